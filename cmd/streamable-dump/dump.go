@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// dumpTypes are the types that can be dumped, by their name in chia
+// Every payload type in the protocols registry is included, by the name of its message type
+var dumpTypes = map[string]func() interface{}{
+	"message": func() interface{} { return &protocols.Message{} },
+
+	"full_block":       func() interface{} { return &types.FullBlock{} },
+	"header_block":     func() interface{} { return &types.HeaderBlock{} },
+	"unfinished_block": func() interface{} { return &types.UnfinishedBlock{} },
+}
+
+func init() {
+	for _, messageType := range protocols.ProtocolMessageTypes() {
+		messageType := messageType
+		if _, err := protocols.NewPayload(messageType); err != nil {
+			continue
+		}
+
+		dumpTypes[messageType.String()] = func() interface{} {
+			payload, _ := protocols.NewPayload(messageType)
+			return payload
+		}
+	}
+}
+
+// Dump returns the annotated dump of data decoded as the named type
+// Whatever could be decoded is returned even when there is an error, since that is usually where the problem is
+func Dump(typeName string, data []byte) (string, error) {
+	newValue, ok := dumpTypes[typeName]
+	if !ok {
+		return "", fmt.Errorf("unknown type %q, use -list to see the supported types", typeName)
+	}
+
+	v := newValue()
+	explanation, err := streamable.Explain(data, v)
+	output := explanation.String()
+	if err != nil {
+		return output, err
+	}
+
+	// Messages wrap the actual data, which is more interesting than the message itself
+	msg, isMessage := v.(*protocols.Message)
+	if !isMessage {
+		return output, nil
+	}
+
+	if _, err = protocols.NewPayload(msg.ProtocolMessageType); err != nil {
+		return output + fmt.Sprintf("\n%s, data not decoded\n", err), nil
+	}
+
+	dataType := msg.ProtocolMessageType.String()
+	dataOutput, err := Dump(dataType, msg.Data)
+	return output + fmt.Sprintf("\nData (%s), offsets from the start of the data:\n", dataType) + dataOutput, err
+}
+
+// decodeHex decodes hex that may have a 0x prefix and whitespace, such as hex copied out of a log
+func decodeHex(hexStr string) ([]byte, error) {
+	hexStr = strings.Join(strings.Fields(hexStr), "")
+	hexStr = strings.TrimPrefix(hexStr, "0x")
+
+	return hex.DecodeString(hexStr)
+}
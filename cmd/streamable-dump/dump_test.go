@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDump_Message(t *testing.T) {
+	data, err := decodeHex("0x01000000002d 000000076d61696e6e657400000006302e302e333300000006312e322e313120fc010000000100010000000131\n")
+	assert.NoError(t, err)
+
+	output, err := Dump("message", data)
+	assert.NoError(t, err)
+	assert.Contains(t, output, "Data (handshake), offsets from the start of the data:")
+	assert.Contains(t, output, `4       7    NetworkID                   6d61696e6e6574  "mainnet"`)
+}
+
+func TestDump_Errors(t *testing.T) {
+	_, err := Dump("not_a_type", nil)
+	assert.Error(t, err)
+
+	// The annotations up to the failure are still returned
+	output, err := Dump("respond_peers", []byte{0, 0, 0, 1, 0})
+	assert.EqualError(t, err, "streamable: decoding PeerList[0].Host at offset 4: need 4 bytes, only 1 remaining")
+	assert.Contains(t, output, "0       4    PeerList  00000001  length 1")
+
+	// Unknown message types still show the message itself
+	output, err = Dump("message", []byte{0xfe, 0, 0, 0, 0, 0})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "unknown message type 254")
+}
+
+func TestDump_Block(t *testing.T) {
+	hexBytes, err := os.ReadFile("../../pkg/types/testdata/full_block.hex")
+	assert.NoError(t, err)
+	data, err := decodeHex(string(hexBytes))
+	assert.NoError(t, err)
+
+	output, err := Dump("full_block", data)
+	assert.NoError(t, err)
+	assert.Contains(t, output, "TransactionsGeneratorRefList[1]")
+}
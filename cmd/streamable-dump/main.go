@@ -0,0 +1,77 @@
+// streamable-dump prints an annotated dump of streamable bytes, showing the offset, length, field, raw hex,
+// and decoded value of every part of the data. It is meant for debugging data that doesn't decode as expected.
+//
+// Usage:
+//
+//	streamable-dump -type handshake 000000076d61696e6e6574...
+//	streamable-dump -type message -file message.bin
+//
+// The data is either hex on the command line (with or without 0x), or a file containing hex or raw bytes.
+// When the type is message, the data of the message is dumped as well if the message type is known.
+// Use -list to see the supported types.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	typeName := flag.String("type", "message", "name of the type the data is encoded as, such as handshake")
+	file := flag.String("file", "", "read the data from this file instead of the command line")
+	list := flag.Bool("list", false, "list the supported types and exit")
+	flag.Parse()
+
+	if *list {
+		names := make([]string, 0, len(dumpTypes))
+		for name := range dumpTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Println(strings.Join(names, "\n"))
+		return
+	}
+
+	data, err := readInput(*file, flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "streamable-dump: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	output, err := Dump(*typeName, data)
+	fmt.Print(output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "streamable-dump: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+// readInput returns the data from the file if there is one, or from the hex arguments otherwise
+func readInput(file string, args []string) ([]byte, error) {
+	if file != "" {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("hex arguments can't be used with -file")
+		}
+
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		// Files of hex are more common than raw bytes when copying data out of logs
+		if decoded, err := decodeHex(string(contents)); err == nil {
+			return decoded, nil
+		}
+
+		return contents, nil
+	}
+
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no data, provide hex as an argument or use -file")
+	}
+
+	return decodeHex(strings.Join(args, ""))
+}
@@ -0,0 +1,894 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+)
+
+const (
+	// defaultOutput is the file generated code is written to when -output isn't provided
+	defaultOutput = "streamable_gen.go"
+
+	// Name of the struct tag used to identify the streamable properties
+	tagName = "streamable"
+
+	// typesPkgPath is where Uint128 lives, which needs special handling just like the reflective path
+	typesPkgPath = "github.com/cmmarslender/go-chia-lib/pkg/types"
+
+	// maxPrealloc caps how many list items we'll allocate space for up front, based on the untrusted length prefix
+	maxPrealloc = 1024
+)
+
+// generator holds the state while generating code for a single package
+type generator struct {
+	pkg *types.Package
+
+	// generating is the set of types that methods are being generated for in this run
+	generating map[*types.TypeName]bool
+
+	// imports tracks the packages referenced by the generated code (path => name)
+	imports map[string]string
+
+	// Per method state
+	usesErr   bool
+	usesBytes bool
+	varNum    int
+
+	// locations are the fields and list items being decoded, outermost first, which errors are located at
+	locations []location
+}
+
+// location is a field or list item that errors are located at, the same as the reflective path does
+type location struct {
+	start string // variable with the offset the field or item starts at
+	path  string // expression for the path segment, such as "Host" or fmt.Sprintf("[%d]", i)
+	typ   string // the go type of the field or item
+}
+
+// Generate loads the package in dir and returns the formatted source for the generated methods
+// The output file is excluded when loading the package, so stale generated code can't interfere
+func Generate(dir string, output string, typeNames []string) ([]byte, error) {
+	pkg, err := loadPackage(dir, output)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		pkg:        pkg,
+		generating: map[*types.TypeName]bool{},
+		imports:    map[string]string{},
+	}
+
+	var typeList []*types.TypeName
+	if len(typeNames) > 0 {
+		for _, name := range typeNames {
+			obj, ok := pkg.Scope().Lookup(strings.TrimSpace(name)).(*types.TypeName)
+			if !ok {
+				return nil, fmt.Errorf("type %s not found in package %s", name, pkg.Path())
+			}
+			if _, isStruct := obj.Type().Underlying().(*types.Struct); !isStruct {
+				return nil, fmt.Errorf("type %s is not a struct", name)
+			}
+			typeList = append(typeList, obj)
+		}
+	} else {
+		for _, name := range pkg.Scope().Names() {
+			obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+			if !ok || obj.IsAlias() {
+				continue
+			}
+			if st, isStruct := obj.Type().Underlying().(*types.Struct); isStruct && hasStreamableTags(st) {
+				typeList = append(typeList, obj)
+			}
+		}
+	}
+
+	if len(typeList) == 0 {
+		return nil, fmt.Errorf("no streamable types found in package %s", pkg.Path())
+	}
+
+	for _, obj := range typeList {
+		g.generating[obj] = true
+	}
+
+	body := &bytes.Buffer{}
+	for _, obj := range typeList {
+		err = g.generateType(body, obj)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", obj.Name(), err)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "// Code generated by streamablegen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(out, "package %s\n\n", pkg.Name())
+	g.writeImports(out)
+	out.Write(body.Bytes())
+	out.WriteString(decoderSource)
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated code: %w\n%s", err, out.String())
+	}
+
+	return formatted, nil
+}
+
+// loadPackage parses and type checks the package in dir
+func loadPackage(dir string, output string) (*types.Package, error) {
+	buildPkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range buildPkg.GoFiles {
+		if name == output {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	return conf.Check(importPath(dir, buildPkg), fset, files, nil)
+}
+
+// importPath returns the full import path of the package in dir
+// go/build doesn't know about modules on its own, so we ask the go command
+func importPath(dir string, buildPkg *build.Package) string {
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return buildPkg.ImportPath
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+// hasStreamableTags returns true if any of the fields in the struct have a streamable tag
+func hasStreamableTags(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if _, ok := reflect.StructTag(st.Tag(i)).Lookup(tagName); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *generator) writeImports(out *bytes.Buffer) {
+	if len(g.imports) == 0 {
+		return
+	}
+
+	var std, other []string
+	for path := range g.imports {
+		if strings.Contains(path, ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	fmt.Fprintf(out, "import (\n")
+	for _, path := range std {
+		fmt.Fprintf(out, "%q\n", path)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		fmt.Fprintf(out, "\n")
+	}
+	for _, path := range other {
+		fmt.Fprintf(out, "%q\n", path)
+	}
+	fmt.Fprintf(out, ")\n\n")
+}
+
+// qualifier returns the name to use for types from pkg, and tracks the import
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == g.pkg {
+		return ""
+	}
+
+	g.imports[pkg.Path()] = pkg.Name()
+	return pkg.Name()
+}
+
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+func (g *generator) useImport(path string) {
+	g.imports[path] = filepath.Base(path)
+}
+
+func (g *generator) newVar(prefix string) string {
+	g.varNum++
+	return fmt.Sprintf("%s%d", prefix, g.varNum)
+}
+
+// hasMethod returns true if t (or *t) has the method, or will once the code being generated exists
+func (g *generator) hasMethod(t types.Type, name string) bool {
+	if named, ok := t.(*types.Named); ok && g.generating[named.Obj()] {
+		return true
+	}
+
+	return types.NewMethodSet(types.NewPointer(t)).Lookup(nil, name) != nil
+}
+
+func isUint128(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == typesPkgPath && named.Obj().Name() == "Uint128"
+}
+
+// field is a single streamable field of a struct
+type field struct {
+	name     string
+	typ      types.Type
+	optional bool
+	length   int
+}
+
+// streamableFields returns the fields that are part of the streamable encoding, in order
+// Tag handling here must stay in sync with the reflective path in pkg/streamable
+func streamableFields(st *types.Struct) ([]field, error) {
+	var fields []field
+	for i := 0; i < st.NumFields(); i++ {
+		tag, ok := reflect.StructTag(st.Tag(i)).Lookup(tagName)
+		if !ok {
+			continue
+		}
+
+		opts, err := streamable.ParseTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: invalid streamable tag: %w", st.Field(i).Name(), err)
+		}
+		if opts.Skip {
+			continue
+		}
+
+		f := field{
+			name:     st.Field(i).Name(),
+			typ:      st.Field(i).Type(),
+			optional: opts.Optional,
+			length:   opts.Len,
+		}
+
+		valueType := f.typ
+		if f.optional {
+			ptr, isPtr := f.typ.(*types.Pointer)
+			if !isPtr {
+				return nil, fmt.Errorf("field %s: optional fields must be pointer types", f.name)
+			}
+			valueType = ptr.Elem()
+		}
+
+		if opts.Tuple {
+			underlying := f.typ
+			for {
+				if ptr, isPtr := underlying.Underlying().(*types.Pointer); isPtr {
+					underlying = ptr.Elem()
+				} else if slice, isSlice := underlying.Underlying().(*types.Slice); isSlice {
+					underlying = slice.Elem()
+				} else {
+					break
+				}
+			}
+			if _, isStruct := underlying.Underlying().(*types.Struct); !isStruct {
+				return nil, fmt.Errorf("field %s: tuple fields must be struct types", f.name)
+			}
+		}
+
+		if f.length > 0 {
+			if _, isSlice := valueType.Underlying().(*types.Slice); !isSlice {
+				return nil, fmt.Errorf("field %s: len can only be used with slices", f.name)
+			}
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+func (g *generator) generateType(out *bytes.Buffer, obj *types.TypeName) error {
+	fields, err := streamableFields(obj.Type().Underlying().(*types.Struct))
+	if err != nil {
+		return err
+	}
+
+	name := obj.Name()
+
+	// Marshal
+	g.usesErr = false
+	g.varNum = 0
+	body := &bytes.Buffer{}
+	for _, f := range fields {
+		err = g.marshalField(body, f)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "// MarshalStreamable implements streamable.Marshaler for %s\n", name)
+	fmt.Fprintf(out, "func (s *%s) MarshalStreamable() ([]byte, error) {\nreturn s.AppendStreamable(nil)\n}\n\n", name)
+	fmt.Fprintf(out, "// AppendStreamable appends the streamable encoding of %s to b\n", name)
+	fmt.Fprintf(out, "func (s *%s) AppendStreamable(b []byte) ([]byte, error) {\n", name)
+	if g.usesErr {
+		fmt.Fprintf(out, "var err error\n\n")
+	}
+	out.Write(body.Bytes())
+	fmt.Fprintf(out, "return b, nil\n}\n\n")
+
+	// Unmarshal
+	g.useImport("io")
+	g.usesErr = false
+	g.usesBytes = false
+	g.varNum = 0
+	body = &bytes.Buffer{}
+	for _, f := range fields {
+		err = g.unmarshalField(body, f)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "// UnmarshalStreamable implements streamable.Unmarshaler for %s\n", name)
+	fmt.Fprintf(out, "func (s *%s) UnmarshalStreamable(r io.Reader) error {\n", name)
+	if len(fields) > 0 {
+		fmt.Fprintf(out, "d := newStreamableDecoder(r)\n")
+	}
+	if g.usesErr {
+		fmt.Fprintf(out, "var err error\n")
+	}
+	if g.usesBytes {
+		fmt.Fprintf(out, "var b []byte\n")
+	}
+	if len(fields) > 0 {
+		fmt.Fprintf(out, "\n")
+	}
+	out.Write(body.Bytes())
+	fmt.Fprintf(out, "return nil\n}\n\n")
+
+	return nil
+}
+
+func (g *generator) marshalField(w *bytes.Buffer, f field) error {
+	x := "s." + f.name
+	fmt.Fprintf(w, "// %s\n", f.name)
+
+	if f.optional {
+		return g.marshalOptional(w, x, f.typ.(*types.Pointer), f.length)
+	}
+
+	return g.marshalFieldValue(w, x, f.typ, f.length)
+}
+
+// marshalFieldValue marshals the value of a field, which is a fixed length list when length is set
+func (g *generator) marshalFieldValue(w *bytes.Buffer, x string, t types.Type, length int) error {
+	if length > 0 {
+		return g.marshalFixedList(w, x, t.Underlying().(*types.Slice), length)
+	}
+
+	return g.marshalValue(w, x, t)
+}
+
+// marshalOptional writes the presence byte, then the value if it isn't nil
+func (g *generator) marshalOptional(w *bytes.Buffer, x string, t *types.Pointer, length int) error {
+	fmt.Fprintf(w, "if %s == nil {\nb = append(b, 0)\n} else {\nb = append(b, 1)\n", x)
+	err := g.marshalFieldValue(w, "(*"+x+")", t.Elem(), length)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "}\n")
+
+	return nil
+}
+
+// marshalElement marshals a single list or array item, where pointers are treated as optional items
+func (g *generator) marshalElement(w *bytes.Buffer, x string, t types.Type) error {
+	if ptr, isPtr := t.(*types.Pointer); isPtr {
+		return g.marshalOptional(w, x, ptr, 0)
+	}
+
+	return g.marshalValue(w, x, t)
+}
+
+// marshalFixedList writes exactly length items, with no length prefix
+func (g *generator) marshalFixedList(w *bytes.Buffer, x string, t *types.Slice, length int) error {
+	g.useImport("fmt")
+	fmt.Fprintf(w, "if len(%s) != %d {\n", x, length)
+	fmt.Fprintf(w, "return nil, fmt.Errorf(\"fixed length list must have exactly %d items, got %%d\", len(%s))\n}\n", length, x)
+
+	if isByte(t.Elem()) {
+		fmt.Fprintf(w, "b = append(b, %s...)\n", x)
+		return nil
+	}
+	i := g.newVar("i")
+	fmt.Fprintf(w, "for %s := range %s {\n", i, x)
+	err := g.marshalElement(w, fmt.Sprintf("%s[%s]", x, i), t.Elem())
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "}\n")
+
+	return nil
+}
+
+// appendUint appends code that writes x as a big-endian unsigned value of numBytes bytes
+func appendUint(w *bytes.Buffer, x string, numBytes int) {
+	parts := make([]string, numBytes)
+	for i := 0; i < numBytes; i++ {
+		shift := 8 * (numBytes - 1 - i)
+		if shift == 0 {
+			parts[i] = fmt.Sprintf("byte(%s)", x)
+		} else {
+			parts[i] = fmt.Sprintf("byte(%s>>%d)", x, shift)
+		}
+	}
+	fmt.Fprintf(w, "b = append(b, %s)\n", strings.Join(parts, ", "))
+}
+
+func (g *generator) marshalValue(w *bytes.Buffer, x string, t types.Type) error {
+	// Same priority as the reflective path - custom encodings first, then uint128, then the kind
+	if g.hasMethod(t, "AppendStreamable") {
+		g.usesErr = true
+		fmt.Fprintf(w, "if b, err = %s.AppendStreamable(b); err != nil {\nreturn nil, err\n}\n", x)
+		return nil
+	}
+	if g.hasMethod(t, "MarshalStreamable") {
+		g.usesErr = true
+		custom := g.newVar("custom")
+		fmt.Fprintf(w, "var %s []byte\n", custom)
+		fmt.Fprintf(w, "if %s, err = %s.MarshalStreamable(); err != nil {\nreturn nil, err\n}\n", custom, x)
+		fmt.Fprintf(w, "b = append(b, %s...)\n", custom)
+		return nil
+	}
+	if isUint128(t) {
+		fmt.Fprintf(w, "b = append(b, %s.Bytes()...)\n", x)
+		return nil
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch u.Kind() {
+		case types.Uint8, types.Int8:
+			appendUint(w, x, 1)
+		case types.Uint16, types.Int16:
+			appendUint(w, x, 2)
+		case types.Uint32, types.Int32:
+			appendUint(w, x, 4)
+		case types.Uint64, types.Int64:
+			appendUint(w, x, 8)
+		case types.Bool:
+			fmt.Fprintf(w, "if %s {\nb = append(b, 1)\n} else {\nb = append(b, 0)\n}\n", x)
+		case types.String:
+			g.appendLength(w, x)
+			fmt.Fprintf(w, "b = append(b, string(%s)...)\n", x)
+		default:
+			return fmt.Errorf("unimplemented type %s", t.String())
+		}
+	case *types.Array:
+		if isByte(u.Elem()) {
+			fmt.Fprintf(w, "b = append(b, %s[:]...)\n", x)
+			return nil
+		}
+		i := g.newVar("i")
+		fmt.Fprintf(w, "for %s := range %s {\n", i, x)
+		err := g.marshalElement(w, fmt.Sprintf("%s[%s]", x, i), u.Elem())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "}\n")
+	case *types.Slice:
+		g.appendLength(w, x)
+		if isByte(u.Elem()) {
+			fmt.Fprintf(w, "b = append(b, %s...)\n", x)
+			return nil
+		}
+		i := g.newVar("i")
+		fmt.Fprintf(w, "for %s := range %s {\n", i, x)
+		err := g.marshalElement(w, fmt.Sprintf("%s[%s]", x, i), u.Elem())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "}\n")
+	case *types.Struct:
+		return fmt.Errorf("struct type %s has no streamable methods, run streamablegen in its package first", t.String())
+	case *types.Pointer:
+		return fmt.Errorf("pointer type %s is only supported for optional fields and list items", t.String())
+	default:
+		return fmt.Errorf("unimplemented type %s", t.String())
+	}
+
+	return nil
+}
+
+// isByte returns true for byte/uint8 itself, which allows appending and reading the whole slice/array at once
+// Named types with an underlying uint8 are handled item by item, since they can't be used as []byte
+func isByte(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	return ok && basic.Kind() == types.Uint8
+}
+
+// isByteKind returns true for uint8 and named uint8 types, which the reflective path reads as raw bytes in lists
+func isByteKind(t types.Type) bool {
+	return isByte(t.Underlying())
+}
+
+// appendLength appends code that writes the 4 byte length prefix for x
+func (g *generator) appendLength(w *bytes.Buffer, x string) {
+	n := g.newVar("n")
+	fmt.Fprintf(w, "%s := uint32(len(%s))\n", n, x)
+	appendUint(w, n, 4)
+}
+
+// fail appends code that returns err, located at each of the fields and list items currently being decoded
+func (g *generator) fail(w *bytes.Buffer) {
+	g.usesErr = true
+	errExpr := "err"
+	for i := len(g.locations) - 1; i >= 0; i-- {
+		loc := g.locations[i]
+		errExpr = fmt.Sprintf("d.FieldError(%s, %s, %s, (*%s)(nil))", errExpr, loc.start, loc.path, loc.typ)
+	}
+	fmt.Fprintf(w, "return %s\n", errExpr)
+}
+
+// check appends code that runs stmt, which sets err, and fails if there was an error
+func (g *generator) check(w *bytes.Buffer, stmt string) {
+	fmt.Fprintf(w, "if %s; err != nil {\n", stmt)
+	g.fail(w)
+	fmt.Fprintf(w, "}\n")
+}
+
+// next appends code that reads the next numBytes (an expression) into b
+func (g *generator) next(w *bytes.Buffer, numBytes string) {
+	g.usesBytes = true
+	g.check(w, fmt.Sprintf("b, err = d.Next(%s)", numBytes))
+}
+
+// readLength appends code that reads a 4 byte length prefix into a new variable, and returns the variable name
+func (g *generator) readLength(w *bytes.Buffer) string {
+	g.next(w, "4")
+	g.useImport("encoding/binary")
+	n := g.newVar("n")
+	fmt.Fprintf(w, "%s := binary.BigEndian.Uint32(b)\n", n)
+	return n
+}
+
+// enter appends code that starts decoding a field or list item, and checks the nesting depth
+// The reflective path checks at the same places, so generated code hits MaxDepth at exactly the same point
+// Errors are located at the field or item until leave is called
+func (g *generator) enter(w *bytes.Buffer, path string, t types.Type) {
+	start := g.newVar("start")
+	fmt.Fprintf(w, "%s := d.Offset()\n", start)
+	g.locations = append(g.locations, location{start: start, path: path, typ: g.typeString(t)})
+	g.check(w, "err = d.EnterNested()")
+}
+
+// leave appends code that goes back up a level once the field or list item is decoded
+func (g *generator) leave(w *bytes.Buffer) {
+	g.locations = g.locations[:len(g.locations)-1]
+	fmt.Fprintf(w, "d.LeaveNested()\n")
+}
+
+func (g *generator) unmarshalField(w *bytes.Buffer, f field) error {
+	x := "s." + f.name
+	fmt.Fprintf(w, "// %s\n", f.name)
+
+	g.enter(w, fmt.Sprintf("%q", f.name), f.typ)
+	var err error
+	if f.optional {
+		err = g.unmarshalOptional(w, x, f.typ.(*types.Pointer), f.length)
+	} else {
+		err = g.unmarshalFieldValue(w, x, f.typ, f.length)
+	}
+	g.leave(w)
+
+	return err
+}
+
+// unmarshalFieldValue unmarshals the value of a field, which is a fixed length list when length is set
+func (g *generator) unmarshalFieldValue(w *bytes.Buffer, x string, t types.Type, length int) error {
+	if length > 0 {
+		return g.unmarshalFixedList(w, x, t, length)
+	}
+
+	return g.unmarshalValue(w, x, t)
+}
+
+// unmarshalOptional reads the presence byte, then the value if it is present
+// The value counts towards MaxAllocation before it is allocated, the same as the reflective path
+func (g *generator) unmarshalOptional(w *bytes.Buffer, x string, t *types.Pointer, length int) error {
+	g.next(w, "1")
+	g.useImport("fmt")
+	g.useImport("unsafe")
+	fmt.Fprintf(w, "switch b[0] {\ncase 0:\n%s = nil\ncase 1:\n", x)
+	g.check(w, fmt.Sprintf("err = d.LimitAllocation(unsafe.Sizeof(*%s))", x))
+	fmt.Fprintf(w, "%s = new(%s)\n", x, g.typeString(t.Elem()))
+	err := g.unmarshalFieldValue(w, "(*"+x+")", t.Elem(), length)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "default:\nerr = fmt.Errorf(\"invalid value for optional presence byte %%d\", b[0])\n")
+	g.fail(w)
+	fmt.Fprintf(w, "}\n")
+
+	return nil
+}
+
+// unmarshalElement unmarshals a single list or array item, where pointers are treated as optional items
+// index is the variable with the index of the item, which is used in the path of errors
+func (g *generator) unmarshalElement(w *bytes.Buffer, x string, t types.Type, index string) error {
+	g.useImport("fmt")
+	g.enter(w, fmt.Sprintf("fmt.Sprintf(\"[%%d]\", %s)", index), t)
+	var err error
+	if ptr, isPtr := t.(*types.Pointer); isPtr {
+		err = g.unmarshalOptional(w, x, ptr, 0)
+	} else {
+		err = g.unmarshalValue(w, x, t)
+	}
+	g.leave(w)
+
+	return err
+}
+
+// unmarshalBytes appends code that reads numBytes (an expression) into x, which is a []byte or named byte slice type
+// In ZeroCopy mode, x refers to the input instead of a copy
+func (g *generator) unmarshalBytes(w *bytes.Buffer, x string, t types.Type, numBytes string) {
+	g.check(w, fmt.Sprintf("err = d.LimitBytesLength(%s)", numBytes))
+	g.usesBytes = true
+	g.check(w, fmt.Sprintf("b, err = d.NextBytes(%s)", numBytes))
+	typeStr := g.typeString(t)
+	elem := t.Underlying().(*types.Slice).Elem()
+	switch {
+	case typeStr == "[]byte":
+		fmt.Fprintf(w, "%s = b\n", x)
+	case isByte(elem):
+		fmt.Fprintf(w, "%s = %s(b)\n", x, typeStr)
+	default:
+		// Lists of a named uint8 type are raw bytes too, but need converting one at a time
+		i := g.newVar("i")
+		fmt.Fprintf(w, "%s = make(%s, len(b))\nfor %s := range b {\n%s[%s] = %s(b[%s])\n}\n", x, typeStr, i, x, i, g.typeString(elem), i)
+	}
+}
+
+// unmarshalFixedList reads exactly length items, with no length prefix
+func (g *generator) unmarshalFixedList(w *bytes.Buffer, x string, t types.Type, length int) error {
+	slice := t.Underlying().(*types.Slice)
+	if isByteKind(slice.Elem()) {
+		g.unmarshalBytes(w, x, t, fmt.Sprintf("%d", length))
+		return nil
+	}
+
+	g.useImport("unsafe")
+	g.check(w, fmt.Sprintf("err = d.LimitListLength(%d, unsafe.Sizeof(%s[0]))", length, x))
+	fmt.Fprintf(w, "%s = make(%s, %d)\n", x, g.typeString(t), length)
+	i := g.newVar("i")
+	fmt.Fprintf(w, "for %s := range %s {\n", i, x)
+	err := g.unmarshalElement(w, fmt.Sprintf("%s[%s]", x, i), slice.Elem(), i)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "}\n")
+
+	return nil
+}
+
+func (g *generator) unmarshalValue(w *bytes.Buffer, x string, t types.Type) error {
+	if g.hasMethod(t, "UnmarshalStreamable") {
+		g.check(w, fmt.Sprintf("err = %s.UnmarshalStreamable(d)", x))
+		return nil
+	}
+	if isUint128(t) {
+		g.next(w, "16")
+		pkgName := g.qualifier(t.(*types.Named).Obj().Pkg())
+		if pkgName != "" {
+			pkgName += "."
+		}
+		g.check(w, fmt.Sprintf("%s, err = %sBytesToUint128(b)", x, pkgName))
+		return nil
+	}
+
+	typeStr := g.typeString(t)
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch u.Kind() {
+		case types.Uint8, types.Int8:
+			g.next(w, "1")
+			fmt.Fprintf(w, "%s = %s(b[0])\n", x, typeStr)
+		case types.Uint16, types.Int16:
+			g.next(w, "2")
+			g.useImport("encoding/binary")
+			fmt.Fprintf(w, "%s = %s(binary.BigEndian.Uint16(b))\n", x, typeStr)
+		case types.Uint32, types.Int32:
+			g.next(w, "4")
+			g.useImport("encoding/binary")
+			fmt.Fprintf(w, "%s = %s(binary.BigEndian.Uint32(b))\n", x, typeStr)
+		case types.Uint64, types.Int64:
+			g.next(w, "8")
+			g.useImport("encoding/binary")
+			fmt.Fprintf(w, "%s = %s(binary.BigEndian.Uint64(b))\n", x, typeStr)
+		case types.Bool:
+			g.next(w, "1")
+			g.useImport("fmt")
+			fmt.Fprintf(w, "switch b[0] {\ncase 0:\n%s = false\ncase 1:\n%s = true\ndefault:\n", x, x)
+			fmt.Fprintf(w, "err = fmt.Errorf(\"invalid value for bool %%d\", b[0])\n")
+			g.fail(w)
+			fmt.Fprintf(w, "}\n")
+		case types.String:
+			n := g.readLength(w)
+			g.check(w, fmt.Sprintf("err = d.LimitBytesLength(%s)", n))
+			g.next(w, fmt.Sprintf("uint(%s)", n))
+			fmt.Fprintf(w, "%s = %s(b)\n", x, typeStr)
+		default:
+			return fmt.Errorf("unimplemented type %s", t.String())
+		}
+	case *types.Array:
+		if isByteKind(u.Elem()) {
+			g.next(w, fmt.Sprintf("%d", u.Len()))
+			if isByte(u.Elem()) {
+				fmt.Fprintf(w, "copy(%s[:], b)\n", x)
+			} else {
+				i := g.newVar("i")
+				fmt.Fprintf(w, "for %s := range %s {\n%s[%s] = %s(b[%s])\n}\n", i, x, x, i, g.typeString(u.Elem()), i)
+			}
+			return nil
+		}
+		i := g.newVar("i")
+		fmt.Fprintf(w, "for %s := range %s {\n", i, x)
+		err := g.unmarshalElement(w, fmt.Sprintf("%s[%s]", x, i), u.Elem(), i)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "}\n")
+	case *types.Slice:
+		n := g.readLength(w)
+		if isByteKind(u.Elem()) {
+			g.unmarshalBytes(w, x, t, n)
+			return nil
+		}
+
+		// Lists count towards MaxAllocation at their size in memory, the same as the reflective path
+		g.useImport("unsafe")
+		g.check(w, fmt.Sprintf("err = d.LimitListLength(%s, unsafe.Sizeof(%s[0]))", n, x))
+
+		// Don't trust the length prefix for the up front allocation
+		prealloc := g.newVar("prealloc")
+		fmt.Fprintf(w, "%s := %s\nif %s > %d {\n%s = %d\n}\n", prealloc, n, prealloc, maxPrealloc, prealloc, maxPrealloc)
+		fmt.Fprintf(w, "%s = make(%s, 0, %s)\n", x, typeStr, prealloc)
+
+		i := g.newVar("i")
+		item := g.newVar("item")
+		fmt.Fprintf(w, "for %s := uint32(0); %s < %s; %s++ {\n", i, i, n, i)
+		fmt.Fprintf(w, "var %s %s\n", item, g.typeString(u.Elem()))
+		err := g.unmarshalElement(w, item, u.Elem(), i)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s = append(%s, %s)\n}\n", x, x, item)
+	case *types.Struct:
+		return fmt.Errorf("struct type %s has no streamable methods, run streamablegen in its package first", t.String())
+	case *types.Pointer:
+		return fmt.Errorf("pointer type %s is only supported for optional fields and list items", t.String())
+	default:
+		return fmt.Errorf("unimplemented type %s", t.String())
+	}
+
+	return nil
+}
+
+// decoderSource is added to every generated file, for UnmarshalStreamable to read through
+// When the reader comes from streamable.Unmarshal or streamable.Decoder, it is used as is, so generated code applies
+// the DecodeOptions and reports errors the same as the reflective path. Any other reader is wrapped, so that lengths
+// from the data still can't cause large allocations before the data arrives
+const decoderSource = `
+// streamableDecoder matches streamable.GeneratedDecoder, which generated code can't import
+type streamableDecoder interface {
+	io.Reader
+	LimitListLength(numItems uint32, itemSize uintptr) error
+	LimitBytesLength(numBytes uint32) error
+	LimitAllocation(numBytes uintptr) error
+	EnterNested() error
+	LeaveNested()
+	Next(numBytes uint) ([]byte, error)
+	NextBytes(numBytes uint32) ([]byte, error)
+	Offset() int64
+	FieldError(err error, start int64, path string, v interface{}) error
+}
+
+// newStreamableDecoder returns r if it is a streamableDecoder, and wraps it otherwise
+func newStreamableDecoder(r io.Reader) streamableDecoder {
+	if d, ok := r.(streamableDecoder); ok {
+		return d
+	}
+
+	return &streamableReader{r: r}
+}
+
+// streamableReadChunk limits how far ahead of the data actually arriving streamableReader allocates
+const streamableReadChunk = 64 * 1024
+
+// streamableReader is the streamableDecoder for a plain io.Reader, which has no limits other than the data itself
+type streamableReader struct {
+	r      io.Reader
+	offset int64
+	buf    []byte
+}
+
+func (s *streamableReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	s.offset += int64(n)
+	return n, err
+}
+
+// Next reads numBytes in chunks, so the buffer only grows as data arrives
+func (s *streamableReader) Next(numBytes uint) ([]byte, error) {
+	s.buf = s.buf[:0]
+	for uint(len(s.buf)) < numBytes {
+		chunk := numBytes - uint(len(s.buf))
+		if chunk > streamableReadChunk {
+			chunk = streamableReadChunk
+		}
+
+		filled := len(s.buf)
+		s.buf = append(s.buf, make([]byte, chunk)...)
+		n, err := io.ReadFull(s.r, s.buf[filled:])
+		s.offset += int64(n)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+
+	return s.buf, nil
+}
+
+func (s *streamableReader) NextBytes(numBytes uint32) ([]byte, error) {
+	b, err := s.Next(uint(numBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(make([]byte, 0, len(b)), b...), nil
+}
+
+func (s *streamableReader) LimitListLength(numItems uint32, itemSize uintptr) error { return nil }
+func (s *streamableReader) LimitBytesLength(numBytes uint32) error                  { return nil }
+func (s *streamableReader) LimitAllocation(numBytes uintptr) error                  { return nil }
+func (s *streamableReader) EnterNested() error                                      { return nil }
+func (s *streamableReader) LeaveNested()                                            {}
+func (s *streamableReader) Offset() int64                                           { return s.offset }
+
+func (s *streamableReader) FieldError(err error, start int64, path string, v interface{}) error {
+	return err
+}
+`
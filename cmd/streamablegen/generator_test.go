@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerate_UpToDate ensures the checked in generated code matches what the generator currently produces
+func TestGenerate_UpToDate(t *testing.T) {
+	for _, dir := range []string{
+		filepath.Join("..", "..", "pkg", "streamable", "internal", "gentest"),
+		filepath.Join("..", "..", "pkg", "types"),
+		filepath.Join("..", "..", "pkg", "protocols"),
+	} {
+		generated, err := Generate(dir, defaultOutput, nil)
+		assert.NoError(t, err)
+
+		existing, err := os.ReadFile(filepath.Join(dir, defaultOutput))
+		assert.NoError(t, err)
+		assert.Equal(t, string(existing), string(generated), "generated code in %s is out of date, run go generate ./...", dir)
+	}
+}
+
+func TestGenerate_TypeList(t *testing.T) {
+	dir := filepath.Join("..", "..", "pkg", "streamable", "internal", "gentest")
+
+	generated, err := Generate(dir, defaultOutput, []string{"Pair"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(generated), "func (s *Pair) UnmarshalStreamable(r io.Reader) error")
+	assert.NotContains(t, string(generated), "Everything")
+
+	_, err = Generate(dir, defaultOutput, []string{"DoesNotExist"})
+	assert.Error(t, err)
+
+	_, err = Generate(dir, defaultOutput, []string{"Kind"})
+	assert.Error(t, err)
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	_, err := Generate(filepath.Join("testdata", "badoptional"), defaultOutput, nil)
+	assert.Error(t, err)
+
+	_, err = Generate(filepath.Join("testdata", "nomethods"), defaultOutput, nil)
+	assert.Error(t, err)
+
+	_, err = Generate(filepath.Join("testdata", "badtag"), defaultOutput, nil)
+	assert.EqualError(t, err, `BadTag: field Value: invalid streamable tag: unknown option "optinal"`)
+}
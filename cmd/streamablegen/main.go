@@ -0,0 +1,53 @@
+// streamablegen generates reflection free MarshalStreamable, AppendStreamable and UnmarshalStreamable methods
+// for structs that use `streamable:""` tags, so that streamable.Marshal and streamable.Unmarshal can skip reflection.
+//
+// Usage, from a go:generate directive in the package that contains the types:
+//
+//	//go:generate go run github.com/cmmarslender/go-chia-lib/cmd/streamablegen
+//
+// By default, methods are generated for every struct in the package that has at least one streamable tag.
+// Use -type to generate for a specific list of types instead (required for empty structs).
+// All generated code for a package is written to a single file, streamable_gen.go by default.
+//
+// Generated code decodes the same way as the reflective path. The DecodeOptions limits are applied at the same points,
+// lists and optionals count against MaxAllocation at their size in memory, errors have the path to the field that
+// failed, and ZeroCopy applies to byte slices. When UnmarshalStreamable is called directly with any other io.Reader,
+// there are no limits, but data is still read in chunks so a length prefix can't cause a large allocation up front.
+// Each generated file declares the unexported helpers streamableDecoder, newStreamableDecoder, streamableReader and
+// streamableReadChunk for this, so those names must not be used elsewhere in the package.
+//
+// The packages in this library (pkg/types and pkg/protocols) are generated, and the generated code is checked in.
+// Run go generate ./... after changing any of their streamable types; a test fails if the generated code is stale.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package to generate code for")
+	typeNames := flag.String("type", "", "comma separated list of type names; defaults to all structs with streamable tags")
+	output := flag.String("output", defaultOutput, "name of the output file, relative to -dir")
+	flag.Parse()
+
+	var typeList []string
+	if *typeNames != "" {
+		typeList = strings.Split(*typeNames, ",")
+	}
+
+	src, err := Generate(*dir, *output, typeList)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "streamablegen: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	err = os.WriteFile(filepath.Join(*dir, *output), src, 0644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "streamablegen: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
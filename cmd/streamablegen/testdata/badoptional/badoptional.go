@@ -0,0 +1,6 @@
+package badoptional
+
+// BadOptional has an optional field that isn't a pointer
+type BadOptional struct {
+	Value uint32 `streamable:"optional"`
+}
@@ -0,0 +1,6 @@
+package badtag
+
+// BadTag has a typo in the tag, which must not be silently ignored
+type BadTag struct {
+	Value *uint32 `streamable:"optinal"`
+}
@@ -0,0 +1,10 @@
+package nomethods
+
+import (
+	"github.com/cmmarslender/go-chia-lib/cmd/streamablegen/testdata/nomethods/peer"
+)
+
+// NoMethods uses a struct from another package that doesn't have generated code
+type NoMethods struct {
+	Peer peer.Peer `streamable:""`
+}
@@ -0,0 +1,7 @@
+package peer
+
+// Peer has streamable tags, but no generated code
+type Peer struct {
+	Host string `streamable:""`
+	Port uint16 `streamable:""`
+}
@@ -0,0 +1,88 @@
+package protocols
+
+import (
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// NewSignagePoint is sent from the full node to the farmer for each signage point
+// The farmer passes the peak height on to harvesters, since the plot filter depends on it
+type NewSignagePoint struct {
+	ChallengeHash     types.Bytes32 `streamable:""`
+	ChallengeChainSP  types.Bytes32 `streamable:""`
+	RewardChainSP     types.Bytes32 `streamable:""`
+	Difficulty        uint64        `streamable:""`
+	SubSlotIters      uint64        `streamable:""`
+	SignagePointIndex uint8         `streamable:""`
+	PeakHeight        uint32        `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewSignagePoint) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewSignagePoint
+}
+
+// DeclareProofOfSpace is sent from the farmer to the full node when a proof of space can make a block
+// The pool target and signature are only set for plots with a pool public key
+type DeclareProofOfSpace struct {
+	ChallengeHash              types.Bytes32      `streamable:""`
+	ChallengeChainSP           types.Bytes32      `streamable:""`
+	SignagePointIndex          uint8              `streamable:""`
+	RewardChainSP              types.Bytes32      `streamable:""`
+	ProofOfSpace               types.ProofOfSpace `streamable:""`
+	ChallengeChainSPSignature  types.G2Element    `streamable:""`
+	RewardChainSPSignature     types.G2Element    `streamable:""`
+	FarmerPuzzleHash           types.Bytes32      `streamable:""`
+	PoolTarget                 *types.PoolTarget  `streamable:"optional"`
+	PoolSignature              *types.G2Element   `streamable:"optional"`
+	IncludeSignatureSourceData bool               `streamable:""` // Send the data being signed in request_signed_values
+}
+
+// ProtocolMessageType implements Payload
+func (d DeclareProofOfSpace) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeDeclareProofOfSpace
+}
+
+// RequestSignedValues is sent from the full node to the farmer to sign the foliage of a block it is making
+// The foliage and reward chain block data are only set when the proof of space declared
+// IncludeSignatureSourceData, so that a harvester can check what it signs instead of just signing the hashes
+type RequestSignedValues struct {
+	QualityString               types.Bytes32                     `streamable:""`
+	FoliageBlockDataHash        types.Bytes32                     `streamable:""`
+	FoliageTransactionBlockHash types.Bytes32                     `streamable:""`
+	FoliageBlockData            *types.FoliageBlockData           `streamable:"optional"`
+	FoliageTransactionBlockData *types.FoliageTransactionBlock    `streamable:"optional"`
+	RCBlockUnfinished           *types.RewardChainBlockUnfinished `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestSignedValues) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestSignedValues
+}
+
+// FarmingInfo is sent from the farmer to the full node with the results of looking up proofs for a signage point
+type FarmingInfo struct {
+	ChallengeHash types.Bytes32 `streamable:""`
+	SPHash        types.Bytes32 `streamable:""`
+	Timestamp     uint64        `streamable:""`
+	Passed        uint32        `streamable:""` // Plots that passed the plot filter
+	Proofs        uint32        `streamable:""`
+	TotalPlots    uint32        `streamable:""`
+	LookupTime    uint64        `streamable:""` // Milliseconds the harvester took to look up proofs
+}
+
+// ProtocolMessageType implements Payload
+func (f FarmingInfo) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeFarmingInfo
+}
+
+// SignedValues is the response to request_signed_values
+type SignedValues struct {
+	QualityString                    types.Bytes32   `streamable:""`
+	FoliageBlockDataSignature        types.G2Element `streamable:""`
+	FoliageTransactionBlockSignature types.G2Element `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (s SignedValues) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeSignedValues
+}
@@ -0,0 +1,144 @@
+package protocols_test
+
+import (
+	"testing"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+var (
+	// FoliageBlockData(bytes32(0x12 * 32), PoolTarget(bytes32(0xee * 32), uint32(0)), None, bytes32(0xcd * 32), bytes32(0x00 * 32))
+	foliageBlockDataHex = rep("12", 32) + rep("ee", 32) + "00000000" + "00" + rep("cd", 32) + rep("00", 32)
+	foliageBlockData    = types.FoliageBlockData{
+		UnfinishedRewardBlockHash: bytes32("12"),
+		PoolTarget:                types.PoolTarget{PuzzleHash: bytes32("ee")},
+		FarmerRewardPuzzleHash:    bytes32("cd"),
+	}
+
+	// FoliageTransactionBlock(bytes32(0x13 * 32), uint64(1650000000), bytes32(0x14 * 32), bytes32(0x15 * 32), bytes32(0x16 * 32), bytes32(0x17 * 32))
+	foliageTransactionBlockHex = rep("13", 32) + "0000000062590080" + rep("14", 32) + rep("15", 32) + rep("16", 32) + rep("17", 32)
+	foliageTransactionBlock    = types.FoliageTransactionBlock{
+		PrevTransactionBlockHash: bytes32("13"),
+		Timestamp:                1650000000,
+		FilterHash:               bytes32("14"),
+		AdditionsRoot:            bytes32("15"),
+		RemovalsRoot:             bytes32("16"),
+		TransactionsInfoHash:     bytes32("17"),
+	}
+
+	// RewardChainBlockUnfinished(uint128(3500000), uint8(4), bytes32(0x18 * 32), ProofOfSpace(...), None, G2Element(), None, G2Element())
+	rcBlockUnfinishedHex = "000000000000000000000000003567e0" + "04" + rep("18", 32) + proofOfSpaceHex + "00" + g2InfinityHex + "00" + g2InfinityHex
+	rcBlockUnfinished    = types.RewardChainBlockUnfinished{
+		TotalIters:                types.Uint128From64(3500000),
+		SignagePointIndex:         4,
+		PosSSCCChallengeHash:      bytes32("18"),
+		ProofOfSpace:              proofOfSpace,
+		ChallengeChainSPSignature: g2Infinity,
+		RewardChainSPSignature:    g2Infinity,
+	}
+)
+
+func TestFarmerMessages(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		expected   protocols.Payload
+	}{
+		{
+			// NewSignagePoint(bytes32(0x11 * 32), bytes32(0x22 * 32), bytes32(0x33 * 32), uint64(2000), uint64(147849216), uint8(4), uint32(1000000))
+			encodedHex: rep("11", 32) + rep("22", 32) + rep("33", 32) + "00000000000007d0" + "0000000008d00000" + "04" + "000f4240",
+			expected: &protocols.NewSignagePoint{
+				ChallengeHash:     bytes32("11"),
+				ChallengeChainSP:  bytes32("22"),
+				RewardChainSP:     bytes32("33"),
+				Difficulty:        2000,
+				SubSlotIters:      147849216,
+				SignagePointIndex: 4,
+				PeakHeight:        1000000,
+			},
+		},
+		{
+			// DeclareProofOfSpace(
+			//   bytes32(0x11 * 32), bytes32(0x22 * 32), uint8(4), bytes32(0x33 * 32), ProofOfSpace(...),
+			//   G2Element(), G2Element(), bytes32(0xcd * 32), None, None, False,
+			// )
+			encodedHex: rep("11", 32) + rep("22", 32) + "04" + rep("33", 32) + proofOfSpaceHex +
+				g2InfinityHex + g2InfinityHex + rep("cd", 32) + "00" + "00" + "00",
+			expected: &protocols.DeclareProofOfSpace{
+				ChallengeHash:             bytes32("11"),
+				ChallengeChainSP:          bytes32("22"),
+				SignagePointIndex:         4,
+				RewardChainSP:             bytes32("33"),
+				ProofOfSpace:              proofOfSpace,
+				ChallengeChainSPSignature: g2Infinity,
+				RewardChainSPSignature:    g2Infinity,
+				FarmerPuzzleHash:          bytes32("cd"),
+			},
+		},
+		{
+			// Same as above, with PoolTarget(bytes32(0xee * 32), uint32(0)) and G2Element() for the pool target and signature,
+			// and True for include_signature_source_data
+			encodedHex: rep("11", 32) + rep("22", 32) + "04" + rep("33", 32) + proofOfSpaceHex +
+				g2InfinityHex + g2InfinityHex + rep("cd", 32) + "01" + rep("ee", 32) + "00000000" + "01" + g2InfinityHex + "01",
+			expected: &protocols.DeclareProofOfSpace{
+				ChallengeHash:              bytes32("11"),
+				ChallengeChainSP:           bytes32("22"),
+				SignagePointIndex:          4,
+				RewardChainSP:              bytes32("33"),
+				ProofOfSpace:               proofOfSpace,
+				ChallengeChainSPSignature:  g2Infinity,
+				RewardChainSPSignature:     g2Infinity,
+				FarmerPuzzleHash:           bytes32("cd"),
+				PoolTarget:                 &types.PoolTarget{PuzzleHash: bytes32("ee")},
+				PoolSignature:              &g2Infinity,
+				IncludeSignatureSourceData: true,
+			},
+		},
+		{
+			// RequestSignedValues(bytes32(0x88 * 32), bytes32(0x99 * 32), bytes32(0xaa * 32), None, None, None)
+			encodedHex: rep("88", 32) + rep("99", 32) + rep("aa", 32) + "00" + "00" + "00",
+			expected: &protocols.RequestSignedValues{
+				QualityString:               bytes32("88"),
+				FoliageBlockDataHash:        bytes32("99"),
+				FoliageTransactionBlockHash: bytes32("aa"),
+			},
+		},
+		{
+			// Same as above, with the FoliageBlockData, FoliageTransactionBlock, and RewardChainBlockUnfinished
+			encodedHex: rep("88", 32) + rep("99", 32) + rep("aa", 32) +
+				"01" + foliageBlockDataHex + "01" + foliageTransactionBlockHex + "01" + rcBlockUnfinishedHex,
+			expected: &protocols.RequestSignedValues{
+				QualityString:               bytes32("88"),
+				FoliageBlockDataHash:        bytes32("99"),
+				FoliageTransactionBlockHash: bytes32("aa"),
+				FoliageBlockData:            &foliageBlockData,
+				FoliageTransactionBlockData: &foliageTransactionBlock,
+				RCBlockUnfinished:           &rcBlockUnfinished,
+			},
+		},
+		{
+			// FarmingInfo(bytes32(0x11 * 32), bytes32(0x22 * 32), uint64(1650000000), uint32(2), uint32(1), uint32(100), uint64(350))
+			encodedHex: rep("11", 32) + rep("22", 32) + "0000000062590080" + "00000002" + "00000001" + "00000064" + "000000000000015e",
+			expected: &protocols.FarmingInfo{
+				ChallengeHash: bytes32("11"),
+				SPHash:        bytes32("22"),
+				Timestamp:     1650000000,
+				Passed:        2,
+				Proofs:        1,
+				TotalPlots:    100,
+				LookupTime:    350,
+			},
+		},
+		{
+			// SignedValues(bytes32(0x88 * 32), G2Element(), G2Element())
+			encodedHex: rep("88", 32) + g2InfinityHex + g2InfinityHex,
+			expected: &protocols.SignedValues{
+				QualityString:                    bytes32("88"),
+				FoliageBlockDataSignature:        g2Infinity,
+				FoliageTransactionBlockSignature: g2Infinity,
+			},
+		},
+	} {
+		assertPayloadRoundTrip(t, test.encodedHex, test.expected)
+	}
+}
@@ -7,7 +7,304 @@ import (
 // RequestPeers is an empty struct
 type RequestPeers struct{}
 
+// ProtocolMessageType implements Payload
+func (r RequestPeers) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestPeers
+}
+
 // RespondPeers is the format for the request_peers response
 type RespondPeers struct {
 	PeerList []types.TimestampedPeerInfo `streamable:""`
 }
+
+// ProtocolMessageType implements Payload
+func (r RespondPeers) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondPeers
+}
+
+// NewPeak is sent to peers when the node has a new peak
+type NewPeak struct {
+	HeaderHash                types.Bytes32 `streamable:""`
+	Height                    uint32        `streamable:""`
+	Weight                    types.Uint128 `streamable:""`
+	ForkPointWithPreviousPeak uint32        `streamable:""`
+	UnfinishedRewardBlockHash types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewPeak) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewPeak
+}
+
+// NewTransaction announces a transaction that was added to the mempool
+type NewTransaction struct {
+	TransactionID types.Bytes32 `streamable:""`
+	Cost          uint64        `streamable:""`
+	Fees          uint64        `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewTransaction) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewTransaction
+}
+
+// RequestTransaction requests a transaction announced with new_transaction
+type RequestTransaction struct {
+	TransactionID types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestTransaction) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestTransaction
+}
+
+// RespondTransaction is the response to request_transaction
+type RespondTransaction struct {
+	Transaction types.SpendBundle `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondTransaction) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondTransaction
+}
+
+// RequestProofOfWeight requests a weight proof for the chain ending at tip
+type RequestProofOfWeight struct {
+	TotalNumberOfBlocks uint32        `streamable:""`
+	Tip                 types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestProofOfWeight) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestProofOfWeight
+}
+
+// RespondProofOfWeight is the response to request_proof_of_weight
+type RespondProofOfWeight struct {
+	WP  types.WeightProof `streamable:""`
+	Tip types.Bytes32     `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondProofOfWeight) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondProofOfWeight
+}
+
+// RequestBlock requests the block at a height
+type RequestBlock struct {
+	Height                  uint32 `streamable:""`
+	IncludeTransactionBlock bool   `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestBlock) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestBlock
+}
+
+// RejectBlock is the response to request_block when the block isn't available
+type RejectBlock struct {
+	Height uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectBlock) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectBlock
+}
+
+// RequestBlocks requests the blocks from start height to end height, inclusive
+type RequestBlocks struct {
+	StartHeight             uint32 `streamable:""`
+	EndHeight               uint32 `streamable:""`
+	IncludeTransactionBlock bool   `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestBlocks) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestBlocks
+}
+
+// RespondBlocks is the response to request_blocks
+type RespondBlocks struct {
+	StartHeight uint32            `streamable:""`
+	EndHeight   uint32            `streamable:""`
+	Blocks      []types.FullBlock `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondBlocks) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondBlocks
+}
+
+// RejectBlocks is the response to request_blocks when the blocks aren't available
+type RejectBlocks struct {
+	StartHeight uint32 `streamable:""`
+	EndHeight   uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectBlocks) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectBlocks
+}
+
+// RespondBlock is the response to request_block, and is also used to send new blocks
+type RespondBlock struct {
+	Block types.FullBlock `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondBlock) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondBlock
+}
+
+// NewUnfinishedBlock announces a new unfinished block
+type NewUnfinishedBlock struct {
+	UnfinishedRewardHash types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewUnfinishedBlock) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewUnfinishedBlock
+}
+
+// RequestUnfinishedBlock requests an unfinished block announced with new_unfinished_block
+type RequestUnfinishedBlock struct {
+	UnfinishedRewardHash types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestUnfinishedBlock) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestUnfinishedBlock
+}
+
+// NewUnfinishedBlock2 announces a new unfinished block, along with the hash of its foliage
+type NewUnfinishedBlock2 struct {
+	UnfinishedRewardHash types.Bytes32  `streamable:""`
+	FoliageHash          *types.Bytes32 `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewUnfinishedBlock2) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewUnfinishedBlock2
+}
+
+// RequestUnfinishedBlock2 requests an unfinished block announced with new_unfinished_block2
+// If the foliage hash is nil, any unfinished block with the reward hash will do
+type RequestUnfinishedBlock2 struct {
+	UnfinishedRewardHash types.Bytes32  `streamable:""`
+	FoliageHash          *types.Bytes32 `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestUnfinishedBlock2) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestUnfinishedBlock2
+}
+
+// RespondUnfinishedBlock is the response to request_unfinished_block and request_unfinished_block2
+type RespondUnfinishedBlock struct {
+	UnfinishedBlock types.UnfinishedBlock `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondUnfinishedBlock) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondUnfinishedBlock
+}
+
+// NewSignagePointOrEndOfSubSlot announces a new signage point, or the end of a sub slot when the index is 0
+type NewSignagePointOrEndOfSubSlot struct {
+	PrevChallengeHash  *types.Bytes32 `streamable:"optional"`
+	ChallengeHash      types.Bytes32  `streamable:""`
+	IndexFromChallenge uint8          `streamable:""`
+	LastRCInfusion     types.Bytes32  `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewSignagePointOrEndOfSubSlot) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewSignagePointOrEndOfSubSlot
+}
+
+// RequestSignagePointOrEndOfSubSlot requests a signage point or end of sub slot announced with new_signage_point_or_end_of_sub_slot
+type RequestSignagePointOrEndOfSubSlot struct {
+	ChallengeHash      types.Bytes32 `streamable:""`
+	IndexFromChallenge uint8         `streamable:""`
+	LastRCInfusion     types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestSignagePointOrEndOfSubSlot) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestSignagePointOrEndOfSubSlot
+}
+
+// RespondSignagePoint is the response to request_signage_point_or_end_of_sub_slot for a signage point
+type RespondSignagePoint struct {
+	IndexFromChallenge  uint8          `streamable:""`
+	ChallengeChainVDF   types.VDFInfo  `streamable:""`
+	ChallengeChainProof types.VDFProof `streamable:""`
+	RewardChainVDF      types.VDFInfo  `streamable:""`
+	RewardChainProof    types.VDFProof `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondSignagePoint) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondSignagePoint
+}
+
+// RespondEndOfSubSlot is the response to request_signage_point_or_end_of_sub_slot for the end of a sub slot
+type RespondEndOfSubSlot struct {
+	EndOfSlotBundle types.EndOfSubSlotBundle `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondEndOfSubSlot) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondEndOfSubSlot
+}
+
+// RequestMempoolTransactions requests the mempool transactions that aren't in the filter
+// The filter is a serialized BIP 158 filter of the transaction IDs the peer already has
+type RequestMempoolTransactions struct {
+	Filter []byte `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestMempoolTransactions) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestMempoolTransactions
+}
+
+// NewCompactVDF announces a compact proof for a VDF in a block
+type NewCompactVDF struct {
+	Height     uint32        `streamable:""`
+	HeaderHash types.Bytes32 `streamable:""`
+	FieldVDF   uint8         `streamable:""`
+	VDFInfo    types.VDFInfo `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewCompactVDF) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewCompactVDF
+}
+
+// RequestCompactVDF requests a compact proof announced with new_compact_vdf
+type RequestCompactVDF struct {
+	Height     uint32        `streamable:""`
+	HeaderHash types.Bytes32 `streamable:""`
+	FieldVDF   uint8         `streamable:""`
+	VDFInfo    types.VDFInfo `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestCompactVDF) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestCompactVDF
+}
+
+// RespondCompactVDF is the response to request_compact_vdf
+type RespondCompactVDF struct {
+	Height     uint32         `streamable:""`
+	HeaderHash types.Bytes32  `streamable:""`
+	FieldVDF   uint8          `streamable:""`
+	VDFInfo    types.VDFInfo  `streamable:""`
+	VDFProof   types.VDFProof `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondCompactVDF) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondCompactVDF
+}
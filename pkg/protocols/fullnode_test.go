@@ -2,12 +2,16 @@ package protocols_test
 
 import (
 	"encoding/hex"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
 	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
 )
 
 func TestRespondPeers(t *testing.T) {
@@ -38,3 +42,214 @@ func TestRespondPeers(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, encodedBytes, reencodedBytes)
 }
+
+// VDFInfo(bytes32(0x77 * 32), uint64(1000), ClassgroupElement.get_default_element())
+var vdfInfoHex = rep("77", 32) + "00000000000003e8" + "08" + rep("00", 99)
+
+// vdfInfo is the VDFInfo encoded in vdfInfoHex, with a different challenge
+func vdfInfo(challengeHex string) types.VDFInfo {
+	return types.VDFInfo{Challenge: bytes32(challengeHex), NumberOfIterations: 1000, Output: types.ClassgroupElement{Data: types.Bytes100{0x08}}}
+}
+
+func TestFullNodeMessages(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		expected   protocols.Payload
+	}{
+		{
+			// NewPeak(bytes32(0x11 * 32), uint32(1000000), uint128(3500000), uint32(999990), bytes32(0x22 * 32))
+			encodedHex: rep("11", 32) + "000f4240" + "000000000000000000000000003567e0" + "000f4236" + rep("22", 32),
+			expected: &protocols.NewPeak{
+				HeaderHash:                bytes32("11"),
+				Height:                    1000000,
+				Weight:                    types.Uint128From64(3500000),
+				ForkPointWithPreviousPeak: 999990,
+				UnfinishedRewardBlockHash: bytes32("22"),
+			},
+		},
+		{
+			// NewTransaction(bytes32(0x33 * 32), uint64(11000000), uint64(50000))
+			encodedHex: rep("33", 32) + "0000000000a7d8c0" + "000000000000c350",
+			expected:   &protocols.NewTransaction{TransactionID: bytes32("33"), Cost: 11000000, Fees: 50000},
+		},
+		{
+			// RequestTransaction(bytes32(0x33 * 32))
+			encodedHex: rep("33", 32),
+			expected:   &protocols.RequestTransaction{TransactionID: bytes32("33")},
+		},
+		{
+			// RespondTransaction(SpendBundle([CoinSpend(Coin(0xab * 32, 0xcd * 32, 1), (q . 1), ())], G2Element()))
+			encodedHex: "00000001" + rep("ab", 32) + rep("cd", 32) + "0000000000000001" + "ff0101" + "80" + "c0" + rep("00", 95),
+			expected: &protocols.RespondTransaction{
+				Transaction: types.SpendBundle{
+					CoinSpends: []types.CoinSpend{{
+						Coin:         types.Coin{ParentCoinInfo: bytes32("ab"), PuzzleHash: bytes32("cd"), Amount: 1},
+						PuzzleReveal: types.SerializedProgram{0xff, 0x01, 0x01},
+						Solution:     types.SerializedProgram{0x80},
+					}},
+					AggregatedSignature: types.G2Element{0xc0},
+				},
+			},
+		},
+		{
+			// RequestProofOfWeight(uint32(1000001), bytes32(0x11 * 32))
+			encodedHex: "000f4241" + rep("11", 32),
+			expected:   &protocols.RequestProofOfWeight{TotalNumberOfBlocks: 1000001, Tip: bytes32("11")},
+		},
+		{
+			// RespondProofOfWeight(
+			//   WeightProof(
+			//     [SubEpochData(bytes32(0x44 * 32), uint8(2), uint64(147849216), None)],
+			//     [SubEpochChallengeSegment(uint32(3), [SubSlotData(signage_point_index=4, total_iters=5000000000)], None)],
+			//     [],
+			//   ),
+			//   bytes32(0x11 * 32),
+			// )
+			encodedHex: "00000001" + rep("44", 32) + "02" + "01" + "0000000008d00000" + "00" +
+				"00000001" + "00000003" + "00000001" + "0000000000" + "0104" + "000000000000" + "01" + "0000000000000000000000012a05f200" + "00" +
+				"00000000" +
+				rep("11", 32),
+			expected: &protocols.RespondProofOfWeight{
+				WP: types.WeightProof{
+					SubEpochs: []types.SubEpochData{{RewardChainHash: bytes32("44"), NumBlocksOverflow: 2, NewSubSlotIters: util.PtrUint64(147849216)}},
+					SubEpochSegments: []types.SubEpochChallengeSegment{{
+						SubEpochN: 3,
+						SubSlots:  []types.SubSlotData{{SignagePointIndex: util.PtrUint8(4), TotalIters: &[]types.Uint128{types.Uint128From64(5000000000)}[0]}},
+					}},
+					RecentChainData: []types.HeaderBlock{},
+				},
+				Tip: bytes32("11"),
+			},
+		},
+		{
+			// RequestBlock(uint32(1000000), True)
+			encodedHex: "000f4240" + "01",
+			expected:   &protocols.RequestBlock{Height: 1000000, IncludeTransactionBlock: true},
+		},
+		{
+			// RejectBlock(uint32(1000000))
+			encodedHex: "000f4240",
+			expected:   &protocols.RejectBlock{Height: 1000000},
+		},
+		{
+			// RequestBlocks(uint32(1000000), uint32(1000031), False)
+			encodedHex: "000f4240" + "000f425f" + "00",
+			expected:   &protocols.RequestBlocks{StartHeight: 1000000, EndHeight: 1000031},
+		},
+		{
+			// RejectBlocks(uint32(1000000), uint32(1000031))
+			encodedHex: "000f4240" + "000f425f",
+			expected:   &protocols.RejectBlocks{StartHeight: 1000000, EndHeight: 1000031},
+		},
+		{
+			// NewUnfinishedBlock(bytes32(0x55 * 32))
+			encodedHex: rep("55", 32),
+			expected:   &protocols.NewUnfinishedBlock{UnfinishedRewardHash: bytes32("55")},
+		},
+		{
+			// RequestUnfinishedBlock(bytes32(0x55 * 32))
+			encodedHex: rep("55", 32),
+			expected:   &protocols.RequestUnfinishedBlock{UnfinishedRewardHash: bytes32("55")},
+		},
+		{
+			// NewUnfinishedBlock2(bytes32(0x55 * 32), bytes32(0x66 * 32))
+			encodedHex: rep("55", 32) + "01" + rep("66", 32),
+			expected:   &protocols.NewUnfinishedBlock2{UnfinishedRewardHash: bytes32("55"), FoliageHash: &[]types.Bytes32{bytes32("66")}[0]},
+		},
+		{
+			// RequestUnfinishedBlock2(bytes32(0x55 * 32), None)
+			encodedHex: rep("55", 32) + "00",
+			expected:   &protocols.RequestUnfinishedBlock2{UnfinishedRewardHash: bytes32("55")},
+		},
+		{
+			// NewSignagePointOrEndOfSubSlot(None, bytes32(0x77 * 32), uint8(4), bytes32(0x88 * 32))
+			encodedHex: "00" + rep("77", 32) + "04" + rep("88", 32),
+			expected:   &protocols.NewSignagePointOrEndOfSubSlot{ChallengeHash: bytes32("77"), IndexFromChallenge: 4, LastRCInfusion: bytes32("88")},
+		},
+		{
+			// RequestSignagePointOrEndOfSubSlot(bytes32(0x77 * 32), uint8(4), bytes32(0x88 * 32))
+			encodedHex: rep("77", 32) + "04" + rep("88", 32),
+			expected:   &protocols.RequestSignagePointOrEndOfSubSlot{ChallengeHash: bytes32("77"), IndexFromChallenge: 4, LastRCInfusion: bytes32("88")},
+		},
+		{
+			// RespondSignagePoint(
+			//   uint8(4),
+			//   VDFInfo(0x77 * 32, 1000, default), VDFProof(uint8(0), 0x0102, False),
+			//   VDFInfo(0x88 * 32, 1000, default), VDFProof(uint8(0), 0x0304, True),
+			// )
+			encodedHex: "04" +
+				vdfInfoHex + "00" + "000000020102" + "00" +
+				rep("88", 32) + vdfInfoHex[64:] + "00" + "000000020304" + "01",
+			expected: &protocols.RespondSignagePoint{
+				IndexFromChallenge:  4,
+				ChallengeChainVDF:   vdfInfo("77"),
+				ChallengeChainProof: types.VDFProof{Witness: []byte{1, 2}},
+				RewardChainVDF:      vdfInfo("88"),
+				RewardChainProof:    types.VDFProof{Witness: []byte{3, 4}, NormalizedToIdentity: true},
+			},
+		},
+		{
+			// RequestMempoolTransactions(bytes(0x0102030405))
+			encodedHex: "00000005" + "0102030405",
+			expected:   &protocols.RequestMempoolTransactions{Filter: []byte{1, 2, 3, 4, 5}},
+		},
+		{
+			// NewCompactVDF(uint32(1000000), bytes32(0x11 * 32), uint8(1), VDFInfo(0x77 * 32, 1000, default))
+			encodedHex: "000f4240" + rep("11", 32) + "01" + vdfInfoHex,
+			expected:   &protocols.NewCompactVDF{Height: 1000000, HeaderHash: bytes32("11"), FieldVDF: 1, VDFInfo: vdfInfo("77")},
+		},
+		{
+			// RequestCompactVDF(uint32(1000000), bytes32(0x11 * 32), uint8(1), VDFInfo(0x77 * 32, 1000, default))
+			encodedHex: "000f4240" + rep("11", 32) + "01" + vdfInfoHex,
+			expected:   &protocols.RequestCompactVDF{Height: 1000000, HeaderHash: bytes32("11"), FieldVDF: 1, VDFInfo: vdfInfo("77")},
+		},
+		{
+			// RespondCompactVDF(uint32(1000000), bytes32(0x11 * 32), uint8(1), VDFInfo(0x77 * 32, 1000, default), VDFProof(uint8(0), 0x0102, True))
+			encodedHex: "000f4240" + rep("11", 32) + "01" + vdfInfoHex + "00" + "000000020102" + "01",
+			expected: &protocols.RespondCompactVDF{
+				Height:     1000000,
+				HeaderHash: bytes32("11"),
+				FieldVDF:   1,
+				VDFInfo:    vdfInfo("77"),
+				VDFProof:   types.VDFProof{Witness: []byte{1, 2}, NormalizedToIdentity: true},
+			},
+		},
+	} {
+		assertPayloadRoundTrip(t, test.encodedHex, test.expected)
+	}
+}
+
+// readBlockHex reads one of the blocks in the types testdata
+func readBlockHex(t *testing.T, name string) string {
+	data, err := os.ReadFile("../types/testdata/" + name + ".hex")
+	assert.NoError(t, err)
+
+	return strings.TrimSpace(string(data))
+}
+
+func TestFullNodeMessages_Blocks(t *testing.T) {
+	fullBlockHex := readBlockHex(t, "full_block")
+	fullBlockBytes, err := hex.DecodeString(fullBlockHex)
+	assert.NoError(t, err)
+	block := types.FullBlock{}
+	assert.NoError(t, streamable.Unmarshal(fullBlockBytes, &block))
+
+	unfinishedBlockHex := readBlockHex(t, "unfinished_block")
+	unfinishedBlockBytes, err := hex.DecodeString(unfinishedBlockHex)
+	assert.NoError(t, err)
+	unfinishedBlock := types.UnfinishedBlock{}
+	assert.NoError(t, streamable.Unmarshal(unfinishedBlockBytes, &unfinishedBlock))
+
+	// The end of sub slot bundle is the first thing in the full block, after the length of the list
+	endOfSubSlotBytes, err := streamable.Marshal(block.FinishedSubSlots[0])
+	assert.NoError(t, err)
+
+	assertPayloadRoundTrip(t, fullBlockHex, &protocols.RespondBlock{Block: block})
+	assertPayloadRoundTrip(t, "000f4240"+"000f4241"+"00000002"+fullBlockHex+fullBlockHex, &protocols.RespondBlocks{
+		StartHeight: 1000000,
+		EndHeight:   1000001,
+		Blocks:      []types.FullBlock{block, block},
+	})
+	assertPayloadRoundTrip(t, unfinishedBlockHex, &protocols.RespondUnfinishedBlock{UnfinishedBlock: unfinishedBlock})
+	assertPayloadRoundTrip(t, fullBlockHex[8:8+2*len(endOfSubSlotBytes)], &protocols.RespondEndOfSubSlot{EndOfSlotBundle: block.FinishedSubSlots[0]})
+}
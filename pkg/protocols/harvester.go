@@ -0,0 +1,286 @@
+package protocols
+
+import (
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// PoolDifficulty is the difficulty a pool wants partial proofs at, for plots with the pool contract puzzle hash
+type PoolDifficulty struct {
+	Difficulty             uint64        `streamable:""`
+	SubSlotIters           uint64        `streamable:""`
+	PoolContractPuzzleHash types.Bytes32 `streamable:""`
+}
+
+// HarvesterHandshake is sent from the farmer to the harvester after connecting, with the keys plots can be farmed with
+type HarvesterHandshake struct {
+	FarmerPublicKeys []types.G1Element `streamable:""`
+	PoolPublicKeys   []types.G1Element `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (h HarvesterHandshake) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeHarvesterHandshake
+}
+
+// NewSignagePointHarvester is sent from the farmer to the harvester for each signage point, to look up proofs
+type NewSignagePointHarvester struct {
+	ChallengeHash     types.Bytes32    `streamable:""`
+	Difficulty        uint64           `streamable:""`
+	SubSlotIters      uint64           `streamable:""`
+	SignagePointIndex uint8            `streamable:""`
+	SPHash            types.Bytes32    `streamable:""`
+	PoolDifficulties  []PoolDifficulty `streamable:""`
+	PeakHeight        uint32           `streamable:""` // The plot filter gets smaller at set heights
+}
+
+// ProtocolMessageType implements Payload
+func (n NewSignagePointHarvester) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewSignagePointHarvester
+}
+
+// ProofOfSpaceFeeInfo is sent by harvesters that charge a fee, with the fee threshold they applied to the proof
+type ProofOfSpaceFeeInfo struct {
+	AppliedFeeThreshold uint32 `streamable:""`
+}
+
+// NewProofOfSpace is sent from the harvester to the farmer when a plot has a proof for a signage point
+// IncludeSourceSignatureData asks the farmer to send the data being signed with request_signatures, and
+// FarmerRewardAddressOverride and FeeInfo are only set by harvesters that charge a fee
+type NewProofOfSpace struct {
+	ChallengeHash               types.Bytes32        `streamable:""`
+	SPHash                      types.Bytes32        `streamable:""`
+	PlotIdentifier              string               `streamable:""`
+	Proof                       types.ProofOfSpace   `streamable:""`
+	SignagePointIndex           uint8                `streamable:""`
+	IncludeSourceSignatureData  bool                 `streamable:""`
+	FarmerRewardAddressOverride *types.Bytes32       `streamable:"optional"`
+	FeeInfo                     *ProofOfSpaceFeeInfo `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewProofOfSpace) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewProofOfSpace
+}
+
+// SigningDataKind is the type of data in a SignatureRequestSourceData
+type SigningDataKind uint8
+
+const (
+	// SigningDataKindFoliageBlockData is a streamable FoliageBlockData
+	SigningDataKindFoliageBlockData SigningDataKind = 1
+
+	// SigningDataKindFoliageTransactionBlock is a streamable FoliageTransactionBlock
+	SigningDataKindFoliageTransactionBlock SigningDataKind = 2
+
+	// SigningDataKindChallengeChainVDF is a streamable VDFInfo from the challenge chain
+	SigningDataKindChallengeChainVDF SigningDataKind = 3
+
+	// SigningDataKindRewardChainVDF is a streamable VDFInfo from the reward chain
+	SigningDataKindRewardChainVDF SigningDataKind = 4
+
+	// SigningDataKindChallengeChainSubSlot is a streamable ChallengeChainSubSlot
+	SigningDataKindChallengeChainSubSlot SigningDataKind = 5
+
+	// SigningDataKindRewardChainSubSlot is a streamable RewardChainSubSlot
+	SigningDataKindRewardChainSubSlot SigningDataKind = 6
+
+	// SigningDataKindPartial is a pool partial
+	SigningDataKindPartial SigningDataKind = 7
+)
+
+// SignatureRequestSourceData is the data that hashes to one of the messages in RequestSignatures
+type SignatureRequestSourceData struct {
+	Kind SigningDataKind `streamable:""`
+	Data []byte          `streamable:""`
+}
+
+// RequestSignatures asks the harvester to sign messages with the local key of a plot
+// MessageData and RCBlockUnfinished are only set when the harvester asked for them in new_proof_of_space.
+// MessageData has an item for each message, which is nil if the farmer doesn't have the data for that message
+type RequestSignatures struct {
+	PlotIdentifier    string                            `streamable:""`
+	ChallengeHash     types.Bytes32                     `streamable:""`
+	SPHash            types.Bytes32                     `streamable:""`
+	Messages          []types.Bytes32                   `streamable:""`
+	MessageData       *[]*SignatureRequestSourceData    `streamable:"optional"` // Optional[List[Optional[SignatureRequestSourceData]]]
+	RCBlockUnfinished *types.RewardChainBlockUnfinished `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestSignatures) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestSignatures
+}
+
+// MessageSignature is a message and its signature
+// This represents the Tuple[bytes32, G2Element] in the Python code
+type MessageSignature struct {
+	Message   types.Bytes32   `streamable:""`
+	Signature types.G2Element `streamable:""`
+}
+
+// RespondSignatures is the response to request_signatures
+type RespondSignatures struct {
+	PlotIdentifier              string             `streamable:""`
+	ChallengeHash               types.Bytes32      `streamable:""`
+	SPHash                      types.Bytes32      `streamable:""`
+	LocalPK                     types.G1Element    `streamable:""`
+	FarmerPK                    types.G1Element    `streamable:""`
+	MessageSignatures           []MessageSignature `streamable:"tuple"` // List[Tuple[bytes32, G2Element]]
+	IncludeSourceSignatureData  bool               `streamable:""`
+	FarmerRewardAddressOverride *types.Bytes32     `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondSignatures) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondSignatures
+}
+
+// Plot is the information about a plot the harvester sends to the farmer
+type Plot struct {
+	Filename               string           `streamable:""`
+	Size                   uint8            `streamable:""`
+	PlotID                 types.Bytes32    `streamable:""`
+	PoolPublicKey          *types.G1Element `streamable:"optional"`
+	PoolContractPuzzleHash *types.Bytes32   `streamable:"optional"`
+	PlotPublicKey          types.G1Element  `streamable:""`
+	FileSize               uint64           `streamable:""`
+	TimeModified           uint64           `streamable:""`
+	CompressionLevel       *uint8           `streamable:"optional"` // nil for plots from before compression was supported
+}
+
+// RequestPlots asks the harvester for its plots
+type RequestPlots struct{}
+
+// ProtocolMessageType implements Payload
+func (r RequestPlots) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestPlots
+}
+
+// RespondPlots is the response to request_plots
+type RespondPlots struct {
+	Plots                 []Plot   `streamable:""`
+	FailedToOpenFilenames []string `streamable:""`
+	NoKeyFilenames        []string `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondPlots) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondPlots
+}
+
+// PlotSyncIdentifier identifies a plot sync message
+// Every message in a sync has the same sync ID, and the message ID counts up from 0
+type PlotSyncIdentifier struct {
+	Timestamp uint64 `streamable:""`
+	SyncID    uint64 `streamable:""`
+	MessageID uint64 `streamable:""`
+}
+
+// HarvestingMode is how the harvester decompresses plots when looking up proofs
+type HarvestingMode uint8
+
+const (
+	// HarvestingModeCPU decompresses plots on the CPU
+	HarvestingModeCPU HarvestingMode = 1
+
+	// HarvestingModeGPU decompresses plots on the GPU
+	HarvestingModeGPU HarvestingMode = 2
+)
+
+// PlotSyncStart is sent from the harvester to the farmer to start syncing the plot list
+type PlotSyncStart struct {
+	Identifier     PlotSyncIdentifier `streamable:""`
+	Initial        bool               `streamable:""`
+	LastSyncID     uint64             `streamable:""`
+	PlotFileCount  uint32             `streamable:""`
+	HarvestingMode HarvestingMode     `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncStart) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncStart
+}
+
+// PlotSyncPathList is a batch of plot paths in a plot sync
+// Chia sends it with several message types, so each of those has its own type here:
+// PlotSyncRemoved, PlotSyncInvalid, PlotSyncKeysMissing, and PlotSyncDuplicates
+type PlotSyncPathList struct {
+	Identifier PlotSyncIdentifier `streamable:""`
+	Data       []string           `streamable:""`
+	Final      bool               `streamable:""` // True for the last batch
+}
+
+// PlotSyncRemoved is the PlotSyncPathList of plots that were removed since the last sync
+type PlotSyncRemoved PlotSyncPathList
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncRemoved) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncRemoved
+}
+
+// PlotSyncInvalid is the PlotSyncPathList of plots that failed to open
+type PlotSyncInvalid PlotSyncPathList
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncInvalid) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncInvalid
+}
+
+// PlotSyncKeysMissing is the PlotSyncPathList of plots with keys the farmer doesn't have
+type PlotSyncKeysMissing PlotSyncPathList
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncKeysMissing) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncKeysMissing
+}
+
+// PlotSyncDuplicates is the PlotSyncPathList of plots that are duplicates of other plots
+type PlotSyncDuplicates PlotSyncPathList
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncDuplicates) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncDuplicates
+}
+
+// PlotSyncPlotList is a batch of plots that were loaded since the last sync
+type PlotSyncPlotList struct {
+	Identifier PlotSyncIdentifier `streamable:""`
+	Data       []Plot             `streamable:""`
+	Final      bool               `streamable:""` // True for the last batch
+}
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncPlotList) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncLoaded
+}
+
+// PlotSyncDone is sent from the harvester to the farmer at the end of a plot sync
+type PlotSyncDone struct {
+	Identifier PlotSyncIdentifier `streamable:""`
+	Duration   uint64             `streamable:""` // Seconds
+}
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncDone) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncDone
+}
+
+// PlotSyncError is the error in a plot sync response
+type PlotSyncError struct {
+	Code               int16               `streamable:""`
+	Message            string              `streamable:""`
+	ExpectedIdentifier *PlotSyncIdentifier `streamable:"optional"`
+}
+
+// PlotSyncResponse is sent from the farmer to the harvester for each plot sync message
+// MessageType is the ProtocolMessageType of the message being responded to
+type PlotSyncResponse struct {
+	Identifier  PlotSyncIdentifier `streamable:""`
+	MessageType int16              `streamable:""`
+	Error       *PlotSyncError     `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (p PlotSyncResponse) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypePlotSyncResponse
+}
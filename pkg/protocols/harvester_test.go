@@ -0,0 +1,273 @@
+package protocols_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+// strHex is the hex of a streamable str, with its length prefix
+func strHex(s string) string {
+	lengthHex := hex.EncodeToString([]byte{0, 0, 0, byte(len(s))})
+	return lengthHex + hex.EncodeToString([]byte(s))
+}
+
+// hexBytes decodes hex that is known to be valid
+func hexBytes(hexStr string) []byte {
+	b, _ := hex.DecodeString(hexStr)
+	return b
+}
+
+var (
+	// G1Element() and G2Element(), the points at infinity
+	g1InfinityHex = "c0" + rep("00", 47)
+	g1Infinity    = types.G1Element{0xc0}
+	g2InfinityHex = "c0" + rep("00", 95)
+	g2Infinity    = types.G2Element{0xc0}
+
+	// G1Element(0xa1 * 48), which isn't a valid point, but streamable doesn't care
+	g1Hex = rep("a1", 48)
+	g1, _ = types.HexStringToG1Element(g1Hex)
+
+	poolContractPuzzleHash = bytes32("33")
+
+	// ProofOfSpace(bytes32(0x44 * 32), None, bytes32(0x33 * 32), G1Element(0xa1 * 48), uint8(32), bytes(0x55 * 64))
+	proofOfSpaceHex = rep("44", 32) + "00" + "01" + rep("33", 32) + g1Hex + "20" + "00000040" + rep("55", 64)
+	proofOfSpace    = types.ProofOfSpace{
+		Challenge:              bytes32("44"),
+		PoolContractPuzzleHash: &poolContractPuzzleHash,
+		PlotPublicKey:          g1,
+		Size:                   32,
+		Proof:                  bytes.Repeat([]byte{0x55}, 64),
+	}
+
+	// Plot("/plots/plot-k32.plot", uint8(32), bytes32(0x77 * 32), None, bytes32(0x33 * 32), G1Element(0xa1 * 48), uint64(108000000000), uint64(1650000000), None)
+	plotHex = strHex("/plots/plot-k32.plot") + "20" + rep("77", 32) + "00" + "01" + rep("33", 32) + g1Hex + "00000019254d3800" + "0000000062590080" + "00"
+	plot    = protocols.Plot{
+		Filename:               "/plots/plot-k32.plot",
+		Size:                   32,
+		PlotID:                 bytes32("77"),
+		PoolContractPuzzleHash: &poolContractPuzzleHash,
+		PlotPublicKey:          g1,
+		FileSize:               108000000000,
+		TimeModified:           1650000000,
+	}
+
+	// Plot("/plots/plot-k32-c07.plot", uint8(32), bytes32(0x78 * 32), None, bytes32(0x33 * 32), G1Element(0xa1 * 48), uint64(73000000000), uint64(1650000000), uint8(7))
+	compressedPlotHex = strHex("/plots/plot-k32-c07.plot") + "20" + rep("78", 32) + "00" + "01" + rep("33", 32) + g1Hex + "00000010ff239a00" + "0000000062590080" + "01" + "07"
+	compressedPlot    = protocols.Plot{
+		Filename:               "/plots/plot-k32-c07.plot",
+		Size:                   32,
+		PlotID:                 bytes32("78"),
+		PoolContractPuzzleHash: &poolContractPuzzleHash,
+		PlotPublicKey:          g1,
+		FileSize:               73000000000,
+		TimeModified:           1650000000,
+		CompressionLevel:       util.PtrUint8(7),
+	}
+)
+
+// plotSyncIdentifier is PlotSyncIdentifier(uint64(1650000000), uint64(7), uint64(messageID))
+func plotSyncIdentifier(messageID uint64) protocols.PlotSyncIdentifier {
+	return protocols.PlotSyncIdentifier{Timestamp: 1650000000, SyncID: 7, MessageID: messageID}
+}
+
+func plotSyncIdentifierHex(messageIDHex string) string {
+	return "0000000062590080" + "0000000000000007" + "00000000000000" + messageIDHex
+}
+
+func TestHarvesterMessages(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		expected   protocols.Payload
+	}{
+		{
+			// HarvesterHandshake([G1Element(), G1Element(0xa1 * 48)], [])
+			encodedHex: "00000002" + g1InfinityHex + g1Hex + "00000000",
+			expected:   &protocols.HarvesterHandshake{FarmerPublicKeys: []types.G1Element{g1Infinity, g1}, PoolPublicKeys: []types.G1Element{}},
+		},
+		{
+			// NewSignagePointHarvester(
+			//   bytes32(0x11 * 32), uint64(2000), uint64(147849216), uint8(4), bytes32(0x22 * 32),
+			//   [PoolDifficulty(uint64(1000), uint64(147849216), bytes32(0x33 * 32))], uint32(1000000),
+			// )
+			encodedHex: rep("11", 32) + "00000000000007d0" + "0000000008d00000" + "04" + rep("22", 32) +
+				"00000001" + "00000000000003e8" + "0000000008d00000" + rep("33", 32) + "000f4240",
+			expected: &protocols.NewSignagePointHarvester{
+				ChallengeHash:     bytes32("11"),
+				Difficulty:        2000,
+				SubSlotIters:      147849216,
+				SignagePointIndex: 4,
+				SPHash:            bytes32("22"),
+				PoolDifficulties:  []protocols.PoolDifficulty{{Difficulty: 1000, SubSlotIters: 147849216, PoolContractPuzzleHash: bytes32("33")}},
+				PeakHeight:        1000000,
+			},
+		},
+		{
+			// NewProofOfSpace(bytes32(0x11 * 32), bytes32(0x22 * 32), "plot-1", ProofOfSpace(...), uint8(4), False, None, None)
+			encodedHex: rep("11", 32) + rep("22", 32) + strHex("plot-1") + proofOfSpaceHex + "04" + "00" + "00" + "00",
+			expected: &protocols.NewProofOfSpace{
+				ChallengeHash:     bytes32("11"),
+				SPHash:            bytes32("22"),
+				PlotIdentifier:    "plot-1",
+				Proof:             proofOfSpace,
+				SignagePointIndex: 4,
+			},
+		},
+		{
+			// Same as above, with True, bytes32(0xcd * 32), and ProofOfSpaceFeeInfo(uint32(1000))
+			encodedHex: rep("11", 32) + rep("22", 32) + strHex("plot-1") + proofOfSpaceHex + "04" + "01" + "01" + rep("cd", 32) + "01" + "000003e8",
+			expected: &protocols.NewProofOfSpace{
+				ChallengeHash:               bytes32("11"),
+				SPHash:                      bytes32("22"),
+				PlotIdentifier:              "plot-1",
+				Proof:                       proofOfSpace,
+				SignagePointIndex:           4,
+				IncludeSourceSignatureData:  true,
+				FarmerRewardAddressOverride: &[]types.Bytes32{bytes32("cd")}[0],
+				FeeInfo:                     &protocols.ProofOfSpaceFeeInfo{AppliedFeeThreshold: 1000},
+			},
+		},
+		{
+			// RequestSignatures("plot-1", bytes32(0x11 * 32), bytes32(0x22 * 32), [bytes32(0x66 * 32)], None, None)
+			encodedHex: strHex("plot-1") + rep("11", 32) + rep("22", 32) + "00000001" + rep("66", 32) + "00" + "00",
+			expected: &protocols.RequestSignatures{
+				PlotIdentifier: "plot-1",
+				ChallengeHash:  bytes32("11"),
+				SPHash:         bytes32("22"),
+				Messages:       []types.Bytes32{bytes32("66")},
+			},
+		},
+		{
+			// RequestSignatures(
+			//   "plot-1", bytes32(0x11 * 32), bytes32(0x22 * 32), [bytes32(0x66 * 32), bytes32(0x67 * 32)],
+			//   [SignatureRequestSourceData(uint8(SigningDataKind.FOLIAGE_BLOCK_DATA), bytes(FoliageBlockData(...))), None],
+			//   RewardChainBlockUnfinished(...),
+			// )
+			encodedHex: strHex("plot-1") + rep("11", 32) + rep("22", 32) + "00000002" + rep("66", 32) + rep("67", 32) +
+				"01" + "00000002" + "01" + "01" + "00000085" + foliageBlockDataHex + "00" + "01" + rcBlockUnfinishedHex,
+			expected: &protocols.RequestSignatures{
+				PlotIdentifier: "plot-1",
+				ChallengeHash:  bytes32("11"),
+				SPHash:         bytes32("22"),
+				Messages:       []types.Bytes32{bytes32("66"), bytes32("67")},
+				MessageData: &[]*protocols.SignatureRequestSourceData{
+					{Kind: protocols.SigningDataKindFoliageBlockData, Data: hexBytes(foliageBlockDataHex)},
+					nil,
+				},
+				RCBlockUnfinished: &rcBlockUnfinished,
+			},
+		},
+		{
+			// RespondSignatures(
+			//   "plot-1", bytes32(0x11 * 32), bytes32(0x22 * 32), G1Element(0xa1 * 48), G1Element(),
+			//   [(bytes32(0x66 * 32), G2Element())], False, None,
+			// )
+			encodedHex: strHex("plot-1") + rep("11", 32) + rep("22", 32) + g1Hex + g1InfinityHex + "00000001" + rep("66", 32) + g2InfinityHex + "00" + "00",
+			expected: &protocols.RespondSignatures{
+				PlotIdentifier:    "plot-1",
+				ChallengeHash:     bytes32("11"),
+				SPHash:            bytes32("22"),
+				LocalPK:           g1,
+				FarmerPK:          g1Infinity,
+				MessageSignatures: []protocols.MessageSignature{{Message: bytes32("66"), Signature: g2Infinity}},
+			},
+		},
+		{
+			// Same as above, with True and bytes32(0xcd * 32)
+			encodedHex: strHex("plot-1") + rep("11", 32) + rep("22", 32) + g1Hex + g1InfinityHex + "00000001" + rep("66", 32) + g2InfinityHex + "01" + "01" + rep("cd", 32),
+			expected: &protocols.RespondSignatures{
+				PlotIdentifier:              "plot-1",
+				ChallengeHash:               bytes32("11"),
+				SPHash:                      bytes32("22"),
+				LocalPK:                     g1,
+				FarmerPK:                    g1Infinity,
+				MessageSignatures:           []protocols.MessageSignature{{Message: bytes32("66"), Signature: g2Infinity}},
+				IncludeSourceSignatureData:  true,
+				FarmerRewardAddressOverride: &[]types.Bytes32{bytes32("cd")}[0],
+			},
+		},
+		{
+			// RequestPlots()
+			encodedHex: "",
+			expected:   &protocols.RequestPlots{},
+		},
+		{
+			// RespondPlots([Plot(...), Plot(...)], ["/plots/bad.plot"], [])
+			encodedHex: "00000002" + plotHex + compressedPlotHex + "00000001" + strHex("/plots/bad.plot") + "00000000",
+			expected: &protocols.RespondPlots{
+				Plots:                 []protocols.Plot{plot, compressedPlot},
+				FailedToOpenFilenames: []string{"/plots/bad.plot"},
+				NoKeyFilenames:        []string{},
+			},
+		},
+		{
+			// PlotSyncStart(PlotSyncIdentifier(uint64(1650000000), uint64(7), uint64(0)), True, uint64(6), uint32(100), uint8(HarvestingMode.GPU))
+			encodedHex: plotSyncIdentifierHex("00") + "01" + "0000000000000006" + "00000064" + "02",
+			expected: &protocols.PlotSyncStart{
+				Identifier:     plotSyncIdentifier(0),
+				Initial:        true,
+				LastSyncID:     6,
+				PlotFileCount:  100,
+				HarvestingMode: protocols.HarvestingModeGPU,
+			},
+		},
+		{
+			// PlotSyncPlotList(PlotSyncIdentifier(..., uint64(1)), [Plot(...), Plot(...)], True)
+			encodedHex: plotSyncIdentifierHex("01") + "00000002" + plotHex + compressedPlotHex + "01",
+			expected:   &protocols.PlotSyncPlotList{Identifier: plotSyncIdentifier(1), Data: []protocols.Plot{plot, compressedPlot}, Final: true},
+		},
+		{
+			// PlotSyncPathList(PlotSyncIdentifier(..., uint64(2)), ["/plots/old.plot"], True) as plot_sync_removed
+			encodedHex: plotSyncIdentifierHex("02") + "00000001" + strHex("/plots/old.plot") + "01",
+			expected:   &protocols.PlotSyncRemoved{Identifier: plotSyncIdentifier(2), Data: []string{"/plots/old.plot"}, Final: true},
+		},
+		{
+			// PlotSyncPathList(PlotSyncIdentifier(..., uint64(3)), ["/plots/bad.plot"], False) as plot_sync_invalid
+			encodedHex: plotSyncIdentifierHex("03") + "00000001" + strHex("/plots/bad.plot") + "00",
+			expected:   &protocols.PlotSyncInvalid{Identifier: plotSyncIdentifier(3), Data: []string{"/plots/bad.plot"}},
+		},
+		{
+			// PlotSyncPathList(PlotSyncIdentifier(..., uint64(4)), [], True) as plot_sync_keys_missing
+			encodedHex: plotSyncIdentifierHex("04") + "00000000" + "01",
+			expected:   &protocols.PlotSyncKeysMissing{Identifier: plotSyncIdentifier(4), Data: []string{}, Final: true},
+		},
+		{
+			// PlotSyncPathList(PlotSyncIdentifier(..., uint64(5)), ["/a", "/b"], True) as plot_sync_duplicates
+			encodedHex: plotSyncIdentifierHex("05") + "00000002" + strHex("/a") + strHex("/b") + "01",
+			expected:   &protocols.PlotSyncDuplicates{Identifier: plotSyncIdentifier(5), Data: []string{"/a", "/b"}, Final: true},
+		},
+		{
+			// PlotSyncDone(PlotSyncIdentifier(..., uint64(6)), uint64(12))
+			encodedHex: plotSyncIdentifierHex("06") + "000000000000000c",
+			expected:   &protocols.PlotSyncDone{Identifier: plotSyncIdentifier(6), Duration: 12},
+		},
+		{
+			// PlotSyncResponse(PlotSyncIdentifier(..., uint64(6)), int16(ProtocolMessageTypes.plot_sync_done.value), None)
+			encodedHex: plotSyncIdentifierHex("06") + "0054" + "00",
+			expected:   &protocols.PlotSyncResponse{Identifier: plotSyncIdentifier(6), MessageType: 84},
+		},
+		{
+			// PlotSyncResponse(
+			//   PlotSyncIdentifier(..., uint64(3)), int16(ProtocolMessageTypes.plot_sync_invalid.value),
+			//   PlotSyncError(int16(1), "invalid identifier", PlotSyncIdentifier(..., uint64(2))),
+			// )
+			encodedHex: plotSyncIdentifierHex("03") + "0051" + "01" + "0001" + strHex("invalid identifier") + "01" + plotSyncIdentifierHex("02"),
+			expected: &protocols.PlotSyncResponse{
+				Identifier:  plotSyncIdentifier(3),
+				MessageType: 81,
+				Error: &protocols.PlotSyncError{
+					Code:               1,
+					Message:            "invalid identifier",
+					ExpectedIdentifier: &[]protocols.PlotSyncIdentifier{plotSyncIdentifier(2)}[0],
+				},
+			},
+		},
+	} {
+		assertPayloadRoundTrip(t, test.encodedHex, test.expected)
+	}
+}
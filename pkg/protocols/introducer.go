@@ -0,0 +1,23 @@
+package protocols
+
+import (
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// RequestPeersIntroducer asks the introducer for peers to connect to
+type RequestPeersIntroducer struct{}
+
+// ProtocolMessageType implements Payload
+func (r RequestPeersIntroducer) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestPeersIntroducer
+}
+
+// RespondPeersIntroducer is the response to request_peers_introducer
+type RespondPeersIntroducer struct {
+	PeerList []types.TimestampedPeerInfo `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondPeersIntroducer) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondPeersIntroducer
+}
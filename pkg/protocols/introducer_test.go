@@ -0,0 +1,40 @@
+package protocols_test
+
+import (
+	"testing"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+func TestIntroducerMessages(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		expected   protocols.Payload
+	}{
+		{
+			// RequestPeersIntroducer()
+			encodedHex: "",
+			expected:   &protocols.RequestPeersIntroducer{},
+		},
+		{
+			// RespondPeersIntroducer([])
+			encodedHex: "00000000",
+			expected:   &protocols.RespondPeersIntroducer{PeerList: []types.TimestampedPeerInfo{}},
+		},
+		{
+			// RespondPeersIntroducer([TimestampedPeerInfo("127.0.0.1", uint16(8444), uint64(1650000000)), TimestampedPeerInfo("::1", uint16(58444), uint64(0))])
+			encodedHex: "00000002" +
+				strHex("127.0.0.1") + "20fc" + "0000000062590080" +
+				strHex("::1") + "e44c" + "0000000000000000",
+			expected: &protocols.RespondPeersIntroducer{
+				PeerList: []types.TimestampedPeerInfo{
+					{Host: "127.0.0.1", Port: 8444, Timestamp: 1650000000},
+					{Host: "::1", Port: 58444},
+				},
+			},
+		},
+	} {
+		assertPayloadRoundTrip(t, test.encodedHex, test.expected)
+	}
+}
@@ -1,16 +1,28 @@
 package protocols
 
 import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+
 	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
 )
 
 // Message is a protocol message
 type Message struct {
-	ProtocolMessageType ProtocolMessageType `streamable:""`
+	ProtocolMessageType ProtocolMessageType `streamable:"name=type"`
 	ID                  *uint16             `streamable:"optional"`
 	Data                []byte              `streamable:""`
 }
 
+// Payload is implemented by the types that are sent as the data of a Message
+// Each payload type is sent with exactly one message type, which lets the typed helpers
+// (MakeTypedMessage, DecodeMessageDataAs, etc) check that the data and the message type agree
+type Payload interface {
+	ProtocolMessageType() ProtocolMessageType
+}
+
 // DecodeData decodes the data in the message to the provided type
 func (m *Message) DecodeData(v interface{}) error {
 	return streamable.Unmarshal(m.Data, v)
@@ -36,21 +48,54 @@ func MakeMessage(messageType ProtocolMessageType, data interface{}) (*Message, e
 	return msg, nil
 }
 
-// MakeMessageBytes calls MakeMessage and converts everything down to bytes
+// MakeTypedMessage makes a new Message with the given data, using the message type of the payload
+func MakeTypedMessage[T Payload](data T) (*Message, error) {
+	return MakeMessage(data.ProtocolMessageType(), data)
+}
+
+// MakeTypedMessageBytes is MakeMessageBytes for a payload, using the message type of the payload
+func MakeTypedMessageBytes[T Payload](data T) ([]byte, error) {
+	return MakeMessageBytes(data.ProtocolMessageType(), data)
+}
+
+// messageHeaderSize is the size of an encoded Message without its data
+// The type (uint8), the presence byte for the ID, and the 4 byte length prefix of the data
+const messageHeaderSize = 1 + 1 + 4
+
+// MakeMessageBytes makes a new Message with the given data, and converts everything down to bytes
+// The size of the data is worked out first, so the data is encoded straight into a buffer that is allocated once
 func MakeMessageBytes(messageType ProtocolMessageType, data interface{}) ([]byte, error) {
-	msg, err := MakeMessage(messageType, data)
-	if err != nil {
-		return nil, err
+	var dataSize int
+	if data != nil {
+		var err error
+		dataSize, err = streamable.Size(data)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(dataSize) > math.MaxUint32 {
+			return nil, fmt.Errorf("message data of %d bytes is too large to encode", dataSize)
+		}
 	}
 
-	return streamable.Marshal(msg)
+	// Same as encoding a Message with no ID, without building the Message or copying the data into it
+	msgBytes := make([]byte, messageHeaderSize, messageHeaderSize+dataSize)
+	msgBytes[0] = uint8(messageType)
+	msgBytes[1] = 0 // ID is not present
+	binary.BigEndian.PutUint32(msgBytes[2:messageHeaderSize], uint32(dataSize))
+
+	if data == nil {
+		return msgBytes, nil
+	}
+
+	return streamable.MarshalAppend(msgBytes, data)
 }
 
 // DecodeMessage is a helper function to quickly decode bytes to Message
+// The bytes must be exactly one message - anything left over after the message is an error
 func DecodeMessage(bytes []byte) (*Message, error) {
 	msg := &Message{}
 
-	err := streamable.Unmarshal(bytes, msg)
+	err := streamable.UnmarshalWithOptions(bytes, msg, streamable.DecodeOptions{Strict: true})
 	if err != nil {
 		return nil, err
 	}
@@ -67,3 +112,29 @@ func DecodeMessageData(bytes []byte, v interface{}) error {
 
 	return msg.DecodeData(v)
 }
+
+// DecodeDataAs decodes the data in the message to a new T
+// T is the payload type itself, such as DecodeDataAs[RespondPeers], not a pointer to it.
+// Returns an error if the message type isn't the one T is sent with
+func DecodeDataAs[T Payload](msg *Message) (*T, error) {
+	var zero T
+	if reflect.TypeOf(&zero).Elem().Kind() == reflect.Ptr {
+		return nil, fmt.Errorf("payload type must not be a pointer, got %T", zero)
+	}
+	if msg.ProtocolMessageType != zero.ProtocolMessageType() {
+		return nil, fmt.Errorf("message type %d does not match %T (message type %d)", msg.ProtocolMessageType, zero, zero.ProtocolMessageType())
+	}
+
+	return streamable.UnmarshalAs[T](msg.Data)
+}
+
+// DecodeMessageDataAs decodes bytes to a Message, and then decodes the data of the message to a new T
+// Returns an error if the message type isn't the one T is sent with
+func DecodeMessageDataAs[T Payload](bytes []byte) (*T, error) {
+	msg, err := DecodeMessage(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeDataAs[T](msg)
+}
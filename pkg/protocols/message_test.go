@@ -2,13 +2,50 @@ package protocols_test
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
 )
 
+// rep repeats the hex for a byte n times, for writing out hashes and signatures in fixtures
+func rep(byteHex string, n int) string {
+	return strings.Repeat(byteHex, n)
+}
+
+// bytes32 returns a Bytes32 with every byte set to byteHex
+func bytes32(byteHex string) types.Bytes32 {
+	b, _ := types.HexStringToBytes32(rep(byteHex, 32))
+	return b
+}
+
+// assertPayloadRoundTrip checks that the encoded data decodes to expected through the payload registry,
+// and that expected encodes back to the same message
+//
+// The encoded hex in the message tests was written by a separate encoder that follows chia's streamable rules and
+// message field lists. None of it was produced by chia-blockchain, so it checks our layouts against that reading of
+// chia rather than against chia itself. Comments like NewPeak(bytes32(0x11 * 32), ...) only describe the values in
+// the notation of chia's constructors, so they are easy to compare with the python classes
+func assertPayloadRoundTrip(t *testing.T, encodedHex string, expected protocols.Payload) {
+	encodedBytes, err := hex.DecodeString(encodedHex)
+	assert.NoError(t, err)
+
+	msg := &protocols.Message{ProtocolMessageType: expected.ProtocolMessageType(), Data: encodedBytes}
+	payload, err := msg.DecodePayload()
+	assert.NoError(t, err, "%T", expected)
+	assert.Equal(t, expected, payload)
+
+	msgBytes, err := protocols.MakeTypedMessageBytes(expected)
+	assert.NoError(t, err)
+	expectedMsgBytes, err := streamable.Marshal(msg)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMsgBytes, msgBytes, "%T", expected)
+}
+
 func TestMakeMessage(t *testing.T) {
 
 }
@@ -21,6 +58,42 @@ func TestMakeMessage_NilData(t *testing.T) {
 	assert.Equal(t, []byte(nil), msg.Data)
 }
 
+func TestMakeMessageBytes(t *testing.T) {
+	handshake := &protocols.Handshake{
+		NetworkID:       "mainnet",
+		ProtocolVersion: protocols.ProtocolVersion,
+		SoftwareVersion: "1.2.11",
+		ServerPort:      8444,
+		NodeType:        protocols.NodeTypeFullNode,
+		Capabilities:    []protocols.Capability{{Capability: protocols.CapabilityTypeBase, Value: "1"}},
+	}
+
+	for _, data := range []interface{}{handshake, &protocols.RequestPeers{}, nil} {
+		// Should be exactly the same as making the message and then encoding it
+		msg, err := protocols.MakeMessage(protocols.ProtocolMessageTypeHandshake, data)
+		assert.NoError(t, err)
+		expected, err := streamable.Marshal(msg)
+		assert.NoError(t, err)
+
+		msgBytes, err := protocols.MakeMessageBytes(protocols.ProtocolMessageTypeHandshake, data)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, msgBytes)
+		assert.Equal(t, len(msgBytes), cap(msgBytes))
+	}
+
+	// Only the output buffer is allocated
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = protocols.MakeMessageBytes(protocols.ProtocolMessageTypeHandshake, handshake)
+	})
+	assert.Equal(t, float64(1), allocs)
+
+	// Data that can't be encoded is an error rather than a partly written message
+	_, err := protocols.MakeMessageBytes(protocols.ProtocolMessageTypeHandshake, &struct {
+		Port *uint16 `streamable:""`
+	}{})
+	assert.EqualError(t, err, "pointer is nil, but the field isn't optional")
+}
+
 func TestDecodeMessage(t *testing.T) {
 	//Message(
 	//	uint8(ProtocolMessageTypes.handshake.value),
@@ -41,6 +114,18 @@ func TestDecodeMessage(t *testing.T) {
 	assert.Equal(t, []byte("This is a sample message to decode"), msg.Data)
 }
 
+func TestDecodeMessage_TrailingBytes(t *testing.T) {
+	// Same as TestDecodeMessage, with 3 extra bytes on the end
+	encodedHex := "0100000000225468697320697320612073616d706c65206d65737361676520746f206465636f6465" + "aabbcc"
+
+	messageBytes, err := hex.DecodeString(encodedHex)
+	assert.NoError(t, err)
+
+	msg, err := protocols.DecodeMessage(messageBytes)
+	assert.Nil(t, msg)
+	assert.EqualError(t, err, "streamable: 3 unconsumed bytes after decoding, starting at offset 40")
+}
+
 func TestDecodeMessageData(t *testing.T) {
 	//Message(
 	//	uint8(ProtocolMessageTypes.handshake.value),
@@ -70,3 +155,39 @@ func TestDecodeMessageData(t *testing.T) {
 	assert.IsType(t, []protocols.Capability{}, handshake.Capabilities)
 	assert.Len(t, handshake.Capabilities, 1)
 }
+
+func TestDecodeMessageDataAs(t *testing.T) {
+	// Message containing the handshake from TestMakeMessageBytes
+	encodedHex := "01000000002d000000076d61696e6e657400000006302e302e333300000006312e322e313120fc010000000100010000000131"
+
+	messageBytes, err := hex.DecodeString(encodedHex)
+	assert.NoError(t, err)
+
+	handshake, err := protocols.DecodeMessageDataAs[protocols.Handshake](messageBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, "mainnet", handshake.NetworkID)
+	assert.Equal(t, uint16(8444), handshake.ServerPort)
+
+	// Back to the same bytes, with the message type coming from the payload
+	reencodedBytes, err := protocols.MakeTypedMessageBytes(handshake)
+	assert.NoError(t, err)
+	assert.Equal(t, messageBytes, reencodedBytes)
+
+	// The message type has to match the payload type
+	_, err = protocols.DecodeMessageDataAs[protocols.RespondPeers](messageBytes)
+	assert.EqualError(t, err, "message type 1 does not match protocols.RespondPeers (message type 44)")
+
+	_, err = protocols.DecodeMessageDataAs[*protocols.Handshake](messageBytes)
+	assert.Error(t, err)
+}
+
+func TestMakeTypedMessage(t *testing.T) {
+	msg, err := protocols.MakeTypedMessage(protocols.RequestPeers{})
+	assert.NoError(t, err)
+	assert.Equal(t, protocols.ProtocolMessageTypeRequestPeers, msg.ProtocolMessageType)
+	assert.Equal(t, []byte(nil), msg.Data)
+
+	rp, err := protocols.DecodeDataAs[protocols.RequestPeers](msg)
+	assert.NoError(t, err)
+	assert.Equal(t, &protocols.RequestPeers{}, rp)
+}
@@ -1,17 +1,494 @@
 package protocols
 
+import (
+	"fmt"
+	"sort"
+)
+
 // ProtocolMessageType corresponds to ProtocolMessageTypes in Chia
+// Source for message types is chia/protocols/protocol_message_types.py
 type ProtocolMessageType uint8
 
 const (
-	// ProtocolMessageTypeHandshake Handshake
+	// Shared protocol (all services)
+
+	// ProtocolMessageTypeHandshake handshake
 	ProtocolMessageTypeHandshake ProtocolMessageType = 1
 
-	// there are many more of these in Chia - only listing the ones current is use for now
+	// Harvester protocol (harvester <-> farmer)
+
+	// ProtocolMessageTypeHarvesterHandshake harvester_handshake
+	ProtocolMessageTypeHarvesterHandshake ProtocolMessageType = 3
+
+	// ProtocolMessageTypeNewProofOfSpace new_proof_of_space
+	ProtocolMessageTypeNewProofOfSpace ProtocolMessageType = 5
+
+	// ProtocolMessageTypeRequestSignatures request_signatures
+	ProtocolMessageTypeRequestSignatures ProtocolMessageType = 6
+
+	// ProtocolMessageTypeRespondSignatures respond_signatures
+	ProtocolMessageTypeRespondSignatures ProtocolMessageType = 7
+
+	// Farmer protocol (farmer <-> full_node)
+
+	// ProtocolMessageTypeNewSignagePoint new_signage_point
+	ProtocolMessageTypeNewSignagePoint ProtocolMessageType = 8
+
+	// ProtocolMessageTypeDeclareProofOfSpace declare_proof_of_space
+	ProtocolMessageTypeDeclareProofOfSpace ProtocolMessageType = 9
+
+	// ProtocolMessageTypeRequestSignedValues request_signed_values
+	ProtocolMessageTypeRequestSignedValues ProtocolMessageType = 10
+
+	// ProtocolMessageTypeSignedValues signed_values
+	ProtocolMessageTypeSignedValues ProtocolMessageType = 11
+
+	// ProtocolMessageTypeFarmingInfo farming_info
+	ProtocolMessageTypeFarmingInfo ProtocolMessageType = 12
+
+	// Timelord protocol (timelord <-> full_node)
+
+	// ProtocolMessageTypeNewPeakTimelord new_peak_timelord
+	ProtocolMessageTypeNewPeakTimelord ProtocolMessageType = 13
+
+	// ProtocolMessageTypeNewUnfinishedBlockTimelord new_unfinished_block_timelord
+	ProtocolMessageTypeNewUnfinishedBlockTimelord ProtocolMessageType = 14
+
+	// ProtocolMessageTypeNewInfusionPointVDF new_infusion_point_vdf
+	ProtocolMessageTypeNewInfusionPointVDF ProtocolMessageType = 15
+
+	// ProtocolMessageTypeNewSignagePointVDF new_signage_point_vdf
+	ProtocolMessageTypeNewSignagePointVDF ProtocolMessageType = 16
+
+	// ProtocolMessageTypeNewEndOfSubSlotVDF new_end_of_sub_slot_vdf
+	ProtocolMessageTypeNewEndOfSubSlotVDF ProtocolMessageType = 17
+
+	// ProtocolMessageTypeRequestCompactProofOfTime request_compact_proof_of_time
+	ProtocolMessageTypeRequestCompactProofOfTime ProtocolMessageType = 18
+
+	// ProtocolMessageTypeRespondCompactProofOfTime respond_compact_proof_of_time
+	ProtocolMessageTypeRespondCompactProofOfTime ProtocolMessageType = 19
+
+	// Full node protocol (full_node <-> full_node)
+
+	// ProtocolMessageTypeNewPeak new_peak
+	ProtocolMessageTypeNewPeak ProtocolMessageType = 20
+
+	// ProtocolMessageTypeNewTransaction new_transaction
+	ProtocolMessageTypeNewTransaction ProtocolMessageType = 21
+
+	// ProtocolMessageTypeRequestTransaction request_transaction
+	ProtocolMessageTypeRequestTransaction ProtocolMessageType = 22
+
+	// ProtocolMessageTypeRespondTransaction respond_transaction
+	ProtocolMessageTypeRespondTransaction ProtocolMessageType = 23
+
+	// ProtocolMessageTypeRequestProofOfWeight request_proof_of_weight
+	ProtocolMessageTypeRequestProofOfWeight ProtocolMessageType = 24
+
+	// ProtocolMessageTypeRespondProofOfWeight respond_proof_of_weight
+	ProtocolMessageTypeRespondProofOfWeight ProtocolMessageType = 25
+
+	// ProtocolMessageTypeRequestBlock request_block
+	ProtocolMessageTypeRequestBlock ProtocolMessageType = 26
+
+	// ProtocolMessageTypeRespondBlock respond_block
+	ProtocolMessageTypeRespondBlock ProtocolMessageType = 27
+
+	// ProtocolMessageTypeRejectBlock reject_block
+	ProtocolMessageTypeRejectBlock ProtocolMessageType = 28
+
+	// ProtocolMessageTypeRequestBlocks request_blocks
+	ProtocolMessageTypeRequestBlocks ProtocolMessageType = 29
+
+	// ProtocolMessageTypeRespondBlocks respond_blocks
+	ProtocolMessageTypeRespondBlocks ProtocolMessageType = 30
+
+	// ProtocolMessageTypeRejectBlocks reject_blocks
+	ProtocolMessageTypeRejectBlocks ProtocolMessageType = 31
+
+	// ProtocolMessageTypeNewUnfinishedBlock new_unfinished_block
+	ProtocolMessageTypeNewUnfinishedBlock ProtocolMessageType = 32
+
+	// ProtocolMessageTypeRequestUnfinishedBlock request_unfinished_block
+	ProtocolMessageTypeRequestUnfinishedBlock ProtocolMessageType = 33
+
+	// ProtocolMessageTypeRespondUnfinishedBlock respond_unfinished_block
+	ProtocolMessageTypeRespondUnfinishedBlock ProtocolMessageType = 34
+
+	// ProtocolMessageTypeNewSignagePointOrEndOfSubSlot new_signage_point_or_end_of_sub_slot
+	ProtocolMessageTypeNewSignagePointOrEndOfSubSlot ProtocolMessageType = 35
+
+	// ProtocolMessageTypeRequestSignagePointOrEndOfSubSlot request_signage_point_or_end_of_sub_slot
+	ProtocolMessageTypeRequestSignagePointOrEndOfSubSlot ProtocolMessageType = 36
+
+	// ProtocolMessageTypeRespondSignagePoint respond_signage_point
+	ProtocolMessageTypeRespondSignagePoint ProtocolMessageType = 37
+
+	// ProtocolMessageTypeRespondEndOfSubSlot respond_end_of_sub_slot
+	ProtocolMessageTypeRespondEndOfSubSlot ProtocolMessageType = 38
+
+	// ProtocolMessageTypeRequestMempoolTransactions request_mempool_transactions
+	ProtocolMessageTypeRequestMempoolTransactions ProtocolMessageType = 39
+
+	// ProtocolMessageTypeRequestCompactVDF request_compact_vdf
+	ProtocolMessageTypeRequestCompactVDF ProtocolMessageType = 40
+
+	// ProtocolMessageTypeRespondCompactVDF respond_compact_vdf
+	ProtocolMessageTypeRespondCompactVDF ProtocolMessageType = 41
+
+	// ProtocolMessageTypeNewCompactVDF new_compact_vdf
+	ProtocolMessageTypeNewCompactVDF ProtocolMessageType = 42
 
 	// ProtocolMessageTypeRequestPeers request_peers
 	ProtocolMessageTypeRequestPeers ProtocolMessageType = 43
 
 	// ProtocolMessageTypeRespondPeers respond_peers
 	ProtocolMessageTypeRespondPeers ProtocolMessageType = 44
+
+	// ProtocolMessageTypeNoneResponse none_response
+	ProtocolMessageTypeNoneResponse ProtocolMessageType = 91
+
+	// Wallet protocol (wallet <-> full_node)
+
+	// ProtocolMessageTypeRequestPuzzleSolution request_puzzle_solution
+	ProtocolMessageTypeRequestPuzzleSolution ProtocolMessageType = 45
+
+	// ProtocolMessageTypeRespondPuzzleSolution respond_puzzle_solution
+	ProtocolMessageTypeRespondPuzzleSolution ProtocolMessageType = 46
+
+	// ProtocolMessageTypeRejectPuzzleSolution reject_puzzle_solution
+	ProtocolMessageTypeRejectPuzzleSolution ProtocolMessageType = 47
+
+	// ProtocolMessageTypeSendTransaction send_transaction
+	ProtocolMessageTypeSendTransaction ProtocolMessageType = 48
+
+	// ProtocolMessageTypeTransactionAck transaction_ack
+	ProtocolMessageTypeTransactionAck ProtocolMessageType = 49
+
+	// ProtocolMessageTypeNewPeakWallet new_peak_wallet
+	ProtocolMessageTypeNewPeakWallet ProtocolMessageType = 50
+
+	// ProtocolMessageTypeRequestBlockHeader request_block_header
+	ProtocolMessageTypeRequestBlockHeader ProtocolMessageType = 51
+
+	// ProtocolMessageTypeRespondBlockHeader respond_block_header
+	ProtocolMessageTypeRespondBlockHeader ProtocolMessageType = 52
+
+	// ProtocolMessageTypeRejectHeaderRequest reject_header_request
+	ProtocolMessageTypeRejectHeaderRequest ProtocolMessageType = 53
+
+	// ProtocolMessageTypeRequestRemovals request_removals
+	ProtocolMessageTypeRequestRemovals ProtocolMessageType = 54
+
+	// ProtocolMessageTypeRespondRemovals respond_removals
+	ProtocolMessageTypeRespondRemovals ProtocolMessageType = 55
+
+	// ProtocolMessageTypeRejectRemovalsRequest reject_removals_request
+	ProtocolMessageTypeRejectRemovalsRequest ProtocolMessageType = 56
+
+	// ProtocolMessageTypeRequestAdditions request_additions
+	ProtocolMessageTypeRequestAdditions ProtocolMessageType = 57
+
+	// ProtocolMessageTypeRespondAdditions respond_additions
+	ProtocolMessageTypeRespondAdditions ProtocolMessageType = 58
+
+	// ProtocolMessageTypeRejectAdditionsRequest reject_additions_request
+	ProtocolMessageTypeRejectAdditionsRequest ProtocolMessageType = 59
+
+	// ProtocolMessageTypeRequestHeaderBlocks request_header_blocks
+	ProtocolMessageTypeRequestHeaderBlocks ProtocolMessageType = 60
+
+	// ProtocolMessageTypeRejectHeaderBlocks reject_header_blocks
+	ProtocolMessageTypeRejectHeaderBlocks ProtocolMessageType = 61
+
+	// ProtocolMessageTypeRespondHeaderBlocks respond_header_blocks
+	ProtocolMessageTypeRespondHeaderBlocks ProtocolMessageType = 62
+
+	// Introducer protocol (introducer <-> full_node)
+
+	// ProtocolMessageTypeRequestPeersIntroducer request_peers_introducer
+	ProtocolMessageTypeRequestPeersIntroducer ProtocolMessageType = 63
+
+	// ProtocolMessageTypeRespondPeersIntroducer respond_peers_introducer
+	ProtocolMessageTypeRespondPeersIntroducer ProtocolMessageType = 64
+
+	// Simulator protocol
+
+	// ProtocolMessageTypeFarmNewBlock farm_new_block
+	ProtocolMessageTypeFarmNewBlock ProtocolMessageType = 65
+
+	// New harvester protocol
+
+	// ProtocolMessageTypeNewSignagePointHarvester new_signage_point_harvester
+	ProtocolMessageTypeNewSignagePointHarvester ProtocolMessageType = 66
+
+	// ProtocolMessageTypeRequestPlots request_plots
+	ProtocolMessageTypeRequestPlots ProtocolMessageType = 67
+
+	// ProtocolMessageTypeRespondPlots respond_plots
+	ProtocolMessageTypeRespondPlots ProtocolMessageType = 68
+
+	// ProtocolMessageTypePlotSyncStart plot_sync_start
+	ProtocolMessageTypePlotSyncStart ProtocolMessageType = 78
+
+	// ProtocolMessageTypePlotSyncLoaded plot_sync_loaded
+	ProtocolMessageTypePlotSyncLoaded ProtocolMessageType = 79
+
+	// ProtocolMessageTypePlotSyncRemoved plot_sync_removed
+	ProtocolMessageTypePlotSyncRemoved ProtocolMessageType = 80
+
+	// ProtocolMessageTypePlotSyncInvalid plot_sync_invalid
+	ProtocolMessageTypePlotSyncInvalid ProtocolMessageType = 81
+
+	// ProtocolMessageTypePlotSyncKeysMissing plot_sync_keys_missing
+	ProtocolMessageTypePlotSyncKeysMissing ProtocolMessageType = 82
+
+	// ProtocolMessageTypePlotSyncDuplicates plot_sync_duplicates
+	ProtocolMessageTypePlotSyncDuplicates ProtocolMessageType = 83
+
+	// ProtocolMessageTypePlotSyncDone plot_sync_done
+	ProtocolMessageTypePlotSyncDone ProtocolMessageType = 84
+
+	// ProtocolMessageTypePlotSyncResponse plot_sync_response
+	ProtocolMessageTypePlotSyncResponse ProtocolMessageType = 85
+
+	// More wallet protocol
+
+	// ProtocolMessageTypeCoinStateUpdate coin_state_update
+	ProtocolMessageTypeCoinStateUpdate ProtocolMessageType = 69
+
+	// ProtocolMessageTypeRegisterInterestInPuzzleHash register_interest_in_puzzle_hash
+	ProtocolMessageTypeRegisterInterestInPuzzleHash ProtocolMessageType = 70
+
+	// ProtocolMessageTypeRespondToPHUpdate respond_to_ph_update
+	ProtocolMessageTypeRespondToPHUpdate ProtocolMessageType = 71
+
+	// ProtocolMessageTypeRegisterInterestInCoin register_interest_in_coin
+	ProtocolMessageTypeRegisterInterestInCoin ProtocolMessageType = 72
+
+	// ProtocolMessageTypeRespondToCoinUpdate respond_to_coin_update
+	ProtocolMessageTypeRespondToCoinUpdate ProtocolMessageType = 73
+
+	// ProtocolMessageTypeRequestChildren request_children
+	ProtocolMessageTypeRequestChildren ProtocolMessageType = 74
+
+	// ProtocolMessageTypeRespondChildren respond_children
+	ProtocolMessageTypeRespondChildren ProtocolMessageType = 75
+
+	// ProtocolMessageTypeRequestSESHashes request_ses_hashes
+	ProtocolMessageTypeRequestSESHashes ProtocolMessageType = 76
+
+	// ProtocolMessageTypeRespondSESHashes respond_ses_hashes
+	ProtocolMessageTypeRespondSESHashes ProtocolMessageType = 77
+
+	// ProtocolMessageTypeRequestBlockHeaders request_block_headers
+	ProtocolMessageTypeRequestBlockHeaders ProtocolMessageType = 86
+
+	// ProtocolMessageTypeRejectBlockHeaders reject_block_headers
+	ProtocolMessageTypeRejectBlockHeaders ProtocolMessageType = 87
+
+	// ProtocolMessageTypeRespondBlockHeaders respond_block_headers
+	ProtocolMessageTypeRespondBlockHeaders ProtocolMessageType = 88
+
+	// ProtocolMessageTypeRequestFeeEstimates request_fee_estimates
+	ProtocolMessageTypeRequestFeeEstimates ProtocolMessageType = 89
+
+	// ProtocolMessageTypeRespondFeeEstimates respond_fee_estimates
+	ProtocolMessageTypeRespondFeeEstimates ProtocolMessageType = 90
+
+	// Unfinished block protocol
+
+	// ProtocolMessageTypeNewUnfinishedBlock2 new_unfinished_block2
+	ProtocolMessageTypeNewUnfinishedBlock2 ProtocolMessageType = 92
+
+	// ProtocolMessageTypeRequestUnfinishedBlock2 request_unfinished_block2
+	ProtocolMessageTypeRequestUnfinishedBlock2 ProtocolMessageType = 93
+
+	// New wallet sync protocol
+
+	// ProtocolMessageTypeRequestRemovePuzzleSubscriptions request_remove_puzzle_subscriptions
+	ProtocolMessageTypeRequestRemovePuzzleSubscriptions ProtocolMessageType = 94
+
+	// ProtocolMessageTypeRespondRemovePuzzleSubscriptions respond_remove_puzzle_subscriptions
+	ProtocolMessageTypeRespondRemovePuzzleSubscriptions ProtocolMessageType = 95
+
+	// ProtocolMessageTypeRequestRemoveCoinSubscriptions request_remove_coin_subscriptions
+	ProtocolMessageTypeRequestRemoveCoinSubscriptions ProtocolMessageType = 96
+
+	// ProtocolMessageTypeRespondRemoveCoinSubscriptions respond_remove_coin_subscriptions
+	ProtocolMessageTypeRespondRemoveCoinSubscriptions ProtocolMessageType = 97
+
+	// ProtocolMessageTypeRequestPuzzleState request_puzzle_state
+	ProtocolMessageTypeRequestPuzzleState ProtocolMessageType = 98
+
+	// ProtocolMessageTypeRespondPuzzleState respond_puzzle_state
+	ProtocolMessageTypeRespondPuzzleState ProtocolMessageType = 99
+
+	// ProtocolMessageTypeRejectPuzzleState reject_puzzle_state
+	ProtocolMessageTypeRejectPuzzleState ProtocolMessageType = 100
+
+	// ProtocolMessageTypeRequestCoinState request_coin_state
+	ProtocolMessageTypeRequestCoinState ProtocolMessageType = 101
+
+	// ProtocolMessageTypeRespondCoinState respond_coin_state
+	ProtocolMessageTypeRespondCoinState ProtocolMessageType = 102
+
+	// ProtocolMessageTypeRejectCoinState reject_coin_state
+	ProtocolMessageTypeRejectCoinState ProtocolMessageType = 103
+
+	// Wallet protocol mempool updates
+
+	// ProtocolMessageTypeMempoolItemsAdded mempool_items_added
+	ProtocolMessageTypeMempoolItemsAdded ProtocolMessageType = 104
+
+	// ProtocolMessageTypeMempoolItemsRemoved mempool_items_removed
+	ProtocolMessageTypeMempoolItemsRemoved ProtocolMessageType = 105
+
+	// ProtocolMessageTypeRequestCostInfo request_cost_info
+	ProtocolMessageTypeRequestCostInfo ProtocolMessageType = 106
+
+	// ProtocolMessageTypeRespondCostInfo respond_cost_info
+	ProtocolMessageTypeRespondCostInfo ProtocolMessageType = 107
+
+	// Shared protocol errors
+
+	// ProtocolMessageTypeError error
+	ProtocolMessageTypeError ProtocolMessageType = 255
 )
+
+// protocolMessageTypeNames are the names of the message types in chia
+var protocolMessageTypeNames = map[ProtocolMessageType]string{
+	ProtocolMessageTypeHandshake:                         "handshake",
+	ProtocolMessageTypeHarvesterHandshake:                "harvester_handshake",
+	ProtocolMessageTypeNewProofOfSpace:                   "new_proof_of_space",
+	ProtocolMessageTypeRequestSignatures:                 "request_signatures",
+	ProtocolMessageTypeRespondSignatures:                 "respond_signatures",
+	ProtocolMessageTypeNewSignagePoint:                   "new_signage_point",
+	ProtocolMessageTypeDeclareProofOfSpace:               "declare_proof_of_space",
+	ProtocolMessageTypeRequestSignedValues:               "request_signed_values",
+	ProtocolMessageTypeSignedValues:                      "signed_values",
+	ProtocolMessageTypeFarmingInfo:                       "farming_info",
+	ProtocolMessageTypeNewPeakTimelord:                   "new_peak_timelord",
+	ProtocolMessageTypeNewUnfinishedBlockTimelord:        "new_unfinished_block_timelord",
+	ProtocolMessageTypeNewInfusionPointVDF:               "new_infusion_point_vdf",
+	ProtocolMessageTypeNewSignagePointVDF:                "new_signage_point_vdf",
+	ProtocolMessageTypeNewEndOfSubSlotVDF:                "new_end_of_sub_slot_vdf",
+	ProtocolMessageTypeRequestCompactProofOfTime:         "request_compact_proof_of_time",
+	ProtocolMessageTypeRespondCompactProofOfTime:         "respond_compact_proof_of_time",
+	ProtocolMessageTypeNewPeak:                           "new_peak",
+	ProtocolMessageTypeNewTransaction:                    "new_transaction",
+	ProtocolMessageTypeRequestTransaction:                "request_transaction",
+	ProtocolMessageTypeRespondTransaction:                "respond_transaction",
+	ProtocolMessageTypeRequestProofOfWeight:              "request_proof_of_weight",
+	ProtocolMessageTypeRespondProofOfWeight:              "respond_proof_of_weight",
+	ProtocolMessageTypeRequestBlock:                      "request_block",
+	ProtocolMessageTypeRespondBlock:                      "respond_block",
+	ProtocolMessageTypeRejectBlock:                       "reject_block",
+	ProtocolMessageTypeRequestBlocks:                     "request_blocks",
+	ProtocolMessageTypeRespondBlocks:                     "respond_blocks",
+	ProtocolMessageTypeRejectBlocks:                      "reject_blocks",
+	ProtocolMessageTypeNewUnfinishedBlock:                "new_unfinished_block",
+	ProtocolMessageTypeRequestUnfinishedBlock:            "request_unfinished_block",
+	ProtocolMessageTypeRespondUnfinishedBlock:            "respond_unfinished_block",
+	ProtocolMessageTypeNewSignagePointOrEndOfSubSlot:     "new_signage_point_or_end_of_sub_slot",
+	ProtocolMessageTypeRequestSignagePointOrEndOfSubSlot: "request_signage_point_or_end_of_sub_slot",
+	ProtocolMessageTypeRespondSignagePoint:               "respond_signage_point",
+	ProtocolMessageTypeRespondEndOfSubSlot:               "respond_end_of_sub_slot",
+	ProtocolMessageTypeRequestMempoolTransactions:        "request_mempool_transactions",
+	ProtocolMessageTypeRequestCompactVDF:                 "request_compact_vdf",
+	ProtocolMessageTypeRespondCompactVDF:                 "respond_compact_vdf",
+	ProtocolMessageTypeNewCompactVDF:                     "new_compact_vdf",
+	ProtocolMessageTypeRequestPeers:                      "request_peers",
+	ProtocolMessageTypeRespondPeers:                      "respond_peers",
+	ProtocolMessageTypeNoneResponse:                      "none_response",
+	ProtocolMessageTypeRequestPuzzleSolution:             "request_puzzle_solution",
+	ProtocolMessageTypeRespondPuzzleSolution:             "respond_puzzle_solution",
+	ProtocolMessageTypeRejectPuzzleSolution:              "reject_puzzle_solution",
+	ProtocolMessageTypeSendTransaction:                   "send_transaction",
+	ProtocolMessageTypeTransactionAck:                    "transaction_ack",
+	ProtocolMessageTypeNewPeakWallet:                     "new_peak_wallet",
+	ProtocolMessageTypeRequestBlockHeader:                "request_block_header",
+	ProtocolMessageTypeRespondBlockHeader:                "respond_block_header",
+	ProtocolMessageTypeRejectHeaderRequest:               "reject_header_request",
+	ProtocolMessageTypeRequestRemovals:                   "request_removals",
+	ProtocolMessageTypeRespondRemovals:                   "respond_removals",
+	ProtocolMessageTypeRejectRemovalsRequest:             "reject_removals_request",
+	ProtocolMessageTypeRequestAdditions:                  "request_additions",
+	ProtocolMessageTypeRespondAdditions:                  "respond_additions",
+	ProtocolMessageTypeRejectAdditionsRequest:            "reject_additions_request",
+	ProtocolMessageTypeRequestHeaderBlocks:               "request_header_blocks",
+	ProtocolMessageTypeRejectHeaderBlocks:                "reject_header_blocks",
+	ProtocolMessageTypeRespondHeaderBlocks:               "respond_header_blocks",
+	ProtocolMessageTypeRequestPeersIntroducer:            "request_peers_introducer",
+	ProtocolMessageTypeRespondPeersIntroducer:            "respond_peers_introducer",
+	ProtocolMessageTypeFarmNewBlock:                      "farm_new_block",
+	ProtocolMessageTypeNewSignagePointHarvester:          "new_signage_point_harvester",
+	ProtocolMessageTypeRequestPlots:                      "request_plots",
+	ProtocolMessageTypeRespondPlots:                      "respond_plots",
+	ProtocolMessageTypePlotSyncStart:                     "plot_sync_start",
+	ProtocolMessageTypePlotSyncLoaded:                    "plot_sync_loaded",
+	ProtocolMessageTypePlotSyncRemoved:                   "plot_sync_removed",
+	ProtocolMessageTypePlotSyncInvalid:                   "plot_sync_invalid",
+	ProtocolMessageTypePlotSyncKeysMissing:               "plot_sync_keys_missing",
+	ProtocolMessageTypePlotSyncDuplicates:                "plot_sync_duplicates",
+	ProtocolMessageTypePlotSyncDone:                      "plot_sync_done",
+	ProtocolMessageTypePlotSyncResponse:                  "plot_sync_response",
+	ProtocolMessageTypeCoinStateUpdate:                   "coin_state_update",
+	ProtocolMessageTypeRegisterInterestInPuzzleHash:      "register_interest_in_puzzle_hash",
+	ProtocolMessageTypeRespondToPHUpdate:                 "respond_to_ph_update",
+	ProtocolMessageTypeRegisterInterestInCoin:            "register_interest_in_coin",
+	ProtocolMessageTypeRespondToCoinUpdate:               "respond_to_coin_update",
+	ProtocolMessageTypeRequestChildren:                   "request_children",
+	ProtocolMessageTypeRespondChildren:                   "respond_children",
+	ProtocolMessageTypeRequestSESHashes:                  "request_ses_hashes",
+	ProtocolMessageTypeRespondSESHashes:                  "respond_ses_hashes",
+	ProtocolMessageTypeRequestBlockHeaders:               "request_block_headers",
+	ProtocolMessageTypeRejectBlockHeaders:                "reject_block_headers",
+	ProtocolMessageTypeRespondBlockHeaders:               "respond_block_headers",
+	ProtocolMessageTypeRequestFeeEstimates:               "request_fee_estimates",
+	ProtocolMessageTypeRespondFeeEstimates:               "respond_fee_estimates",
+	ProtocolMessageTypeNewUnfinishedBlock2:               "new_unfinished_block2",
+	ProtocolMessageTypeRequestUnfinishedBlock2:           "request_unfinished_block2",
+	ProtocolMessageTypeRequestRemovePuzzleSubscriptions:  "request_remove_puzzle_subscriptions",
+	ProtocolMessageTypeRespondRemovePuzzleSubscriptions:  "respond_remove_puzzle_subscriptions",
+	ProtocolMessageTypeRequestRemoveCoinSubscriptions:    "request_remove_coin_subscriptions",
+	ProtocolMessageTypeRespondRemoveCoinSubscriptions:    "respond_remove_coin_subscriptions",
+	ProtocolMessageTypeRequestPuzzleState:                "request_puzzle_state",
+	ProtocolMessageTypeRespondPuzzleState:                "respond_puzzle_state",
+	ProtocolMessageTypeRejectPuzzleState:                 "reject_puzzle_state",
+	ProtocolMessageTypeRequestCoinState:                  "request_coin_state",
+	ProtocolMessageTypeRespondCoinState:                  "respond_coin_state",
+	ProtocolMessageTypeRejectCoinState:                   "reject_coin_state",
+	ProtocolMessageTypeMempoolItemsAdded:                 "mempool_items_added",
+	ProtocolMessageTypeMempoolItemsRemoved:               "mempool_items_removed",
+	ProtocolMessageTypeRequestCostInfo:                   "request_cost_info",
+	ProtocolMessageTypeRespondCostInfo:                   "respond_cost_info",
+	ProtocolMessageTypeError:                             "error",
+}
+
+// String returns the name of the message type in chia, or ProtocolMessageType(N) for unknown types
+func (t ProtocolMessageType) String() string {
+	if name, ok := protocolMessageTypeNames[t]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("ProtocolMessageType(%d)", uint8(t))
+}
+
+// IsKnown returns true if the message type is one of the message types defined in chia
+func (t ProtocolMessageType) IsKnown() bool {
+	_, ok := protocolMessageTypeNames[t]
+	return ok
+}
+
+// ProtocolMessageTypes returns all of the message types defined in chia, in numeric order
+func ProtocolMessageTypes() []ProtocolMessageType {
+	types := make([]ProtocolMessageType, 0, len(protocolMessageTypeNames))
+	for t := range protocolMessageTypeNames {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
@@ -0,0 +1,41 @@
+package protocols_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+)
+
+func TestProtocolMessageType_String(t *testing.T) {
+	assert.Equal(t, "handshake", protocols.ProtocolMessageTypeHandshake.String())
+	assert.Equal(t, "respond_peers", protocols.ProtocolMessageTypeRespondPeers.String())
+	assert.Equal(t, "new_signage_point_or_end_of_sub_slot", protocols.ProtocolMessageType(35).String())
+	assert.Equal(t, "error", protocols.ProtocolMessageTypeError.String())
+
+	// 4 was the old new_signage_point_harvester, which moved to 66
+	assert.Equal(t, "ProtocolMessageType(4)", protocols.ProtocolMessageType(4).String())
+	assert.False(t, protocols.ProtocolMessageType(4).IsKnown())
+	assert.Equal(t, "new_signage_point_harvester", protocols.ProtocolMessageType(66).String())
+}
+
+func TestProtocolMessageTypes(t *testing.T) {
+	messageTypes := protocols.ProtocolMessageTypes()
+
+	// 1, 3, 5-107, and 255
+	assert.Len(t, messageTypes, 106)
+	assert.Equal(t, protocols.ProtocolMessageTypeHandshake, messageTypes[0])
+	assert.Equal(t, protocols.ProtocolMessageTypeError, messageTypes[len(messageTypes)-1])
+
+	names := map[string]bool{}
+	for i, messageType := range messageTypes {
+		assert.True(t, messageType.IsKnown())
+		if i > 0 {
+			assert.Less(t, messageTypes[i-1], messageType)
+		}
+
+		assert.False(t, names[messageType.String()], "duplicate name %s", messageType)
+		names[messageType.String()] = true
+	}
+}
@@ -0,0 +1,160 @@
+package protocols
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// payloadTypes maps each message type to the payload type that is sent as its data
+// Message types that are known but not listed here don't have a payload type in this library yet
+var payloadTypes = map[ProtocolMessageType]reflect.Type{}
+
+// registerPayloads adds payload types to the registry, using the message type of each payload
+// Panics if two payload types claim the same message type, since that can only be a mistake in this package
+func registerPayloads(payloads ...Payload) {
+	for _, payload := range payloads {
+		messageType := payload.ProtocolMessageType()
+		if existing, ok := payloadTypes[messageType]; ok {
+			panic(fmt.Sprintf("message type %s is already registered to %s", messageType, existing))
+		}
+
+		payloadTypes[messageType] = reflect.TypeOf(payload)
+	}
+}
+
+func init() {
+	registerPayloads(
+		// Shared protocol
+		Handshake{},
+
+		// Harvester protocol
+		HarvesterHandshake{},
+		NewSignagePointHarvester{},
+		NewProofOfSpace{},
+		RequestSignatures{},
+		RespondSignatures{},
+		RequestPlots{},
+		RespondPlots{},
+		PlotSyncStart{},
+		PlotSyncPlotList{},
+		PlotSyncRemoved{},
+		PlotSyncInvalid{},
+		PlotSyncKeysMissing{},
+		PlotSyncDuplicates{},
+		PlotSyncDone{},
+		PlotSyncResponse{},
+
+		// Farmer protocol
+		NewSignagePoint{},
+		DeclareProofOfSpace{},
+		RequestSignedValues{},
+		SignedValues{},
+		FarmingInfo{},
+
+		// Timelord protocol
+		NewPeakTimelord{},
+		NewUnfinishedBlockTimelord{},
+		NewInfusionPointVDF{},
+		NewSignagePointVDF{},
+		NewEndOfSubSlotVDF{},
+		RequestCompactProofOfTime{},
+		RespondCompactProofOfTime{},
+
+		// Full node protocol
+		NewPeak{},
+		NewTransaction{},
+		RequestTransaction{},
+		RespondTransaction{},
+		RequestProofOfWeight{},
+		RespondProofOfWeight{},
+		RequestBlock{},
+		RejectBlock{},
+		RequestBlocks{},
+		RespondBlocks{},
+		RejectBlocks{},
+		RespondBlock{},
+		NewUnfinishedBlock{},
+		RequestUnfinishedBlock{},
+		NewUnfinishedBlock2{},
+		RequestUnfinishedBlock2{},
+		RespondUnfinishedBlock{},
+		NewSignagePointOrEndOfSubSlot{},
+		RequestSignagePointOrEndOfSubSlot{},
+		RespondSignagePoint{},
+		RespondEndOfSubSlot{},
+		RequestMempoolTransactions{},
+		NewCompactVDF{},
+		RequestCompactVDF{},
+		RespondCompactVDF{},
+		RequestPeers{},
+		RespondPeers{},
+
+		// Introducer protocol
+		RequestPeersIntroducer{},
+		RespondPeersIntroducer{},
+
+		// Wallet protocol
+		RequestPuzzleSolution{},
+		RespondPuzzleSolution{},
+		RejectPuzzleSolution{},
+		SendTransaction{},
+		TransactionAck{},
+		NewPeakWallet{},
+		RequestBlockHeader{},
+		RespondBlockHeader{},
+		RejectHeaderRequest{},
+		RequestRemovals{},
+		RespondRemovals{},
+		RejectRemovalsRequest{},
+		RequestAdditions{},
+		RespondAdditions{},
+		RejectAdditionsRequest{},
+		RequestHeaderBlocks{},
+		RejectHeaderBlocks{},
+		RespondHeaderBlocks{},
+		CoinStateUpdate{},
+		RegisterForPHUpdates{},
+		RespondToPHUpdates{},
+		RegisterForCoinUpdates{},
+		RespondToCoinUpdates{},
+		RequestChildren{},
+		RespondChildren{},
+		RequestSESInfo{},
+		RespondSESInfo{},
+		RequestBlockHeaders{},
+		RejectBlockHeaders{},
+		RespondBlockHeaders{},
+		RequestFeeEstimates{},
+		RespondFeeEstimates{},
+	)
+}
+
+// NewPayload returns a pointer to a new zero value of the payload type for the message type, such as *RespondPeers
+// Returns an error if the message type is unknown, or has no payload type
+func NewPayload(messageType ProtocolMessageType) (Payload, error) {
+	payloadType, ok := payloadTypes[messageType]
+	if !ok {
+		if !messageType.IsKnown() {
+			return nil, fmt.Errorf("unknown message type %d", uint8(messageType))
+		}
+		return nil, fmt.Errorf("no payload type for message type %s (%d)", messageType, uint8(messageType))
+	}
+
+	return reflect.New(payloadType).Interface().(Payload), nil
+}
+
+// DecodePayload decodes the data in the message to the payload type for the message type
+// The returned payload is a pointer, such as *RespondPeers, which can be used in a type switch
+func (m *Message) DecodePayload() (Payload, error) {
+	payload, err := NewPayload(m.ProtocolMessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.DecodeData(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,62 @@
+package protocols_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+)
+
+func TestNewPayload(t *testing.T) {
+	for _, messageType := range protocols.ProtocolMessageTypes() {
+		payload, err := protocols.NewPayload(messageType)
+		if err != nil {
+			assert.Contains(t, err.Error(), "no payload type for message type "+messageType.String())
+			continue
+		}
+
+		// Each payload is registered under the message type it is sent with
+		assert.Equal(t, messageType, payload.ProtocolMessageType())
+	}
+
+	payload, err := protocols.NewPayload(protocols.ProtocolMessageTypeRespondPeers)
+	assert.NoError(t, err)
+	assert.IsType(t, &protocols.RespondPeers{}, payload)
+
+	_, err = protocols.NewPayload(protocols.ProtocolMessageTypeNoneResponse)
+	assert.EqualError(t, err, "no payload type for message type none_response (91)")
+
+	_, err = protocols.NewPayload(protocols.ProtocolMessageType(4))
+	assert.EqualError(t, err, "unknown message type 4")
+}
+
+func TestMessage_DecodePayload(t *testing.T) {
+	// Message containing the handshake from TestMakeMessageBytes
+	messageBytes, err := hex.DecodeString("01000000002d000000076d61696e6e657400000006302e302e333300000006312e322e313120fc010000000100010000000131")
+	assert.NoError(t, err)
+
+	msg, err := protocols.DecodeMessage(messageBytes)
+	assert.NoError(t, err)
+
+	payload, err := msg.DecodePayload()
+	assert.NoError(t, err)
+
+	switch p := payload.(type) {
+	case *protocols.Handshake:
+		assert.Equal(t, "mainnet", p.NetworkID)
+		assert.Equal(t, protocols.NodeTypeFullNode, p.NodeType)
+	default:
+		t.Errorf("unexpected payload type %T", payload)
+	}
+
+	// Bad data is reported the same way as DecodeData
+	msg.Data = msg.Data[:10]
+	_, err = msg.DecodePayload()
+	assert.Error(t, err)
+
+	msg.ProtocolMessageType = 200
+	_, err = msg.DecodePayload()
+	assert.EqualError(t, err, "unknown message type 200")
+}
@@ -51,5 +51,10 @@ type Handshake struct {
 	SoftwareVersion string       `streamable:""`
 	ServerPort      uint16       `streamable:""`
 	NodeType        NodeType     `streamable:""`
-	Capabilities    []Capability `streamable:""` // List[Tuple[uint16, str]]
+	Capabilities    []Capability `streamable:"tuple"` // List[Tuple[uint16, str]]
+}
+
+// ProtocolMessageType implements Payload
+func (h Handshake) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeHandshake
 }
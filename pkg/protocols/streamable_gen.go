@@ -0,0 +1,8245 @@
+// Code generated by streamablegen. DO NOT EDIT.
+
+package protocols
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"unsafe"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// MarshalStreamable implements streamable.Marshaler for AdditionProof
+func (s *AdditionProof) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of AdditionProof to b
+func (s *AdditionProof) AppendStreamable(b []byte) ([]byte, error) {
+	// PuzzleHash
+	b = append(b, s.PuzzleHash[:]...)
+	// Proof
+	n1 := uint32(len(s.Proof))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Proof...)
+	// CoinListProof
+	if s.CoinListProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n2 := uint32(len((*s.CoinListProof)))
+		b = append(b, byte(n2>>24), byte(n2>>16), byte(n2>>8), byte(n2))
+		b = append(b, (*s.CoinListProof)...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for AdditionProof
+func (s *AdditionProof) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PuzzleHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PuzzleHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "PuzzleHash", (*types.Bytes32)(nil))
+	}
+	copy(s.PuzzleHash[:], b)
+	d.LeaveNested()
+	// Proof
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n3); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n3); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	s.Proof = b
+	d.LeaveNested()
+	// CoinListProof
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "CoinListProof", (**[]byte)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "CoinListProof", (**[]byte)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CoinListProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CoinListProof)); err != nil {
+			return d.FieldError(err, start4, "CoinListProof", (**[]byte)(nil))
+		}
+		s.CoinListProof = new([]byte)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start4, "CoinListProof", (**[]byte)(nil))
+		}
+		n5 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n5); err != nil {
+			return d.FieldError(err, start4, "CoinListProof", (**[]byte)(nil))
+		}
+		if b, err = d.NextBytes(n5); err != nil {
+			return d.FieldError(err, start4, "CoinListProof", (**[]byte)(nil))
+		}
+		(*s.CoinListProof) = b
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "CoinListProof", (**[]byte)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Capability
+func (s *Capability) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Capability to b
+func (s *Capability) AppendStreamable(b []byte) ([]byte, error) {
+	// Capability
+	b = append(b, byte(s.Capability>>8), byte(s.Capability))
+	// Value
+	n1 := uint32(len(s.Value))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.Value)...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Capability
+func (s *Capability) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Capability
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Capability", (*CapabilityType)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start1, "Capability", (*CapabilityType)(nil))
+	}
+	s.Capability = CapabilityType(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// Value
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Value", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Value", (*string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n3); err != nil {
+		return d.FieldError(err, start2, "Value", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n3)); err != nil {
+		return d.FieldError(err, start2, "Value", (*string)(nil))
+	}
+	s.Value = string(b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for CoinStateUpdate
+func (s *CoinStateUpdate) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of CoinStateUpdate to b
+func (s *CoinStateUpdate) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// ForkHeight
+	b = append(b, byte(s.ForkHeight>>24), byte(s.ForkHeight>>16), byte(s.ForkHeight>>8), byte(s.ForkHeight))
+	// PeakHash
+	b = append(b, s.PeakHash[:]...)
+	// Items
+	n1 := uint32(len(s.Items))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Items {
+		if b, err = s.Items[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for CoinStateUpdate
+func (s *CoinStateUpdate) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// ForkHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ForkHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "ForkHeight", (*uint32)(nil))
+	}
+	s.ForkHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// PeakHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "PeakHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "PeakHash", (*types.Bytes32)(nil))
+	}
+	copy(s.PeakHash[:], b)
+	d.LeaveNested()
+	// Items
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Items", (*[]types.CoinState)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start4, "Items", (*[]types.CoinState)(nil))
+	}
+	n5 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n5, unsafe.Sizeof(s.Items[0])); err != nil {
+		return d.FieldError(err, start4, "Items", (*[]types.CoinState)(nil))
+	}
+	prealloc6 := n5
+	if prealloc6 > 1024 {
+		prealloc6 = 1024
+	}
+	s.Items = make([]types.CoinState, 0, prealloc6)
+	for i7 := uint32(0); i7 < n5; i7++ {
+		var item8 types.CoinState
+		start9 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start9, fmt.Sprintf("[%d]", i7), (*types.CoinState)(nil)), start4, "Items", (*[]types.CoinState)(nil))
+		}
+		if err = item8.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start9, fmt.Sprintf("[%d]", i7), (*types.CoinState)(nil)), start4, "Items", (*[]types.CoinState)(nil))
+		}
+		d.LeaveNested()
+		s.Items = append(s.Items, item8)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for DeclareProofOfSpace
+func (s *DeclareProofOfSpace) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of DeclareProofOfSpace to b
+func (s *DeclareProofOfSpace) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// ChallengeChainSP
+	b = append(b, s.ChallengeChainSP[:]...)
+	// SignagePointIndex
+	b = append(b, byte(s.SignagePointIndex))
+	// RewardChainSP
+	b = append(b, s.RewardChainSP[:]...)
+	// ProofOfSpace
+	if b, err = s.ProofOfSpace.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSPSignature
+	b = append(b, s.ChallengeChainSPSignature[:]...)
+	// RewardChainSPSignature
+	b = append(b, s.RewardChainSPSignature[:]...)
+	// FarmerPuzzleHash
+	b = append(b, s.FarmerPuzzleHash[:]...)
+	// PoolTarget
+	if s.PoolTarget == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.PoolTarget).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// PoolSignature
+	if s.PoolSignature == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.PoolSignature)[:]...)
+	}
+	// IncludeSignatureSourceData
+	if s.IncludeSignatureSourceData {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for DeclareProofOfSpace
+func (s *DeclareProofOfSpace) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// ChallengeChainSP
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSP", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSP", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeChainSP[:], b)
+	d.LeaveNested()
+	// SignagePointIndex
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "SignagePointIndex", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "SignagePointIndex", (*uint8)(nil))
+	}
+	s.SignagePointIndex = uint8(b[0])
+	d.LeaveNested()
+	// RewardChainSP
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "RewardChainSP", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start4, "RewardChainSP", (*types.Bytes32)(nil))
+	}
+	copy(s.RewardChainSP[:], b)
+	d.LeaveNested()
+	// ProofOfSpace
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "ProofOfSpace", (*types.ProofOfSpace)(nil))
+	}
+	if err = s.ProofOfSpace.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start5, "ProofOfSpace", (*types.ProofOfSpace)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPSignature
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "ChallengeChainSPSignature", (*types.G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start6, "ChallengeChainSPSignature", (*types.G2Element)(nil))
+	}
+	copy(s.ChallengeChainSPSignature[:], b)
+	d.LeaveNested()
+	// RewardChainSPSignature
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "RewardChainSPSignature", (*types.G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start7, "RewardChainSPSignature", (*types.G2Element)(nil))
+	}
+	copy(s.RewardChainSPSignature[:], b)
+	d.LeaveNested()
+	// FarmerPuzzleHash
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "FarmerPuzzleHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start8, "FarmerPuzzleHash", (*types.Bytes32)(nil))
+	}
+	copy(s.FarmerPuzzleHash[:], b)
+	d.LeaveNested()
+	// PoolTarget
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "PoolTarget", (**types.PoolTarget)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "PoolTarget", (**types.PoolTarget)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PoolTarget = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PoolTarget)); err != nil {
+			return d.FieldError(err, start9, "PoolTarget", (**types.PoolTarget)(nil))
+		}
+		s.PoolTarget = new(types.PoolTarget)
+		if err = (*s.PoolTarget).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start9, "PoolTarget", (**types.PoolTarget)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start9, "PoolTarget", (**types.PoolTarget)(nil))
+	}
+	d.LeaveNested()
+	// PoolSignature
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "PoolSignature", (**types.G2Element)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "PoolSignature", (**types.G2Element)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PoolSignature = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PoolSignature)); err != nil {
+			return d.FieldError(err, start10, "PoolSignature", (**types.G2Element)(nil))
+		}
+		s.PoolSignature = new(types.G2Element)
+		if b, err = d.Next(96); err != nil {
+			return d.FieldError(err, start10, "PoolSignature", (**types.G2Element)(nil))
+		}
+		copy((*s.PoolSignature)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start10, "PoolSignature", (**types.G2Element)(nil))
+	}
+	d.LeaveNested()
+	// IncludeSignatureSourceData
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "IncludeSignatureSourceData", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start11, "IncludeSignatureSourceData", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.IncludeSignatureSourceData = false
+	case 1:
+		s.IncludeSignatureSourceData = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start11, "IncludeSignatureSourceData", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for FarmingInfo
+func (s *FarmingInfo) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of FarmingInfo to b
+func (s *FarmingInfo) AppendStreamable(b []byte) ([]byte, error) {
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// SPHash
+	b = append(b, s.SPHash[:]...)
+	// Timestamp
+	b = append(b, byte(s.Timestamp>>56), byte(s.Timestamp>>48), byte(s.Timestamp>>40), byte(s.Timestamp>>32), byte(s.Timestamp>>24), byte(s.Timestamp>>16), byte(s.Timestamp>>8), byte(s.Timestamp))
+	// Passed
+	b = append(b, byte(s.Passed>>24), byte(s.Passed>>16), byte(s.Passed>>8), byte(s.Passed))
+	// Proofs
+	b = append(b, byte(s.Proofs>>24), byte(s.Proofs>>16), byte(s.Proofs>>8), byte(s.Proofs))
+	// TotalPlots
+	b = append(b, byte(s.TotalPlots>>24), byte(s.TotalPlots>>16), byte(s.TotalPlots>>8), byte(s.TotalPlots))
+	// LookupTime
+	b = append(b, byte(s.LookupTime>>56), byte(s.LookupTime>>48), byte(s.LookupTime>>40), byte(s.LookupTime>>32), byte(s.LookupTime>>24), byte(s.LookupTime>>16), byte(s.LookupTime>>8), byte(s.LookupTime))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for FarmingInfo
+func (s *FarmingInfo) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// SPHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "SPHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "SPHash", (*types.Bytes32)(nil))
+	}
+	copy(s.SPHash[:], b)
+	d.LeaveNested()
+	// Timestamp
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Timestamp", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "Timestamp", (*uint64)(nil))
+	}
+	s.Timestamp = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// Passed
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Passed", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start4, "Passed", (*uint32)(nil))
+	}
+	s.Passed = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// Proofs
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "Proofs", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start5, "Proofs", (*uint32)(nil))
+	}
+	s.Proofs = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// TotalPlots
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "TotalPlots", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start6, "TotalPlots", (*uint32)(nil))
+	}
+	s.TotalPlots = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// LookupTime
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "LookupTime", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start7, "LookupTime", (*uint64)(nil))
+	}
+	s.LookupTime = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for FeeEstimate
+func (s *FeeEstimate) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of FeeEstimate to b
+func (s *FeeEstimate) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Error
+	if s.Error == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n1 := uint32(len((*s.Error)))
+		b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+		b = append(b, string((*s.Error))...)
+	}
+	// TimeTarget
+	b = append(b, byte(s.TimeTarget>>56), byte(s.TimeTarget>>48), byte(s.TimeTarget>>40), byte(s.TimeTarget>>32), byte(s.TimeTarget>>24), byte(s.TimeTarget>>16), byte(s.TimeTarget>>8), byte(s.TimeTarget))
+	// EstimatedFeeRate
+	if b, err = s.EstimatedFeeRate.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for FeeEstimate
+func (s *FeeEstimate) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Error
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Error", (**string)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "Error", (**string)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Error = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.Error)); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		s.Error = new(string)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		n2 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n2); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		if b, err = d.Next(uint(n2)); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		(*s.Error) = string(b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start1, "Error", (**string)(nil))
+	}
+	d.LeaveNested()
+	// TimeTarget
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "TimeTarget", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "TimeTarget", (*uint64)(nil))
+	}
+	s.TimeTarget = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// EstimatedFeeRate
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "EstimatedFeeRate", (*FeeRate)(nil))
+	}
+	if err = s.EstimatedFeeRate.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "EstimatedFeeRate", (*FeeRate)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for FeeEstimateGroup
+func (s *FeeEstimateGroup) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of FeeEstimateGroup to b
+func (s *FeeEstimateGroup) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Error
+	if s.Error == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n1 := uint32(len((*s.Error)))
+		b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+		b = append(b, string((*s.Error))...)
+	}
+	// Estimates
+	n2 := uint32(len(s.Estimates))
+	b = append(b, byte(n2>>24), byte(n2>>16), byte(n2>>8), byte(n2))
+	for i3 := range s.Estimates {
+		if b, err = s.Estimates[i3].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for FeeEstimateGroup
+func (s *FeeEstimateGroup) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Error
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Error", (**string)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "Error", (**string)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Error = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.Error)); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		s.Error = new(string)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		n2 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n2); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		if b, err = d.Next(uint(n2)); err != nil {
+			return d.FieldError(err, start1, "Error", (**string)(nil))
+		}
+		(*s.Error) = string(b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start1, "Error", (**string)(nil))
+	}
+	d.LeaveNested()
+	// Estimates
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Estimates", (*[]FeeEstimate)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "Estimates", (*[]FeeEstimate)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n4, unsafe.Sizeof(s.Estimates[0])); err != nil {
+		return d.FieldError(err, start3, "Estimates", (*[]FeeEstimate)(nil))
+	}
+	prealloc5 := n4
+	if prealloc5 > 1024 {
+		prealloc5 = 1024
+	}
+	s.Estimates = make([]FeeEstimate, 0, prealloc5)
+	for i6 := uint32(0); i6 < n4; i6++ {
+		var item7 FeeEstimate
+		start8 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*FeeEstimate)(nil)), start3, "Estimates", (*[]FeeEstimate)(nil))
+		}
+		if err = item7.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*FeeEstimate)(nil)), start3, "Estimates", (*[]FeeEstimate)(nil))
+		}
+		d.LeaveNested()
+		s.Estimates = append(s.Estimates, item7)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for FeeRate
+func (s *FeeRate) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of FeeRate to b
+func (s *FeeRate) AppendStreamable(b []byte) ([]byte, error) {
+	// MojosPerCLVMCost
+	b = append(b, byte(s.MojosPerCLVMCost>>56), byte(s.MojosPerCLVMCost>>48), byte(s.MojosPerCLVMCost>>40), byte(s.MojosPerCLVMCost>>32), byte(s.MojosPerCLVMCost>>24), byte(s.MojosPerCLVMCost>>16), byte(s.MojosPerCLVMCost>>8), byte(s.MojosPerCLVMCost))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for FeeRate
+func (s *FeeRate) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// MojosPerCLVMCost
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "MojosPerCLVMCost", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start1, "MojosPerCLVMCost", (*uint64)(nil))
+	}
+	s.MojosPerCLVMCost = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Handshake
+func (s *Handshake) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Handshake to b
+func (s *Handshake) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// NetworkID
+	n1 := uint32(len(s.NetworkID))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.NetworkID)...)
+	// ProtocolVersion
+	n2 := uint32(len(s.ProtocolVersion))
+	b = append(b, byte(n2>>24), byte(n2>>16), byte(n2>>8), byte(n2))
+	b = append(b, string(s.ProtocolVersion)...)
+	// SoftwareVersion
+	n3 := uint32(len(s.SoftwareVersion))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	b = append(b, string(s.SoftwareVersion)...)
+	// ServerPort
+	b = append(b, byte(s.ServerPort>>8), byte(s.ServerPort))
+	// NodeType
+	b = append(b, byte(s.NodeType))
+	// Capabilities
+	n4 := uint32(len(s.Capabilities))
+	b = append(b, byte(n4>>24), byte(n4>>16), byte(n4>>8), byte(n4))
+	for i5 := range s.Capabilities {
+		if b, err = s.Capabilities[i5].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Handshake
+func (s *Handshake) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// NetworkID
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "NetworkID", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "NetworkID", (*string)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n2); err != nil {
+		return d.FieldError(err, start1, "NetworkID", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n2)); err != nil {
+		return d.FieldError(err, start1, "NetworkID", (*string)(nil))
+	}
+	s.NetworkID = string(b)
+	d.LeaveNested()
+	// ProtocolVersion
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "ProtocolVersion", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "ProtocolVersion", (*string)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n4); err != nil {
+		return d.FieldError(err, start3, "ProtocolVersion", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n4)); err != nil {
+		return d.FieldError(err, start3, "ProtocolVersion", (*string)(nil))
+	}
+	s.ProtocolVersion = string(b)
+	d.LeaveNested()
+	// SoftwareVersion
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "SoftwareVersion", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start5, "SoftwareVersion", (*string)(nil))
+	}
+	n6 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n6); err != nil {
+		return d.FieldError(err, start5, "SoftwareVersion", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n6)); err != nil {
+		return d.FieldError(err, start5, "SoftwareVersion", (*string)(nil))
+	}
+	s.SoftwareVersion = string(b)
+	d.LeaveNested()
+	// ServerPort
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "ServerPort", (*uint16)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start7, "ServerPort", (*uint16)(nil))
+	}
+	s.ServerPort = uint16(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// NodeType
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "NodeType", (*NodeType)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "NodeType", (*NodeType)(nil))
+	}
+	s.NodeType = NodeType(b[0])
+	d.LeaveNested()
+	// Capabilities
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Capabilities", (*[]Capability)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start9, "Capabilities", (*[]Capability)(nil))
+	}
+	n10 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n10, unsafe.Sizeof(s.Capabilities[0])); err != nil {
+		return d.FieldError(err, start9, "Capabilities", (*[]Capability)(nil))
+	}
+	prealloc11 := n10
+	if prealloc11 > 1024 {
+		prealloc11 = 1024
+	}
+	s.Capabilities = make([]Capability, 0, prealloc11)
+	for i12 := uint32(0); i12 < n10; i12++ {
+		var item13 Capability
+		start14 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start14, fmt.Sprintf("[%d]", i12), (*Capability)(nil)), start9, "Capabilities", (*[]Capability)(nil))
+		}
+		if err = item13.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start14, fmt.Sprintf("[%d]", i12), (*Capability)(nil)), start9, "Capabilities", (*[]Capability)(nil))
+		}
+		d.LeaveNested()
+		s.Capabilities = append(s.Capabilities, item13)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for HarvesterHandshake
+func (s *HarvesterHandshake) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of HarvesterHandshake to b
+func (s *HarvesterHandshake) AppendStreamable(b []byte) ([]byte, error) {
+	// FarmerPublicKeys
+	n1 := uint32(len(s.FarmerPublicKeys))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.FarmerPublicKeys {
+		b = append(b, s.FarmerPublicKeys[i2][:]...)
+	}
+	// PoolPublicKeys
+	n3 := uint32(len(s.PoolPublicKeys))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	for i4 := range s.PoolPublicKeys {
+		b = append(b, s.PoolPublicKeys[i4][:]...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for HarvesterHandshake
+func (s *HarvesterHandshake) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// FarmerPublicKeys
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "FarmerPublicKeys", (*[]types.G1Element)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "FarmerPublicKeys", (*[]types.G1Element)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.FarmerPublicKeys[0])); err != nil {
+		return d.FieldError(err, start1, "FarmerPublicKeys", (*[]types.G1Element)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.FarmerPublicKeys = make([]types.G1Element, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.G1Element
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.G1Element)(nil)), start1, "FarmerPublicKeys", (*[]types.G1Element)(nil))
+		}
+		if b, err = d.Next(48); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.G1Element)(nil)), start1, "FarmerPublicKeys", (*[]types.G1Element)(nil))
+		}
+		copy(item5[:], b)
+		d.LeaveNested()
+		s.FarmerPublicKeys = append(s.FarmerPublicKeys, item5)
+	}
+	d.LeaveNested()
+	// PoolPublicKeys
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "PoolPublicKeys", (*[]types.G1Element)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "PoolPublicKeys", (*[]types.G1Element)(nil))
+	}
+	n8 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n8, unsafe.Sizeof(s.PoolPublicKeys[0])); err != nil {
+		return d.FieldError(err, start7, "PoolPublicKeys", (*[]types.G1Element)(nil))
+	}
+	prealloc9 := n8
+	if prealloc9 > 1024 {
+		prealloc9 = 1024
+	}
+	s.PoolPublicKeys = make([]types.G1Element, 0, prealloc9)
+	for i10 := uint32(0); i10 < n8; i10++ {
+		var item11 types.G1Element
+		start12 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*types.G1Element)(nil)), start7, "PoolPublicKeys", (*[]types.G1Element)(nil))
+		}
+		if b, err = d.Next(48); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*types.G1Element)(nil)), start7, "PoolPublicKeys", (*[]types.G1Element)(nil))
+		}
+		copy(item11[:], b)
+		d.LeaveNested()
+		s.PoolPublicKeys = append(s.PoolPublicKeys, item11)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Message
+func (s *Message) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Message to b
+func (s *Message) AppendStreamable(b []byte) ([]byte, error) {
+	// ProtocolMessageType
+	b = append(b, byte(s.ProtocolMessageType))
+	// ID
+	if s.ID == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.ID)>>8), byte((*s.ID)))
+	}
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Data...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Message
+func (s *Message) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ProtocolMessageType
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ProtocolMessageType", (*ProtocolMessageType)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "ProtocolMessageType", (*ProtocolMessageType)(nil))
+	}
+	s.ProtocolMessageType = ProtocolMessageType(b[0])
+	d.LeaveNested()
+	// ID
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ID", (**uint16)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "ID", (**uint16)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ID = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ID)); err != nil {
+			return d.FieldError(err, start2, "ID", (**uint16)(nil))
+		}
+		s.ID = new(uint16)
+		if b, err = d.Next(2); err != nil {
+			return d.FieldError(err, start2, "ID", (**uint16)(nil))
+		}
+		(*s.ID) = uint16(binary.BigEndian.Uint16(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "ID", (**uint16)(nil))
+	}
+	d.LeaveNested()
+	// Data
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Data", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "Data", (*[]byte)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n4); err != nil {
+		return d.FieldError(err, start3, "Data", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n4); err != nil {
+		return d.FieldError(err, start3, "Data", (*[]byte)(nil))
+	}
+	s.Data = b
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for MessageSignature
+func (s *MessageSignature) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of MessageSignature to b
+func (s *MessageSignature) AppendStreamable(b []byte) ([]byte, error) {
+	// Message
+	b = append(b, s.Message[:]...)
+	// Signature
+	b = append(b, s.Signature[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for MessageSignature
+func (s *MessageSignature) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Message
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Message", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "Message", (*types.Bytes32)(nil))
+	}
+	copy(s.Message[:], b)
+	d.LeaveNested()
+	// Signature
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Signature", (*types.G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start2, "Signature", (*types.G2Element)(nil))
+	}
+	copy(s.Signature[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewCompactVDF
+func (s *NewCompactVDF) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewCompactVDF to b
+func (s *NewCompactVDF) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// FieldVDF
+	b = append(b, byte(s.FieldVDF))
+	// VDFInfo
+	if b, err = s.VDFInfo.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewCompactVDF
+func (s *NewCompactVDF) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// FieldVDF
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "FieldVDF", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "FieldVDF", (*uint8)(nil))
+	}
+	s.FieldVDF = uint8(b[0])
+	d.LeaveNested()
+	// VDFInfo
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	if err = s.VDFInfo.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewEndOfSubSlotVDF
+func (s *NewEndOfSubSlotVDF) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewEndOfSubSlotVDF to b
+func (s *NewEndOfSubSlotVDF) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// EndOfSubSlotBundle
+	if b, err = s.EndOfSubSlotBundle.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewEndOfSubSlotVDF
+func (s *NewEndOfSubSlotVDF) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// EndOfSubSlotBundle
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "EndOfSubSlotBundle", (*types.EndOfSubSlotBundle)(nil))
+	}
+	if err = s.EndOfSubSlotBundle.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "EndOfSubSlotBundle", (*types.EndOfSubSlotBundle)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewInfusionPointVDF
+func (s *NewInfusionPointVDF) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewInfusionPointVDF to b
+func (s *NewInfusionPointVDF) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// UnfinishedRewardHash
+	b = append(b, s.UnfinishedRewardHash[:]...)
+	// ChallengeChainIPVDF
+	if b, err = s.ChallengeChainIPVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainIPProof
+	if b, err = s.ChallengeChainIPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainIPVDF
+	if b, err = s.RewardChainIPVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainIPProof
+	if b, err = s.RewardChainIPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// InfusedChallengeChainIPVDF
+	if s.InfusedChallengeChainIPVDF == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.InfusedChallengeChainIPVDF).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// InfusedChallengeChainIPProof
+	if s.InfusedChallengeChainIPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.InfusedChallengeChainIPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewInfusionPointVDF
+func (s *NewInfusionPointVDF) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// UnfinishedRewardHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	copy(s.UnfinishedRewardHash[:], b)
+	d.LeaveNested()
+	// ChallengeChainIPVDF
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainIPVDF", (*types.VDFInfo)(nil))
+	}
+	if err = s.ChallengeChainIPVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainIPVDF", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainIPProof
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "ChallengeChainIPProof", (*types.VDFProof)(nil))
+	}
+	if err = s.ChallengeChainIPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "ChallengeChainIPProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainIPVDF
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "RewardChainIPVDF", (*types.VDFInfo)(nil))
+	}
+	if err = s.RewardChainIPVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "RewardChainIPVDF", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainIPProof
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "RewardChainIPProof", (*types.VDFProof)(nil))
+	}
+	if err = s.RewardChainIPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start5, "RewardChainIPProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChainIPVDF
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "InfusedChallengeChainIPVDF", (**types.VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "InfusedChallengeChainIPVDF", (**types.VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainIPVDF = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainIPVDF)); err != nil {
+			return d.FieldError(err, start6, "InfusedChallengeChainIPVDF", (**types.VDFInfo)(nil))
+		}
+		s.InfusedChallengeChainIPVDF = new(types.VDFInfo)
+		if err = (*s.InfusedChallengeChainIPVDF).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start6, "InfusedChallengeChainIPVDF", (**types.VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start6, "InfusedChallengeChainIPVDF", (**types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChainIPProof
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "InfusedChallengeChainIPProof", (**types.VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start7, "InfusedChallengeChainIPProof", (**types.VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainIPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainIPProof)); err != nil {
+			return d.FieldError(err, start7, "InfusedChallengeChainIPProof", (**types.VDFProof)(nil))
+		}
+		s.InfusedChallengeChainIPProof = new(types.VDFProof)
+		if err = (*s.InfusedChallengeChainIPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start7, "InfusedChallengeChainIPProof", (**types.VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start7, "InfusedChallengeChainIPProof", (**types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewPeak
+func (s *NewPeak) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewPeak to b
+func (s *NewPeak) AppendStreamable(b []byte) ([]byte, error) {
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// Weight
+	b = append(b, s.Weight.Bytes()...)
+	// ForkPointWithPreviousPeak
+	b = append(b, byte(s.ForkPointWithPreviousPeak>>24), byte(s.ForkPointWithPreviousPeak>>16), byte(s.ForkPointWithPreviousPeak>>8), byte(s.ForkPointWithPreviousPeak))
+	// UnfinishedRewardBlockHash
+	b = append(b, s.UnfinishedRewardBlockHash[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewPeak
+func (s *NewPeak) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// HeaderHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// Height
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// Weight
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Weight", (*types.Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start3, "Weight", (*types.Uint128)(nil))
+	}
+	if s.Weight, err = types.BytesToUint128(b); err != nil {
+		return d.FieldError(err, start3, "Weight", (*types.Uint128)(nil))
+	}
+	d.LeaveNested()
+	// ForkPointWithPreviousPeak
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "ForkPointWithPreviousPeak", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start4, "ForkPointWithPreviousPeak", (*uint32)(nil))
+	}
+	s.ForkPointWithPreviousPeak = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// UnfinishedRewardBlockHash
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "UnfinishedRewardBlockHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start5, "UnfinishedRewardBlockHash", (*types.Bytes32)(nil))
+	}
+	copy(s.UnfinishedRewardBlockHash[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewPeakTimelord
+func (s *NewPeakTimelord) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewPeakTimelord to b
+func (s *NewPeakTimelord) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// RewardChainBlock
+	if b, err = s.RewardChainBlock.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Difficulty
+	b = append(b, byte(s.Difficulty>>56), byte(s.Difficulty>>48), byte(s.Difficulty>>40), byte(s.Difficulty>>32), byte(s.Difficulty>>24), byte(s.Difficulty>>16), byte(s.Difficulty>>8), byte(s.Difficulty))
+	// Deficit
+	b = append(b, byte(s.Deficit))
+	// SubSlotIters
+	b = append(b, byte(s.SubSlotIters>>56), byte(s.SubSlotIters>>48), byte(s.SubSlotIters>>40), byte(s.SubSlotIters>>32), byte(s.SubSlotIters>>24), byte(s.SubSlotIters>>16), byte(s.SubSlotIters>>8), byte(s.SubSlotIters))
+	// SubEpochSummary
+	if s.SubEpochSummary == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.SubEpochSummary).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// PreviousRewardChallenges
+	n1 := uint32(len(s.PreviousRewardChallenges))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.PreviousRewardChallenges {
+		if b, err = s.PreviousRewardChallenges[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// LastChallengeSBOrEOSTotalIters
+	b = append(b, s.LastChallengeSBOrEOSTotalIters.Bytes()...)
+	// PassesSESHeightButNotYetIncluded
+	if s.PassesSESHeightButNotYetIncluded {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewPeakTimelord
+func (s *NewPeakTimelord) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// RewardChainBlock
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "RewardChainBlock", (*types.RewardChainBlock)(nil))
+	}
+	if err = s.RewardChainBlock.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "RewardChainBlock", (*types.RewardChainBlock)(nil))
+	}
+	d.LeaveNested()
+	// Difficulty
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Difficulty", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "Difficulty", (*uint64)(nil))
+	}
+	s.Difficulty = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// Deficit
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Deficit", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "Deficit", (*uint8)(nil))
+	}
+	s.Deficit = uint8(b[0])
+	d.LeaveNested()
+	// SubSlotIters
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "SubSlotIters", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start4, "SubSlotIters", (*uint64)(nil))
+	}
+	s.SubSlotIters = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SubEpochSummary
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.SubEpochSummary = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.SubEpochSummary)); err != nil {
+			return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+		}
+		s.SubEpochSummary = new(types.SubEpochSummary)
+		if err = (*s.SubEpochSummary).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+	}
+	d.LeaveNested()
+	// PreviousRewardChallenges
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "PreviousRewardChallenges", (*[]RewardChallenge)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start6, "PreviousRewardChallenges", (*[]RewardChallenge)(nil))
+	}
+	n7 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n7, unsafe.Sizeof(s.PreviousRewardChallenges[0])); err != nil {
+		return d.FieldError(err, start6, "PreviousRewardChallenges", (*[]RewardChallenge)(nil))
+	}
+	prealloc8 := n7
+	if prealloc8 > 1024 {
+		prealloc8 = 1024
+	}
+	s.PreviousRewardChallenges = make([]RewardChallenge, 0, prealloc8)
+	for i9 := uint32(0); i9 < n7; i9++ {
+		var item10 RewardChallenge
+		start11 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start11, fmt.Sprintf("[%d]", i9), (*RewardChallenge)(nil)), start6, "PreviousRewardChallenges", (*[]RewardChallenge)(nil))
+		}
+		if err = item10.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start11, fmt.Sprintf("[%d]", i9), (*RewardChallenge)(nil)), start6, "PreviousRewardChallenges", (*[]RewardChallenge)(nil))
+		}
+		d.LeaveNested()
+		s.PreviousRewardChallenges = append(s.PreviousRewardChallenges, item10)
+	}
+	d.LeaveNested()
+	// LastChallengeSBOrEOSTotalIters
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "LastChallengeSBOrEOSTotalIters", (*types.Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start12, "LastChallengeSBOrEOSTotalIters", (*types.Uint128)(nil))
+	}
+	if s.LastChallengeSBOrEOSTotalIters, err = types.BytesToUint128(b); err != nil {
+		return d.FieldError(err, start12, "LastChallengeSBOrEOSTotalIters", (*types.Uint128)(nil))
+	}
+	d.LeaveNested()
+	// PassesSESHeightButNotYetIncluded
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "PassesSESHeightButNotYetIncluded", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start13, "PassesSESHeightButNotYetIncluded", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PassesSESHeightButNotYetIncluded = false
+	case 1:
+		s.PassesSESHeightButNotYetIncluded = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start13, "PassesSESHeightButNotYetIncluded", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewPeakWallet
+func (s *NewPeakWallet) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewPeakWallet to b
+func (s *NewPeakWallet) AppendStreamable(b []byte) ([]byte, error) {
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// Weight
+	b = append(b, s.Weight.Bytes()...)
+	// ForkPointWithPreviousPeak
+	b = append(b, byte(s.ForkPointWithPreviousPeak>>24), byte(s.ForkPointWithPreviousPeak>>16), byte(s.ForkPointWithPreviousPeak>>8), byte(s.ForkPointWithPreviousPeak))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewPeakWallet
+func (s *NewPeakWallet) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// HeaderHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// Height
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// Weight
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Weight", (*types.Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start3, "Weight", (*types.Uint128)(nil))
+	}
+	if s.Weight, err = types.BytesToUint128(b); err != nil {
+		return d.FieldError(err, start3, "Weight", (*types.Uint128)(nil))
+	}
+	d.LeaveNested()
+	// ForkPointWithPreviousPeak
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "ForkPointWithPreviousPeak", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start4, "ForkPointWithPreviousPeak", (*uint32)(nil))
+	}
+	s.ForkPointWithPreviousPeak = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewProofOfSpace
+func (s *NewProofOfSpace) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewProofOfSpace to b
+func (s *NewProofOfSpace) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// SPHash
+	b = append(b, s.SPHash[:]...)
+	// PlotIdentifier
+	n1 := uint32(len(s.PlotIdentifier))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.PlotIdentifier)...)
+	// Proof
+	if b, err = s.Proof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// SignagePointIndex
+	b = append(b, byte(s.SignagePointIndex))
+	// IncludeSourceSignatureData
+	if s.IncludeSourceSignatureData {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	// FarmerRewardAddressOverride
+	if s.FarmerRewardAddressOverride == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.FarmerRewardAddressOverride)[:]...)
+	}
+	// FeeInfo
+	if s.FeeInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.FeeInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewProofOfSpace
+func (s *NewProofOfSpace) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// SPHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "SPHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "SPHash", (*types.Bytes32)(nil))
+	}
+	copy(s.SPHash[:], b)
+	d.LeaveNested()
+	// PlotIdentifier
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "PlotIdentifier", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "PlotIdentifier", (*string)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n4); err != nil {
+		return d.FieldError(err, start3, "PlotIdentifier", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n4)); err != nil {
+		return d.FieldError(err, start3, "PlotIdentifier", (*string)(nil))
+	}
+	s.PlotIdentifier = string(b)
+	d.LeaveNested()
+	// Proof
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "Proof", (*types.ProofOfSpace)(nil))
+	}
+	if err = s.Proof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start5, "Proof", (*types.ProofOfSpace)(nil))
+	}
+	d.LeaveNested()
+	// SignagePointIndex
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "SignagePointIndex", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "SignagePointIndex", (*uint8)(nil))
+	}
+	s.SignagePointIndex = uint8(b[0])
+	d.LeaveNested()
+	// IncludeSourceSignatureData
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "IncludeSourceSignatureData", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start7, "IncludeSourceSignatureData", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.IncludeSourceSignatureData = false
+	case 1:
+		s.IncludeSourceSignatureData = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start7, "IncludeSourceSignatureData", (*bool)(nil))
+	}
+	d.LeaveNested()
+	// FarmerRewardAddressOverride
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FarmerRewardAddressOverride = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FarmerRewardAddressOverride)); err != nil {
+			return d.FieldError(err, start8, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+		}
+		s.FarmerRewardAddressOverride = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start8, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+		}
+		copy((*s.FarmerRewardAddressOverride)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start8, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// FeeInfo
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "FeeInfo", (**ProofOfSpaceFeeInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "FeeInfo", (**ProofOfSpaceFeeInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FeeInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FeeInfo)); err != nil {
+			return d.FieldError(err, start9, "FeeInfo", (**ProofOfSpaceFeeInfo)(nil))
+		}
+		s.FeeInfo = new(ProofOfSpaceFeeInfo)
+		if err = (*s.FeeInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start9, "FeeInfo", (**ProofOfSpaceFeeInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start9, "FeeInfo", (**ProofOfSpaceFeeInfo)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewSignagePoint
+func (s *NewSignagePoint) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewSignagePoint to b
+func (s *NewSignagePoint) AppendStreamable(b []byte) ([]byte, error) {
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// ChallengeChainSP
+	b = append(b, s.ChallengeChainSP[:]...)
+	// RewardChainSP
+	b = append(b, s.RewardChainSP[:]...)
+	// Difficulty
+	b = append(b, byte(s.Difficulty>>56), byte(s.Difficulty>>48), byte(s.Difficulty>>40), byte(s.Difficulty>>32), byte(s.Difficulty>>24), byte(s.Difficulty>>16), byte(s.Difficulty>>8), byte(s.Difficulty))
+	// SubSlotIters
+	b = append(b, byte(s.SubSlotIters>>56), byte(s.SubSlotIters>>48), byte(s.SubSlotIters>>40), byte(s.SubSlotIters>>32), byte(s.SubSlotIters>>24), byte(s.SubSlotIters>>16), byte(s.SubSlotIters>>8), byte(s.SubSlotIters))
+	// SignagePointIndex
+	b = append(b, byte(s.SignagePointIndex))
+	// PeakHeight
+	b = append(b, byte(s.PeakHeight>>24), byte(s.PeakHeight>>16), byte(s.PeakHeight>>8), byte(s.PeakHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewSignagePoint
+func (s *NewSignagePoint) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// ChallengeChainSP
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSP", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSP", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeChainSP[:], b)
+	d.LeaveNested()
+	// RewardChainSP
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "RewardChainSP", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "RewardChainSP", (*types.Bytes32)(nil))
+	}
+	copy(s.RewardChainSP[:], b)
+	d.LeaveNested()
+	// Difficulty
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Difficulty", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start4, "Difficulty", (*uint64)(nil))
+	}
+	s.Difficulty = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SubSlotIters
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "SubSlotIters", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start5, "SubSlotIters", (*uint64)(nil))
+	}
+	s.SubSlotIters = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SignagePointIndex
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "SignagePointIndex", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "SignagePointIndex", (*uint8)(nil))
+	}
+	s.SignagePointIndex = uint8(b[0])
+	d.LeaveNested()
+	// PeakHeight
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "PeakHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "PeakHeight", (*uint32)(nil))
+	}
+	s.PeakHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewSignagePointHarvester
+func (s *NewSignagePointHarvester) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewSignagePointHarvester to b
+func (s *NewSignagePointHarvester) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// Difficulty
+	b = append(b, byte(s.Difficulty>>56), byte(s.Difficulty>>48), byte(s.Difficulty>>40), byte(s.Difficulty>>32), byte(s.Difficulty>>24), byte(s.Difficulty>>16), byte(s.Difficulty>>8), byte(s.Difficulty))
+	// SubSlotIters
+	b = append(b, byte(s.SubSlotIters>>56), byte(s.SubSlotIters>>48), byte(s.SubSlotIters>>40), byte(s.SubSlotIters>>32), byte(s.SubSlotIters>>24), byte(s.SubSlotIters>>16), byte(s.SubSlotIters>>8), byte(s.SubSlotIters))
+	// SignagePointIndex
+	b = append(b, byte(s.SignagePointIndex))
+	// SPHash
+	b = append(b, s.SPHash[:]...)
+	// PoolDifficulties
+	n1 := uint32(len(s.PoolDifficulties))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.PoolDifficulties {
+		if b, err = s.PoolDifficulties[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// PeakHeight
+	b = append(b, byte(s.PeakHeight>>24), byte(s.PeakHeight>>16), byte(s.PeakHeight>>8), byte(s.PeakHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewSignagePointHarvester
+func (s *NewSignagePointHarvester) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// Difficulty
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Difficulty", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "Difficulty", (*uint64)(nil))
+	}
+	s.Difficulty = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SubSlotIters
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "SubSlotIters", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "SubSlotIters", (*uint64)(nil))
+	}
+	s.SubSlotIters = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SignagePointIndex
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "SignagePointIndex", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "SignagePointIndex", (*uint8)(nil))
+	}
+	s.SignagePointIndex = uint8(b[0])
+	d.LeaveNested()
+	// SPHash
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "SPHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start5, "SPHash", (*types.Bytes32)(nil))
+	}
+	copy(s.SPHash[:], b)
+	d.LeaveNested()
+	// PoolDifficulties
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "PoolDifficulties", (*[]PoolDifficulty)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start6, "PoolDifficulties", (*[]PoolDifficulty)(nil))
+	}
+	n7 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n7, unsafe.Sizeof(s.PoolDifficulties[0])); err != nil {
+		return d.FieldError(err, start6, "PoolDifficulties", (*[]PoolDifficulty)(nil))
+	}
+	prealloc8 := n7
+	if prealloc8 > 1024 {
+		prealloc8 = 1024
+	}
+	s.PoolDifficulties = make([]PoolDifficulty, 0, prealloc8)
+	for i9 := uint32(0); i9 < n7; i9++ {
+		var item10 PoolDifficulty
+		start11 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start11, fmt.Sprintf("[%d]", i9), (*PoolDifficulty)(nil)), start6, "PoolDifficulties", (*[]PoolDifficulty)(nil))
+		}
+		if err = item10.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start11, fmt.Sprintf("[%d]", i9), (*PoolDifficulty)(nil)), start6, "PoolDifficulties", (*[]PoolDifficulty)(nil))
+		}
+		d.LeaveNested()
+		s.PoolDifficulties = append(s.PoolDifficulties, item10)
+	}
+	d.LeaveNested()
+	// PeakHeight
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "PeakHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start12, "PeakHeight", (*uint32)(nil))
+	}
+	s.PeakHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewSignagePointOrEndOfSubSlot
+func (s *NewSignagePointOrEndOfSubSlot) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewSignagePointOrEndOfSubSlot to b
+func (s *NewSignagePointOrEndOfSubSlot) AppendStreamable(b []byte) ([]byte, error) {
+	// PrevChallengeHash
+	if s.PrevChallengeHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.PrevChallengeHash)[:]...)
+	}
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// IndexFromChallenge
+	b = append(b, byte(s.IndexFromChallenge))
+	// LastRCInfusion
+	b = append(b, s.LastRCInfusion[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewSignagePointOrEndOfSubSlot
+func (s *NewSignagePointOrEndOfSubSlot) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PrevChallengeHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PrevChallengeHash", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "PrevChallengeHash", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PrevChallengeHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PrevChallengeHash)); err != nil {
+			return d.FieldError(err, start1, "PrevChallengeHash", (**types.Bytes32)(nil))
+		}
+		s.PrevChallengeHash = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start1, "PrevChallengeHash", (**types.Bytes32)(nil))
+		}
+		copy((*s.PrevChallengeHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start1, "PrevChallengeHash", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// IndexFromChallenge
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "IndexFromChallenge", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "IndexFromChallenge", (*uint8)(nil))
+	}
+	s.IndexFromChallenge = uint8(b[0])
+	d.LeaveNested()
+	// LastRCInfusion
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "LastRCInfusion", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start4, "LastRCInfusion", (*types.Bytes32)(nil))
+	}
+	copy(s.LastRCInfusion[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewSignagePointVDF
+func (s *NewSignagePointVDF) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewSignagePointVDF to b
+func (s *NewSignagePointVDF) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// IndexFromChallenge
+	b = append(b, byte(s.IndexFromChallenge))
+	// ChallengeChainSPVDF
+	if b, err = s.ChallengeChainSPVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSPProof
+	if b, err = s.ChallengeChainSPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainSPVDF
+	if b, err = s.RewardChainSPVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainSPProof
+	if b, err = s.RewardChainSPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewSignagePointVDF
+func (s *NewSignagePointVDF) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// IndexFromChallenge
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "IndexFromChallenge", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "IndexFromChallenge", (*uint8)(nil))
+	}
+	s.IndexFromChallenge = uint8(b[0])
+	d.LeaveNested()
+	// ChallengeChainSPVDF
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSPVDF", (*types.VDFInfo)(nil))
+	}
+	if err = s.ChallengeChainSPVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSPVDF", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPProof
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "ChallengeChainSPProof", (*types.VDFProof)(nil))
+	}
+	if err = s.ChallengeChainSPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "ChallengeChainSPProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPVDF
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "RewardChainSPVDF", (*types.VDFInfo)(nil))
+	}
+	if err = s.RewardChainSPVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "RewardChainSPVDF", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPProof
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "RewardChainSPProof", (*types.VDFProof)(nil))
+	}
+	if err = s.RewardChainSPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start5, "RewardChainSPProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewTransaction
+func (s *NewTransaction) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewTransaction to b
+func (s *NewTransaction) AppendStreamable(b []byte) ([]byte, error) {
+	// TransactionID
+	b = append(b, s.TransactionID[:]...)
+	// Cost
+	b = append(b, byte(s.Cost>>56), byte(s.Cost>>48), byte(s.Cost>>40), byte(s.Cost>>32), byte(s.Cost>>24), byte(s.Cost>>16), byte(s.Cost>>8), byte(s.Cost))
+	// Fees
+	b = append(b, byte(s.Fees>>56), byte(s.Fees>>48), byte(s.Fees>>40), byte(s.Fees>>32), byte(s.Fees>>24), byte(s.Fees>>16), byte(s.Fees>>8), byte(s.Fees))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewTransaction
+func (s *NewTransaction) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// TransactionID
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "TransactionID", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "TransactionID", (*types.Bytes32)(nil))
+	}
+	copy(s.TransactionID[:], b)
+	d.LeaveNested()
+	// Cost
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Cost", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "Cost", (*uint64)(nil))
+	}
+	s.Cost = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// Fees
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Fees", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "Fees", (*uint64)(nil))
+	}
+	s.Fees = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewUnfinishedBlock
+func (s *NewUnfinishedBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewUnfinishedBlock to b
+func (s *NewUnfinishedBlock) AppendStreamable(b []byte) ([]byte, error) {
+	// UnfinishedRewardHash
+	b = append(b, s.UnfinishedRewardHash[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewUnfinishedBlock
+func (s *NewUnfinishedBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// UnfinishedRewardHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	copy(s.UnfinishedRewardHash[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewUnfinishedBlock2
+func (s *NewUnfinishedBlock2) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewUnfinishedBlock2 to b
+func (s *NewUnfinishedBlock2) AppendStreamable(b []byte) ([]byte, error) {
+	// UnfinishedRewardHash
+	b = append(b, s.UnfinishedRewardHash[:]...)
+	// FoliageHash
+	if s.FoliageHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.FoliageHash)[:]...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewUnfinishedBlock2
+func (s *NewUnfinishedBlock2) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// UnfinishedRewardHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	copy(s.UnfinishedRewardHash[:], b)
+	d.LeaveNested()
+	// FoliageHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageHash)); err != nil {
+			return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+		}
+		s.FoliageHash = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+		}
+		copy((*s.FoliageHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for NewUnfinishedBlockTimelord
+func (s *NewUnfinishedBlockTimelord) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of NewUnfinishedBlockTimelord to b
+func (s *NewUnfinishedBlockTimelord) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// RewardChainBlock
+	if b, err = s.RewardChainBlock.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Difficulty
+	b = append(b, byte(s.Difficulty>>56), byte(s.Difficulty>>48), byte(s.Difficulty>>40), byte(s.Difficulty>>32), byte(s.Difficulty>>24), byte(s.Difficulty>>16), byte(s.Difficulty>>8), byte(s.Difficulty))
+	// SubSlotIters
+	b = append(b, byte(s.SubSlotIters>>56), byte(s.SubSlotIters>>48), byte(s.SubSlotIters>>40), byte(s.SubSlotIters>>32), byte(s.SubSlotIters>>24), byte(s.SubSlotIters>>16), byte(s.SubSlotIters>>8), byte(s.SubSlotIters))
+	// Foliage
+	if b, err = s.Foliage.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// SubEpochSummary
+	if s.SubEpochSummary == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.SubEpochSummary).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RCPrev
+	b = append(b, s.RCPrev[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for NewUnfinishedBlockTimelord
+func (s *NewUnfinishedBlockTimelord) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// RewardChainBlock
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "RewardChainBlock", (*types.RewardChainBlockUnfinished)(nil))
+	}
+	if err = s.RewardChainBlock.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "RewardChainBlock", (*types.RewardChainBlockUnfinished)(nil))
+	}
+	d.LeaveNested()
+	// Difficulty
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Difficulty", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "Difficulty", (*uint64)(nil))
+	}
+	s.Difficulty = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SubSlotIters
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "SubSlotIters", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "SubSlotIters", (*uint64)(nil))
+	}
+	s.SubSlotIters = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// Foliage
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Foliage", (*types.Foliage)(nil))
+	}
+	if err = s.Foliage.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "Foliage", (*types.Foliage)(nil))
+	}
+	d.LeaveNested()
+	// SubEpochSummary
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.SubEpochSummary = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.SubEpochSummary)); err != nil {
+			return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+		}
+		s.SubEpochSummary = new(types.SubEpochSummary)
+		if err = (*s.SubEpochSummary).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "SubEpochSummary", (**types.SubEpochSummary)(nil))
+	}
+	d.LeaveNested()
+	// RCPrev
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "RCPrev", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start6, "RCPrev", (*types.Bytes32)(nil))
+	}
+	copy(s.RCPrev[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Plot
+func (s *Plot) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Plot to b
+func (s *Plot) AppendStreamable(b []byte) ([]byte, error) {
+	// Filename
+	n1 := uint32(len(s.Filename))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.Filename)...)
+	// Size
+	b = append(b, byte(s.Size))
+	// PlotID
+	b = append(b, s.PlotID[:]...)
+	// PoolPublicKey
+	if s.PoolPublicKey == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.PoolPublicKey)[:]...)
+	}
+	// PoolContractPuzzleHash
+	if s.PoolContractPuzzleHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.PoolContractPuzzleHash)[:]...)
+	}
+	// PlotPublicKey
+	b = append(b, s.PlotPublicKey[:]...)
+	// FileSize
+	b = append(b, byte(s.FileSize>>56), byte(s.FileSize>>48), byte(s.FileSize>>40), byte(s.FileSize>>32), byte(s.FileSize>>24), byte(s.FileSize>>16), byte(s.FileSize>>8), byte(s.FileSize))
+	// TimeModified
+	b = append(b, byte(s.TimeModified>>56), byte(s.TimeModified>>48), byte(s.TimeModified>>40), byte(s.TimeModified>>32), byte(s.TimeModified>>24), byte(s.TimeModified>>16), byte(s.TimeModified>>8), byte(s.TimeModified))
+	// CompressionLevel
+	if s.CompressionLevel == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.CompressionLevel)))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Plot
+func (s *Plot) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Filename
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Filename", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Filename", (*string)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n2); err != nil {
+		return d.FieldError(err, start1, "Filename", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n2)); err != nil {
+		return d.FieldError(err, start1, "Filename", (*string)(nil))
+	}
+	s.Filename = string(b)
+	d.LeaveNested()
+	// Size
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Size", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "Size", (*uint8)(nil))
+	}
+	s.Size = uint8(b[0])
+	d.LeaveNested()
+	// PlotID
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "PlotID", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start4, "PlotID", (*types.Bytes32)(nil))
+	}
+	copy(s.PlotID[:], b)
+	d.LeaveNested()
+	// PoolPublicKey
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "PoolPublicKey", (**types.G1Element)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "PoolPublicKey", (**types.G1Element)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PoolPublicKey = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PoolPublicKey)); err != nil {
+			return d.FieldError(err, start5, "PoolPublicKey", (**types.G1Element)(nil))
+		}
+		s.PoolPublicKey = new(types.G1Element)
+		if b, err = d.Next(48); err != nil {
+			return d.FieldError(err, start5, "PoolPublicKey", (**types.G1Element)(nil))
+		}
+		copy((*s.PoolPublicKey)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "PoolPublicKey", (**types.G1Element)(nil))
+	}
+	d.LeaveNested()
+	// PoolContractPuzzleHash
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "PoolContractPuzzleHash", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "PoolContractPuzzleHash", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PoolContractPuzzleHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PoolContractPuzzleHash)); err != nil {
+			return d.FieldError(err, start6, "PoolContractPuzzleHash", (**types.Bytes32)(nil))
+		}
+		s.PoolContractPuzzleHash = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start6, "PoolContractPuzzleHash", (**types.Bytes32)(nil))
+		}
+		copy((*s.PoolContractPuzzleHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start6, "PoolContractPuzzleHash", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// PlotPublicKey
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "PlotPublicKey", (*types.G1Element)(nil))
+	}
+	if b, err = d.Next(48); err != nil {
+		return d.FieldError(err, start7, "PlotPublicKey", (*types.G1Element)(nil))
+	}
+	copy(s.PlotPublicKey[:], b)
+	d.LeaveNested()
+	// FileSize
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "FileSize", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start8, "FileSize", (*uint64)(nil))
+	}
+	s.FileSize = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// TimeModified
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "TimeModified", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start9, "TimeModified", (*uint64)(nil))
+	}
+	s.TimeModified = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// CompressionLevel
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "CompressionLevel", (**uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "CompressionLevel", (**uint8)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CompressionLevel = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CompressionLevel)); err != nil {
+			return d.FieldError(err, start10, "CompressionLevel", (**uint8)(nil))
+		}
+		s.CompressionLevel = new(uint8)
+		if b, err = d.Next(1); err != nil {
+			return d.FieldError(err, start10, "CompressionLevel", (**uint8)(nil))
+		}
+		(*s.CompressionLevel) = uint8(b[0])
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start10, "CompressionLevel", (**uint8)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncDone
+func (s *PlotSyncDone) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncDone to b
+func (s *PlotSyncDone) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Duration
+	b = append(b, byte(s.Duration>>56), byte(s.Duration>>48), byte(s.Duration>>40), byte(s.Duration>>32), byte(s.Duration>>24), byte(s.Duration>>16), byte(s.Duration>>8), byte(s.Duration))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncDone
+func (s *PlotSyncDone) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Duration
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Duration", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "Duration", (*uint64)(nil))
+	}
+	s.Duration = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncDuplicates
+func (s *PlotSyncDuplicates) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncDuplicates to b
+func (s *PlotSyncDuplicates) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Data {
+		n3 := uint32(len(s.Data[i2]))
+		b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+		b = append(b, string(s.Data[i2])...)
+	}
+	// Final
+	if s.Final {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncDuplicates
+func (s *PlotSyncDuplicates) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Data
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Data[0])); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Data = make([]string, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 string
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		n8 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n8); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n8)); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		item6 = string(b)
+		d.LeaveNested()
+		s.Data = append(s.Data, item6)
+	}
+	d.LeaveNested()
+	// Final
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Final = false
+	case 1:
+		s.Final = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncError
+func (s *PlotSyncError) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncError to b
+func (s *PlotSyncError) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Code
+	b = append(b, byte(s.Code>>8), byte(s.Code))
+	// Message
+	n1 := uint32(len(s.Message))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.Message)...)
+	// ExpectedIdentifier
+	if s.ExpectedIdentifier == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ExpectedIdentifier).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncError
+func (s *PlotSyncError) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Code
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Code", (*int16)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start1, "Code", (*int16)(nil))
+	}
+	s.Code = int16(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// Message
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Message", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Message", (*string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n3); err != nil {
+		return d.FieldError(err, start2, "Message", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n3)); err != nil {
+		return d.FieldError(err, start2, "Message", (*string)(nil))
+	}
+	s.Message = string(b)
+	d.LeaveNested()
+	// ExpectedIdentifier
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "ExpectedIdentifier", (**PlotSyncIdentifier)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "ExpectedIdentifier", (**PlotSyncIdentifier)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ExpectedIdentifier = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ExpectedIdentifier)); err != nil {
+			return d.FieldError(err, start4, "ExpectedIdentifier", (**PlotSyncIdentifier)(nil))
+		}
+		s.ExpectedIdentifier = new(PlotSyncIdentifier)
+		if err = (*s.ExpectedIdentifier).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start4, "ExpectedIdentifier", (**PlotSyncIdentifier)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "ExpectedIdentifier", (**PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncIdentifier
+func (s *PlotSyncIdentifier) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncIdentifier to b
+func (s *PlotSyncIdentifier) AppendStreamable(b []byte) ([]byte, error) {
+	// Timestamp
+	b = append(b, byte(s.Timestamp>>56), byte(s.Timestamp>>48), byte(s.Timestamp>>40), byte(s.Timestamp>>32), byte(s.Timestamp>>24), byte(s.Timestamp>>16), byte(s.Timestamp>>8), byte(s.Timestamp))
+	// SyncID
+	b = append(b, byte(s.SyncID>>56), byte(s.SyncID>>48), byte(s.SyncID>>40), byte(s.SyncID>>32), byte(s.SyncID>>24), byte(s.SyncID>>16), byte(s.SyncID>>8), byte(s.SyncID))
+	// MessageID
+	b = append(b, byte(s.MessageID>>56), byte(s.MessageID>>48), byte(s.MessageID>>40), byte(s.MessageID>>32), byte(s.MessageID>>24), byte(s.MessageID>>16), byte(s.MessageID>>8), byte(s.MessageID))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncIdentifier
+func (s *PlotSyncIdentifier) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Timestamp
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Timestamp", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start1, "Timestamp", (*uint64)(nil))
+	}
+	s.Timestamp = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SyncID
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "SyncID", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "SyncID", (*uint64)(nil))
+	}
+	s.SyncID = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// MessageID
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "MessageID", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "MessageID", (*uint64)(nil))
+	}
+	s.MessageID = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncInvalid
+func (s *PlotSyncInvalid) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncInvalid to b
+func (s *PlotSyncInvalid) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Data {
+		n3 := uint32(len(s.Data[i2]))
+		b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+		b = append(b, string(s.Data[i2])...)
+	}
+	// Final
+	if s.Final {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncInvalid
+func (s *PlotSyncInvalid) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Data
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Data[0])); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Data = make([]string, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 string
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		n8 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n8); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n8)); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		item6 = string(b)
+		d.LeaveNested()
+		s.Data = append(s.Data, item6)
+	}
+	d.LeaveNested()
+	// Final
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Final = false
+	case 1:
+		s.Final = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncKeysMissing
+func (s *PlotSyncKeysMissing) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncKeysMissing to b
+func (s *PlotSyncKeysMissing) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Data {
+		n3 := uint32(len(s.Data[i2]))
+		b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+		b = append(b, string(s.Data[i2])...)
+	}
+	// Final
+	if s.Final {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncKeysMissing
+func (s *PlotSyncKeysMissing) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Data
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Data[0])); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Data = make([]string, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 string
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		n8 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n8); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n8)); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		item6 = string(b)
+		d.LeaveNested()
+		s.Data = append(s.Data, item6)
+	}
+	d.LeaveNested()
+	// Final
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Final = false
+	case 1:
+		s.Final = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncPathList
+func (s *PlotSyncPathList) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncPathList to b
+func (s *PlotSyncPathList) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Data {
+		n3 := uint32(len(s.Data[i2]))
+		b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+		b = append(b, string(s.Data[i2])...)
+	}
+	// Final
+	if s.Final {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncPathList
+func (s *PlotSyncPathList) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Data
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Data[0])); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Data = make([]string, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 string
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		n8 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n8); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n8)); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		item6 = string(b)
+		d.LeaveNested()
+		s.Data = append(s.Data, item6)
+	}
+	d.LeaveNested()
+	// Final
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Final = false
+	case 1:
+		s.Final = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncPlotList
+func (s *PlotSyncPlotList) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncPlotList to b
+func (s *PlotSyncPlotList) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Data {
+		if b, err = s.Data[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Final
+	if s.Final {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncPlotList
+func (s *PlotSyncPlotList) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Data
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]Plot)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]Plot)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Data[0])); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]Plot)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Data = make([]Plot, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 Plot
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*Plot)(nil)), start2, "Data", (*[]Plot)(nil))
+		}
+		if err = item6.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*Plot)(nil)), start2, "Data", (*[]Plot)(nil))
+		}
+		d.LeaveNested()
+		s.Data = append(s.Data, item6)
+	}
+	d.LeaveNested()
+	// Final
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "Final", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "Final", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Final = false
+	case 1:
+		s.Final = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start8, "Final", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncRemoved
+func (s *PlotSyncRemoved) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncRemoved to b
+func (s *PlotSyncRemoved) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Data {
+		n3 := uint32(len(s.Data[i2]))
+		b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+		b = append(b, string(s.Data[i2])...)
+	}
+	// Final
+	if s.Final {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncRemoved
+func (s *PlotSyncRemoved) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Data
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Data[0])); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]string)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Data = make([]string, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 string
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		n8 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n8); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n8)); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*string)(nil)), start2, "Data", (*[]string)(nil))
+		}
+		item6 = string(b)
+		d.LeaveNested()
+		s.Data = append(s.Data, item6)
+	}
+	d.LeaveNested()
+	// Final
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Final = false
+	case 1:
+		s.Final = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start9, "Final", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncResponse
+func (s *PlotSyncResponse) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncResponse to b
+func (s *PlotSyncResponse) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// MessageType
+	b = append(b, byte(s.MessageType>>8), byte(s.MessageType))
+	// Error
+	if s.Error == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.Error).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncResponse
+func (s *PlotSyncResponse) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// MessageType
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "MessageType", (*int16)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start2, "MessageType", (*int16)(nil))
+	}
+	s.MessageType = int16(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// Error
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Error", (**PlotSyncError)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "Error", (**PlotSyncError)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Error = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.Error)); err != nil {
+			return d.FieldError(err, start3, "Error", (**PlotSyncError)(nil))
+		}
+		s.Error = new(PlotSyncError)
+		if err = (*s.Error).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start3, "Error", (**PlotSyncError)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "Error", (**PlotSyncError)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PlotSyncStart
+func (s *PlotSyncStart) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PlotSyncStart to b
+func (s *PlotSyncStart) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Identifier
+	if b, err = s.Identifier.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Initial
+	if s.Initial {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	// LastSyncID
+	b = append(b, byte(s.LastSyncID>>56), byte(s.LastSyncID>>48), byte(s.LastSyncID>>40), byte(s.LastSyncID>>32), byte(s.LastSyncID>>24), byte(s.LastSyncID>>16), byte(s.LastSyncID>>8), byte(s.LastSyncID))
+	// PlotFileCount
+	b = append(b, byte(s.PlotFileCount>>24), byte(s.PlotFileCount>>16), byte(s.PlotFileCount>>8), byte(s.PlotFileCount))
+	// HarvestingMode
+	b = append(b, byte(s.HarvestingMode))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PlotSyncStart
+func (s *PlotSyncStart) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Identifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	if err = s.Identifier.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Identifier", (*PlotSyncIdentifier)(nil))
+	}
+	d.LeaveNested()
+	// Initial
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Initial", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "Initial", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Initial = false
+	case 1:
+		s.Initial = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start2, "Initial", (*bool)(nil))
+	}
+	d.LeaveNested()
+	// LastSyncID
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "LastSyncID", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "LastSyncID", (*uint64)(nil))
+	}
+	s.LastSyncID = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// PlotFileCount
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "PlotFileCount", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start4, "PlotFileCount", (*uint32)(nil))
+	}
+	s.PlotFileCount = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HarvestingMode
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "HarvestingMode", (*HarvestingMode)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "HarvestingMode", (*HarvestingMode)(nil))
+	}
+	s.HarvestingMode = HarvestingMode(b[0])
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PoolDifficulty
+func (s *PoolDifficulty) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PoolDifficulty to b
+func (s *PoolDifficulty) AppendStreamable(b []byte) ([]byte, error) {
+	// Difficulty
+	b = append(b, byte(s.Difficulty>>56), byte(s.Difficulty>>48), byte(s.Difficulty>>40), byte(s.Difficulty>>32), byte(s.Difficulty>>24), byte(s.Difficulty>>16), byte(s.Difficulty>>8), byte(s.Difficulty))
+	// SubSlotIters
+	b = append(b, byte(s.SubSlotIters>>56), byte(s.SubSlotIters>>48), byte(s.SubSlotIters>>40), byte(s.SubSlotIters>>32), byte(s.SubSlotIters>>24), byte(s.SubSlotIters>>16), byte(s.SubSlotIters>>8), byte(s.SubSlotIters))
+	// PoolContractPuzzleHash
+	b = append(b, s.PoolContractPuzzleHash[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PoolDifficulty
+func (s *PoolDifficulty) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Difficulty
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Difficulty", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start1, "Difficulty", (*uint64)(nil))
+	}
+	s.Difficulty = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// SubSlotIters
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "SubSlotIters", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "SubSlotIters", (*uint64)(nil))
+	}
+	s.SubSlotIters = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// PoolContractPuzzleHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "PoolContractPuzzleHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "PoolContractPuzzleHash", (*types.Bytes32)(nil))
+	}
+	copy(s.PoolContractPuzzleHash[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for ProofOfSpaceFeeInfo
+func (s *ProofOfSpaceFeeInfo) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of ProofOfSpaceFeeInfo to b
+func (s *ProofOfSpaceFeeInfo) AppendStreamable(b []byte) ([]byte, error) {
+	// AppliedFeeThreshold
+	b = append(b, byte(s.AppliedFeeThreshold>>24), byte(s.AppliedFeeThreshold>>16), byte(s.AppliedFeeThreshold>>8), byte(s.AppliedFeeThreshold))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for ProofOfSpaceFeeInfo
+func (s *ProofOfSpaceFeeInfo) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// AppliedFeeThreshold
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "AppliedFeeThreshold", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "AppliedFeeThreshold", (*uint32)(nil))
+	}
+	s.AppliedFeeThreshold = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PuzzleHashCoins
+func (s *PuzzleHashCoins) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PuzzleHashCoins to b
+func (s *PuzzleHashCoins) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// PuzzleHash
+	b = append(b, s.PuzzleHash[:]...)
+	// Coins
+	n1 := uint32(len(s.Coins))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Coins {
+		if b, err = s.Coins[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PuzzleHashCoins
+func (s *PuzzleHashCoins) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PuzzleHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PuzzleHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "PuzzleHash", (*types.Bytes32)(nil))
+	}
+	copy(s.PuzzleHash[:], b)
+	d.LeaveNested()
+	// Coins
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Coins", (*[]types.Coin)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Coins", (*[]types.Coin)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Coins[0])); err != nil {
+		return d.FieldError(err, start2, "Coins", (*[]types.Coin)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Coins = make([]types.Coin, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 types.Coin
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*types.Coin)(nil)), start2, "Coins", (*[]types.Coin)(nil))
+		}
+		if err = item6.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*types.Coin)(nil)), start2, "Coins", (*[]types.Coin)(nil))
+		}
+		d.LeaveNested()
+		s.Coins = append(s.Coins, item6)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PuzzleSolutionResponse
+func (s *PuzzleSolutionResponse) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PuzzleSolutionResponse to b
+func (s *PuzzleSolutionResponse) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// CoinName
+	b = append(b, s.CoinName[:]...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// Puzzle
+	var custom1 []byte
+	if custom1, err = s.Puzzle.MarshalStreamable(); err != nil {
+		return nil, err
+	}
+	b = append(b, custom1...)
+	// Solution
+	var custom2 []byte
+	if custom2, err = s.Solution.MarshalStreamable(); err != nil {
+		return nil, err
+	}
+	b = append(b, custom2...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PuzzleSolutionResponse
+func (s *PuzzleSolutionResponse) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinName
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	copy(s.CoinName[:], b)
+	d.LeaveNested()
+	// Height
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// Puzzle
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Puzzle", (*types.SerializedProgram)(nil))
+	}
+	if err = s.Puzzle.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "Puzzle", (*types.SerializedProgram)(nil))
+	}
+	d.LeaveNested()
+	// Solution
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Solution", (*types.SerializedProgram)(nil))
+	}
+	if err = s.Solution.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "Solution", (*types.SerializedProgram)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RegisterForCoinUpdates
+func (s *RegisterForCoinUpdates) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RegisterForCoinUpdates to b
+func (s *RegisterForCoinUpdates) AppendStreamable(b []byte) ([]byte, error) {
+	// CoinIDs
+	n1 := uint32(len(s.CoinIDs))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.CoinIDs {
+		b = append(b, s.CoinIDs[i2][:]...)
+	}
+	// MinHeight
+	b = append(b, byte(s.MinHeight>>24), byte(s.MinHeight>>16), byte(s.MinHeight>>8), byte(s.MinHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RegisterForCoinUpdates
+func (s *RegisterForCoinUpdates) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinIDs
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinIDs", (*[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "CoinIDs", (*[]types.Bytes32)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.CoinIDs[0])); err != nil {
+		return d.FieldError(err, start1, "CoinIDs", (*[]types.Bytes32)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.CoinIDs = make([]types.Bytes32, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.Bytes32
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "CoinIDs", (*[]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "CoinIDs", (*[]types.Bytes32)(nil))
+		}
+		copy(item5[:], b)
+		d.LeaveNested()
+		s.CoinIDs = append(s.CoinIDs, item5)
+	}
+	d.LeaveNested()
+	// MinHeight
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	s.MinHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RegisterForPHUpdates
+func (s *RegisterForPHUpdates) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RegisterForPHUpdates to b
+func (s *RegisterForPHUpdates) AppendStreamable(b []byte) ([]byte, error) {
+	// PuzzleHashes
+	n1 := uint32(len(s.PuzzleHashes))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.PuzzleHashes {
+		b = append(b, s.PuzzleHashes[i2][:]...)
+	}
+	// MinHeight
+	b = append(b, byte(s.MinHeight>>24), byte(s.MinHeight>>16), byte(s.MinHeight>>8), byte(s.MinHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RegisterForPHUpdates
+func (s *RegisterForPHUpdates) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PuzzleHashes
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.PuzzleHashes[0])); err != nil {
+		return d.FieldError(err, start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.PuzzleHashes = make([]types.Bytes32, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.Bytes32
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+		}
+		copy(item5[:], b)
+		d.LeaveNested()
+		s.PuzzleHashes = append(s.PuzzleHashes, item5)
+	}
+	d.LeaveNested()
+	// MinHeight
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	s.MinHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectAdditionsRequest
+func (s *RejectAdditionsRequest) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectAdditionsRequest to b
+func (s *RejectAdditionsRequest) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectAdditionsRequest
+func (s *RejectAdditionsRequest) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectBlock
+func (s *RejectBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectBlock to b
+func (s *RejectBlock) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectBlock
+func (s *RejectBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectBlockHeaders
+func (s *RejectBlockHeaders) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectBlockHeaders to b
+func (s *RejectBlockHeaders) AppendStreamable(b []byte) ([]byte, error) {
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectBlockHeaders
+func (s *RejectBlockHeaders) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectBlocks
+func (s *RejectBlocks) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectBlocks to b
+func (s *RejectBlocks) AppendStreamable(b []byte) ([]byte, error) {
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectBlocks
+func (s *RejectBlocks) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectHeaderBlocks
+func (s *RejectHeaderBlocks) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectHeaderBlocks to b
+func (s *RejectHeaderBlocks) AppendStreamable(b []byte) ([]byte, error) {
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectHeaderBlocks
+func (s *RejectHeaderBlocks) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectHeaderRequest
+func (s *RejectHeaderRequest) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectHeaderRequest to b
+func (s *RejectHeaderRequest) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectHeaderRequest
+func (s *RejectHeaderRequest) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectPuzzleSolution
+func (s *RejectPuzzleSolution) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectPuzzleSolution to b
+func (s *RejectPuzzleSolution) AppendStreamable(b []byte) ([]byte, error) {
+	// CoinName
+	b = append(b, s.CoinName[:]...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectPuzzleSolution
+func (s *RejectPuzzleSolution) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinName
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	copy(s.CoinName[:], b)
+	d.LeaveNested()
+	// Height
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RejectRemovalsRequest
+func (s *RejectRemovalsRequest) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RejectRemovalsRequest to b
+func (s *RejectRemovalsRequest) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RejectRemovalsRequest
+func (s *RejectRemovalsRequest) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RemovalProof
+func (s *RemovalProof) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RemovalProof to b
+func (s *RemovalProof) AppendStreamable(b []byte) ([]byte, error) {
+	// CoinName
+	b = append(b, s.CoinName[:]...)
+	// Proof
+	n1 := uint32(len(s.Proof))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Proof...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RemovalProof
+func (s *RemovalProof) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinName
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	copy(s.CoinName[:], b)
+	d.LeaveNested()
+	// Proof
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n3); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n3); err != nil {
+		return d.FieldError(err, start2, "Proof", (*[]byte)(nil))
+	}
+	s.Proof = b
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RemovedCoin
+func (s *RemovedCoin) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RemovedCoin to b
+func (s *RemovedCoin) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// CoinName
+	b = append(b, s.CoinName[:]...)
+	// Coin
+	if s.Coin == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.Coin).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RemovedCoin
+func (s *RemovedCoin) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinName
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	copy(s.CoinName[:], b)
+	d.LeaveNested()
+	// Coin
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Coin", (**types.Coin)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "Coin", (**types.Coin)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Coin = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.Coin)); err != nil {
+			return d.FieldError(err, start2, "Coin", (**types.Coin)(nil))
+		}
+		s.Coin = new(types.Coin)
+		if err = (*s.Coin).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start2, "Coin", (**types.Coin)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "Coin", (**types.Coin)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestAdditions
+func (s *RequestAdditions) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestAdditions to b
+func (s *RequestAdditions) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	if s.HeaderHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.HeaderHash)[:]...)
+	}
+	// PuzzleHashes
+	if s.PuzzleHashes == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n1 := uint32(len((*s.PuzzleHashes)))
+		b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+		for i2 := range *s.PuzzleHashes {
+			b = append(b, (*s.PuzzleHashes)[i2][:]...)
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestAdditions
+func (s *RequestAdditions) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.HeaderHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.HeaderHash)); err != nil {
+			return d.FieldError(err, start2, "HeaderHash", (**types.Bytes32)(nil))
+		}
+		s.HeaderHash = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start2, "HeaderHash", (**types.Bytes32)(nil))
+		}
+		copy((*s.HeaderHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "HeaderHash", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// PuzzleHashes
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PuzzleHashes = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PuzzleHashes)); err != nil {
+			return d.FieldError(err, start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+		}
+		s.PuzzleHashes = new([]types.Bytes32)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+		}
+		n4 := binary.BigEndian.Uint32(b)
+		if err = d.LimitListLength(n4, unsafe.Sizeof((*s.PuzzleHashes)[0])); err != nil {
+			return d.FieldError(err, start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+		}
+		prealloc5 := n4
+		if prealloc5 > 1024 {
+			prealloc5 = 1024
+		}
+		(*s.PuzzleHashes) = make([]types.Bytes32, 0, prealloc5)
+		for i6 := uint32(0); i6 < n4; i6++ {
+			var item7 types.Bytes32
+			start8 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.Bytes32)(nil)), start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+			}
+			if b, err = d.Next(32); err != nil {
+				return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.Bytes32)(nil)), start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+			}
+			copy(item7[:], b)
+			d.LeaveNested()
+			(*s.PuzzleHashes) = append((*s.PuzzleHashes), item7)
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "PuzzleHashes", (**[]types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestBlock
+func (s *RequestBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestBlock to b
+func (s *RequestBlock) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// IncludeTransactionBlock
+	if s.IncludeTransactionBlock {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestBlock
+func (s *RequestBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// IncludeTransactionBlock
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "IncludeTransactionBlock", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "IncludeTransactionBlock", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.IncludeTransactionBlock = false
+	case 1:
+		s.IncludeTransactionBlock = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start2, "IncludeTransactionBlock", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestBlockHeader
+func (s *RequestBlockHeader) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestBlockHeader to b
+func (s *RequestBlockHeader) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestBlockHeader
+func (s *RequestBlockHeader) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestBlockHeaders
+func (s *RequestBlockHeaders) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestBlockHeaders to b
+func (s *RequestBlockHeaders) AppendStreamable(b []byte) ([]byte, error) {
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	// ReturnFilter
+	if s.ReturnFilter {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestBlockHeaders
+func (s *RequestBlockHeaders) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// ReturnFilter
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "ReturnFilter", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "ReturnFilter", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ReturnFilter = false
+	case 1:
+		s.ReturnFilter = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start3, "ReturnFilter", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestBlocks
+func (s *RequestBlocks) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestBlocks to b
+func (s *RequestBlocks) AppendStreamable(b []byte) ([]byte, error) {
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	// IncludeTransactionBlock
+	if s.IncludeTransactionBlock {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestBlocks
+func (s *RequestBlocks) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// IncludeTransactionBlock
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "IncludeTransactionBlock", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "IncludeTransactionBlock", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.IncludeTransactionBlock = false
+	case 1:
+		s.IncludeTransactionBlock = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start3, "IncludeTransactionBlock", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestChildren
+func (s *RequestChildren) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestChildren to b
+func (s *RequestChildren) AppendStreamable(b []byte) ([]byte, error) {
+	// CoinName
+	b = append(b, s.CoinName[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestChildren
+func (s *RequestChildren) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinName
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	copy(s.CoinName[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestCompactProofOfTime
+func (s *RequestCompactProofOfTime) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestCompactProofOfTime to b
+func (s *RequestCompactProofOfTime) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// NewProofOfTime
+	if b, err = s.NewProofOfTime.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// FieldVDF
+	b = append(b, byte(s.FieldVDF))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestCompactProofOfTime
+func (s *RequestCompactProofOfTime) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// NewProofOfTime
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "NewProofOfTime", (*types.VDFInfo)(nil))
+	}
+	if err = s.NewProofOfTime.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "NewProofOfTime", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// Height
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// FieldVDF
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "FieldVDF", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "FieldVDF", (*uint8)(nil))
+	}
+	s.FieldVDF = uint8(b[0])
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestCompactVDF
+func (s *RequestCompactVDF) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestCompactVDF to b
+func (s *RequestCompactVDF) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// FieldVDF
+	b = append(b, byte(s.FieldVDF))
+	// VDFInfo
+	if b, err = s.VDFInfo.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestCompactVDF
+func (s *RequestCompactVDF) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// FieldVDF
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "FieldVDF", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "FieldVDF", (*uint8)(nil))
+	}
+	s.FieldVDF = uint8(b[0])
+	d.LeaveNested()
+	// VDFInfo
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	if err = s.VDFInfo.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestFeeEstimates
+func (s *RequestFeeEstimates) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestFeeEstimates to b
+func (s *RequestFeeEstimates) AppendStreamable(b []byte) ([]byte, error) {
+	// TimeTargets
+	n1 := uint32(len(s.TimeTargets))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.TimeTargets {
+		b = append(b, byte(s.TimeTargets[i2]>>56), byte(s.TimeTargets[i2]>>48), byte(s.TimeTargets[i2]>>40), byte(s.TimeTargets[i2]>>32), byte(s.TimeTargets[i2]>>24), byte(s.TimeTargets[i2]>>16), byte(s.TimeTargets[i2]>>8), byte(s.TimeTargets[i2]))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestFeeEstimates
+func (s *RequestFeeEstimates) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// TimeTargets
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "TimeTargets", (*[]uint64)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "TimeTargets", (*[]uint64)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.TimeTargets[0])); err != nil {
+		return d.FieldError(err, start1, "TimeTargets", (*[]uint64)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.TimeTargets = make([]uint64, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 uint64
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*uint64)(nil)), start1, "TimeTargets", (*[]uint64)(nil))
+		}
+		if b, err = d.Next(8); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*uint64)(nil)), start1, "TimeTargets", (*[]uint64)(nil))
+		}
+		item5 = uint64(binary.BigEndian.Uint64(b))
+		d.LeaveNested()
+		s.TimeTargets = append(s.TimeTargets, item5)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestHeaderBlocks
+func (s *RequestHeaderBlocks) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestHeaderBlocks to b
+func (s *RequestHeaderBlocks) AppendStreamable(b []byte) ([]byte, error) {
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestHeaderBlocks
+func (s *RequestHeaderBlocks) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestMempoolTransactions
+func (s *RequestMempoolTransactions) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestMempoolTransactions to b
+func (s *RequestMempoolTransactions) AppendStreamable(b []byte) ([]byte, error) {
+	// Filter
+	n1 := uint32(len(s.Filter))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Filter...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestMempoolTransactions
+func (s *RequestMempoolTransactions) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Filter
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Filter", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Filter", (*[]byte)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n2); err != nil {
+		return d.FieldError(err, start1, "Filter", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n2); err != nil {
+		return d.FieldError(err, start1, "Filter", (*[]byte)(nil))
+	}
+	s.Filter = b
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestProofOfWeight
+func (s *RequestProofOfWeight) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestProofOfWeight to b
+func (s *RequestProofOfWeight) AppendStreamable(b []byte) ([]byte, error) {
+	// TotalNumberOfBlocks
+	b = append(b, byte(s.TotalNumberOfBlocks>>24), byte(s.TotalNumberOfBlocks>>16), byte(s.TotalNumberOfBlocks>>8), byte(s.TotalNumberOfBlocks))
+	// Tip
+	b = append(b, s.Tip[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestProofOfWeight
+func (s *RequestProofOfWeight) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// TotalNumberOfBlocks
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "TotalNumberOfBlocks", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "TotalNumberOfBlocks", (*uint32)(nil))
+	}
+	s.TotalNumberOfBlocks = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// Tip
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Tip", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "Tip", (*types.Bytes32)(nil))
+	}
+	copy(s.Tip[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestPuzzleSolution
+func (s *RequestPuzzleSolution) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestPuzzleSolution to b
+func (s *RequestPuzzleSolution) AppendStreamable(b []byte) ([]byte, error) {
+	// CoinName
+	b = append(b, s.CoinName[:]...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestPuzzleSolution
+func (s *RequestPuzzleSolution) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinName
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "CoinName", (*types.Bytes32)(nil))
+	}
+	copy(s.CoinName[:], b)
+	d.LeaveNested()
+	// Height
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestRemovals
+func (s *RequestRemovals) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestRemovals to b
+func (s *RequestRemovals) AppendStreamable(b []byte) ([]byte, error) {
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// CoinNames
+	if s.CoinNames == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n1 := uint32(len((*s.CoinNames)))
+		b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+		for i2 := range *s.CoinNames {
+			b = append(b, (*s.CoinNames)[i2][:]...)
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestRemovals
+func (s *RequestRemovals) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// CoinNames
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "CoinNames", (**[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "CoinNames", (**[]types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CoinNames = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CoinNames)); err != nil {
+			return d.FieldError(err, start3, "CoinNames", (**[]types.Bytes32)(nil))
+		}
+		s.CoinNames = new([]types.Bytes32)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start3, "CoinNames", (**[]types.Bytes32)(nil))
+		}
+		n4 := binary.BigEndian.Uint32(b)
+		if err = d.LimitListLength(n4, unsafe.Sizeof((*s.CoinNames)[0])); err != nil {
+			return d.FieldError(err, start3, "CoinNames", (**[]types.Bytes32)(nil))
+		}
+		prealloc5 := n4
+		if prealloc5 > 1024 {
+			prealloc5 = 1024
+		}
+		(*s.CoinNames) = make([]types.Bytes32, 0, prealloc5)
+		for i6 := uint32(0); i6 < n4; i6++ {
+			var item7 types.Bytes32
+			start8 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.Bytes32)(nil)), start3, "CoinNames", (**[]types.Bytes32)(nil))
+			}
+			if b, err = d.Next(32); err != nil {
+				return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.Bytes32)(nil)), start3, "CoinNames", (**[]types.Bytes32)(nil))
+			}
+			copy(item7[:], b)
+			d.LeaveNested()
+			(*s.CoinNames) = append((*s.CoinNames), item7)
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "CoinNames", (**[]types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestSESInfo
+func (s *RequestSESInfo) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestSESInfo to b
+func (s *RequestSESInfo) AppendStreamable(b []byte) ([]byte, error) {
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestSESInfo
+func (s *RequestSESInfo) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestSignagePointOrEndOfSubSlot
+func (s *RequestSignagePointOrEndOfSubSlot) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestSignagePointOrEndOfSubSlot to b
+func (s *RequestSignagePointOrEndOfSubSlot) AppendStreamable(b []byte) ([]byte, error) {
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// IndexFromChallenge
+	b = append(b, byte(s.IndexFromChallenge))
+	// LastRCInfusion
+	b = append(b, s.LastRCInfusion[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestSignagePointOrEndOfSubSlot
+func (s *RequestSignagePointOrEndOfSubSlot) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// IndexFromChallenge
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "IndexFromChallenge", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "IndexFromChallenge", (*uint8)(nil))
+	}
+	s.IndexFromChallenge = uint8(b[0])
+	d.LeaveNested()
+	// LastRCInfusion
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "LastRCInfusion", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "LastRCInfusion", (*types.Bytes32)(nil))
+	}
+	copy(s.LastRCInfusion[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestSignatures
+func (s *RequestSignatures) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestSignatures to b
+func (s *RequestSignatures) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// PlotIdentifier
+	n1 := uint32(len(s.PlotIdentifier))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.PlotIdentifier)...)
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// SPHash
+	b = append(b, s.SPHash[:]...)
+	// Messages
+	n2 := uint32(len(s.Messages))
+	b = append(b, byte(n2>>24), byte(n2>>16), byte(n2>>8), byte(n2))
+	for i3 := range s.Messages {
+		b = append(b, s.Messages[i3][:]...)
+	}
+	// MessageData
+	if s.MessageData == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n4 := uint32(len((*s.MessageData)))
+		b = append(b, byte(n4>>24), byte(n4>>16), byte(n4>>8), byte(n4))
+		for i5 := range *s.MessageData {
+			if (*s.MessageData)[i5] == nil {
+				b = append(b, 0)
+			} else {
+				b = append(b, 1)
+				if b, err = (*(*s.MessageData)[i5]).AppendStreamable(b); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	// RCBlockUnfinished
+	if s.RCBlockUnfinished == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RCBlockUnfinished).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestSignatures
+func (s *RequestSignatures) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PlotIdentifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n2); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n2)); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	s.PlotIdentifier = string(b)
+	d.LeaveNested()
+	// ChallengeHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// SPHash
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "SPHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start4, "SPHash", (*types.Bytes32)(nil))
+	}
+	copy(s.SPHash[:], b)
+	d.LeaveNested()
+	// Messages
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "Messages", (*[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start5, "Messages", (*[]types.Bytes32)(nil))
+	}
+	n6 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n6, unsafe.Sizeof(s.Messages[0])); err != nil {
+		return d.FieldError(err, start5, "Messages", (*[]types.Bytes32)(nil))
+	}
+	prealloc7 := n6
+	if prealloc7 > 1024 {
+		prealloc7 = 1024
+	}
+	s.Messages = make([]types.Bytes32, 0, prealloc7)
+	for i8 := uint32(0); i8 < n6; i8++ {
+		var item9 types.Bytes32
+		start10 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start10, fmt.Sprintf("[%d]", i8), (*types.Bytes32)(nil)), start5, "Messages", (*[]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start10, fmt.Sprintf("[%d]", i8), (*types.Bytes32)(nil)), start5, "Messages", (*[]types.Bytes32)(nil))
+		}
+		copy(item9[:], b)
+		d.LeaveNested()
+		s.Messages = append(s.Messages, item9)
+	}
+	d.LeaveNested()
+	// MessageData
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.MessageData = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.MessageData)); err != nil {
+			return d.FieldError(err, start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+		}
+		s.MessageData = new([]*SignatureRequestSourceData)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+		}
+		n12 := binary.BigEndian.Uint32(b)
+		if err = d.LimitListLength(n12, unsafe.Sizeof((*s.MessageData)[0])); err != nil {
+			return d.FieldError(err, start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+		}
+		prealloc13 := n12
+		if prealloc13 > 1024 {
+			prealloc13 = 1024
+		}
+		(*s.MessageData) = make([]*SignatureRequestSourceData, 0, prealloc13)
+		for i14 := uint32(0); i14 < n12; i14++ {
+			var item15 *SignatureRequestSourceData
+			start16 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(err, start16, fmt.Sprintf("[%d]", i14), (**SignatureRequestSourceData)(nil)), start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+			}
+			if b, err = d.Next(1); err != nil {
+				return d.FieldError(d.FieldError(err, start16, fmt.Sprintf("[%d]", i14), (**SignatureRequestSourceData)(nil)), start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+			}
+			switch b[0] {
+			case 0:
+				item15 = nil
+			case 1:
+				if err = d.LimitAllocation(unsafe.Sizeof(*item15)); err != nil {
+					return d.FieldError(d.FieldError(err, start16, fmt.Sprintf("[%d]", i14), (**SignatureRequestSourceData)(nil)), start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+				}
+				item15 = new(SignatureRequestSourceData)
+				if err = (*item15).UnmarshalStreamable(d); err != nil {
+					return d.FieldError(d.FieldError(err, start16, fmt.Sprintf("[%d]", i14), (**SignatureRequestSourceData)(nil)), start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+				}
+			default:
+				err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+				return d.FieldError(d.FieldError(err, start16, fmt.Sprintf("[%d]", i14), (**SignatureRequestSourceData)(nil)), start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+			}
+			d.LeaveNested()
+			(*s.MessageData) = append((*s.MessageData), item15)
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start11, "MessageData", (**[]*SignatureRequestSourceData)(nil))
+	}
+	d.LeaveNested()
+	// RCBlockUnfinished
+	start17 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start17, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start17, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RCBlockUnfinished = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RCBlockUnfinished)); err != nil {
+			return d.FieldError(err, start17, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+		}
+		s.RCBlockUnfinished = new(types.RewardChainBlockUnfinished)
+		if err = (*s.RCBlockUnfinished).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start17, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start17, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestSignedValues
+func (s *RequestSignedValues) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestSignedValues to b
+func (s *RequestSignedValues) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// QualityString
+	b = append(b, s.QualityString[:]...)
+	// FoliageBlockDataHash
+	b = append(b, s.FoliageBlockDataHash[:]...)
+	// FoliageTransactionBlockHash
+	b = append(b, s.FoliageTransactionBlockHash[:]...)
+	// FoliageBlockData
+	if s.FoliageBlockData == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.FoliageBlockData).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// FoliageTransactionBlockData
+	if s.FoliageTransactionBlockData == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.FoliageTransactionBlockData).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RCBlockUnfinished
+	if s.RCBlockUnfinished == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RCBlockUnfinished).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestSignedValues
+func (s *RequestSignedValues) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// QualityString
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "QualityString", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "QualityString", (*types.Bytes32)(nil))
+	}
+	copy(s.QualityString[:], b)
+	d.LeaveNested()
+	// FoliageBlockDataHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "FoliageBlockDataHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "FoliageBlockDataHash", (*types.Bytes32)(nil))
+	}
+	copy(s.FoliageBlockDataHash[:], b)
+	d.LeaveNested()
+	// FoliageTransactionBlockHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "FoliageTransactionBlockHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "FoliageTransactionBlockHash", (*types.Bytes32)(nil))
+	}
+	copy(s.FoliageTransactionBlockHash[:], b)
+	d.LeaveNested()
+	// FoliageBlockData
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "FoliageBlockData", (**types.FoliageBlockData)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "FoliageBlockData", (**types.FoliageBlockData)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageBlockData = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageBlockData)); err != nil {
+			return d.FieldError(err, start4, "FoliageBlockData", (**types.FoliageBlockData)(nil))
+		}
+		s.FoliageBlockData = new(types.FoliageBlockData)
+		if err = (*s.FoliageBlockData).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start4, "FoliageBlockData", (**types.FoliageBlockData)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "FoliageBlockData", (**types.FoliageBlockData)(nil))
+	}
+	d.LeaveNested()
+	// FoliageTransactionBlockData
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "FoliageTransactionBlockData", (**types.FoliageTransactionBlock)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "FoliageTransactionBlockData", (**types.FoliageTransactionBlock)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageTransactionBlockData = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageTransactionBlockData)); err != nil {
+			return d.FieldError(err, start5, "FoliageTransactionBlockData", (**types.FoliageTransactionBlock)(nil))
+		}
+		s.FoliageTransactionBlockData = new(types.FoliageTransactionBlock)
+		if err = (*s.FoliageTransactionBlockData).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start5, "FoliageTransactionBlockData", (**types.FoliageTransactionBlock)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "FoliageTransactionBlockData", (**types.FoliageTransactionBlock)(nil))
+	}
+	d.LeaveNested()
+	// RCBlockUnfinished
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RCBlockUnfinished = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RCBlockUnfinished)); err != nil {
+			return d.FieldError(err, start6, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+		}
+		s.RCBlockUnfinished = new(types.RewardChainBlockUnfinished)
+		if err = (*s.RCBlockUnfinished).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start6, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start6, "RCBlockUnfinished", (**types.RewardChainBlockUnfinished)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestTransaction
+func (s *RequestTransaction) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestTransaction to b
+func (s *RequestTransaction) AppendStreamable(b []byte) ([]byte, error) {
+	// TransactionID
+	b = append(b, s.TransactionID[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestTransaction
+func (s *RequestTransaction) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// TransactionID
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "TransactionID", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "TransactionID", (*types.Bytes32)(nil))
+	}
+	copy(s.TransactionID[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestUnfinishedBlock
+func (s *RequestUnfinishedBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestUnfinishedBlock to b
+func (s *RequestUnfinishedBlock) AppendStreamable(b []byte) ([]byte, error) {
+	// UnfinishedRewardHash
+	b = append(b, s.UnfinishedRewardHash[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestUnfinishedBlock
+func (s *RequestUnfinishedBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// UnfinishedRewardHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	copy(s.UnfinishedRewardHash[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RequestUnfinishedBlock2
+func (s *RequestUnfinishedBlock2) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RequestUnfinishedBlock2 to b
+func (s *RequestUnfinishedBlock2) AppendStreamable(b []byte) ([]byte, error) {
+	// UnfinishedRewardHash
+	b = append(b, s.UnfinishedRewardHash[:]...)
+	// FoliageHash
+	if s.FoliageHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.FoliageHash)[:]...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RequestUnfinishedBlock2
+func (s *RequestUnfinishedBlock2) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// UnfinishedRewardHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardHash", (*types.Bytes32)(nil))
+	}
+	copy(s.UnfinishedRewardHash[:], b)
+	d.LeaveNested()
+	// FoliageHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageHash)); err != nil {
+			return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+		}
+		s.FoliageHash = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+		}
+		copy((*s.FoliageHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "FoliageHash", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondAdditions
+func (s *RespondAdditions) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondAdditions to b
+func (s *RespondAdditions) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// Coins
+	n1 := uint32(len(s.Coins))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Coins {
+		if b, err = s.Coins[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Proofs
+	if s.Proofs == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n3 := uint32(len((*s.Proofs)))
+		b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+		for i4 := range *s.Proofs {
+			if b, err = (*s.Proofs)[i4].AppendStreamable(b); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondAdditions
+func (s *RespondAdditions) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// Coins
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Coins", (*[]PuzzleHashCoins)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "Coins", (*[]PuzzleHashCoins)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n4, unsafe.Sizeof(s.Coins[0])); err != nil {
+		return d.FieldError(err, start3, "Coins", (*[]PuzzleHashCoins)(nil))
+	}
+	prealloc5 := n4
+	if prealloc5 > 1024 {
+		prealloc5 = 1024
+	}
+	s.Coins = make([]PuzzleHashCoins, 0, prealloc5)
+	for i6 := uint32(0); i6 < n4; i6++ {
+		var item7 PuzzleHashCoins
+		start8 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*PuzzleHashCoins)(nil)), start3, "Coins", (*[]PuzzleHashCoins)(nil))
+		}
+		if err = item7.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*PuzzleHashCoins)(nil)), start3, "Coins", (*[]PuzzleHashCoins)(nil))
+		}
+		d.LeaveNested()
+		s.Coins = append(s.Coins, item7)
+	}
+	d.LeaveNested()
+	// Proofs
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Proofs", (**[]AdditionProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "Proofs", (**[]AdditionProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Proofs = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.Proofs)); err != nil {
+			return d.FieldError(err, start9, "Proofs", (**[]AdditionProof)(nil))
+		}
+		s.Proofs = new([]AdditionProof)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start9, "Proofs", (**[]AdditionProof)(nil))
+		}
+		n10 := binary.BigEndian.Uint32(b)
+		if err = d.LimitListLength(n10, unsafe.Sizeof((*s.Proofs)[0])); err != nil {
+			return d.FieldError(err, start9, "Proofs", (**[]AdditionProof)(nil))
+		}
+		prealloc11 := n10
+		if prealloc11 > 1024 {
+			prealloc11 = 1024
+		}
+		(*s.Proofs) = make([]AdditionProof, 0, prealloc11)
+		for i12 := uint32(0); i12 < n10; i12++ {
+			var item13 AdditionProof
+			start14 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(err, start14, fmt.Sprintf("[%d]", i12), (*AdditionProof)(nil)), start9, "Proofs", (**[]AdditionProof)(nil))
+			}
+			if err = item13.UnmarshalStreamable(d); err != nil {
+				return d.FieldError(d.FieldError(err, start14, fmt.Sprintf("[%d]", i12), (*AdditionProof)(nil)), start9, "Proofs", (**[]AdditionProof)(nil))
+			}
+			d.LeaveNested()
+			(*s.Proofs) = append((*s.Proofs), item13)
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start9, "Proofs", (**[]AdditionProof)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondBlock
+func (s *RespondBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondBlock to b
+func (s *RespondBlock) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Block
+	if b, err = s.Block.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondBlock
+func (s *RespondBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// Block
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Block", (*types.FullBlock)(nil))
+	}
+	if err = s.Block.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Block", (*types.FullBlock)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondBlockHeader
+func (s *RespondBlockHeader) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondBlockHeader to b
+func (s *RespondBlockHeader) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// HeaderBlock
+	if b, err = s.HeaderBlock.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondBlockHeader
+func (s *RespondBlockHeader) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// HeaderBlock
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "HeaderBlock", (*types.HeaderBlock)(nil))
+	}
+	if err = s.HeaderBlock.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "HeaderBlock", (*types.HeaderBlock)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondBlockHeaders
+func (s *RespondBlockHeaders) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondBlockHeaders to b
+func (s *RespondBlockHeaders) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	// HeaderBlocks
+	n1 := uint32(len(s.HeaderBlocks))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.HeaderBlocks {
+		if b, err = s.HeaderBlocks[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondBlockHeaders
+func (s *RespondBlockHeaders) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderBlocks
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n4, unsafe.Sizeof(s.HeaderBlocks[0])); err != nil {
+		return d.FieldError(err, start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+	}
+	prealloc5 := n4
+	if prealloc5 > 1024 {
+		prealloc5 = 1024
+	}
+	s.HeaderBlocks = make([]types.HeaderBlock, 0, prealloc5)
+	for i6 := uint32(0); i6 < n4; i6++ {
+		var item7 types.HeaderBlock
+		start8 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.HeaderBlock)(nil)), start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+		}
+		if err = item7.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.HeaderBlock)(nil)), start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+		}
+		d.LeaveNested()
+		s.HeaderBlocks = append(s.HeaderBlocks, item7)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondBlocks
+func (s *RespondBlocks) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondBlocks to b
+func (s *RespondBlocks) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	// Blocks
+	n1 := uint32(len(s.Blocks))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Blocks {
+		if b, err = s.Blocks[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondBlocks
+func (s *RespondBlocks) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// Blocks
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Blocks", (*[]types.FullBlock)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "Blocks", (*[]types.FullBlock)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n4, unsafe.Sizeof(s.Blocks[0])); err != nil {
+		return d.FieldError(err, start3, "Blocks", (*[]types.FullBlock)(nil))
+	}
+	prealloc5 := n4
+	if prealloc5 > 1024 {
+		prealloc5 = 1024
+	}
+	s.Blocks = make([]types.FullBlock, 0, prealloc5)
+	for i6 := uint32(0); i6 < n4; i6++ {
+		var item7 types.FullBlock
+		start8 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.FullBlock)(nil)), start3, "Blocks", (*[]types.FullBlock)(nil))
+		}
+		if err = item7.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.FullBlock)(nil)), start3, "Blocks", (*[]types.FullBlock)(nil))
+		}
+		d.LeaveNested()
+		s.Blocks = append(s.Blocks, item7)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondChildren
+func (s *RespondChildren) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondChildren to b
+func (s *RespondChildren) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// CoinStates
+	n1 := uint32(len(s.CoinStates))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.CoinStates {
+		if b, err = s.CoinStates[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondChildren
+func (s *RespondChildren) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinStates
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.CoinStates[0])); err != nil {
+		return d.FieldError(err, start1, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.CoinStates = make([]types.CoinState, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.CoinState
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.CoinState)(nil)), start1, "CoinStates", (*[]types.CoinState)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.CoinState)(nil)), start1, "CoinStates", (*[]types.CoinState)(nil))
+		}
+		d.LeaveNested()
+		s.CoinStates = append(s.CoinStates, item5)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondCompactProofOfTime
+func (s *RespondCompactProofOfTime) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondCompactProofOfTime to b
+func (s *RespondCompactProofOfTime) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// VDFInfo
+	if b, err = s.VDFInfo.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// VDFProof
+	if b, err = s.VDFProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// FieldVDF
+	b = append(b, byte(s.FieldVDF))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondCompactProofOfTime
+func (s *RespondCompactProofOfTime) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// VDFInfo
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	if err = s.VDFInfo.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// VDFProof
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "VDFProof", (*types.VDFProof)(nil))
+	}
+	if err = s.VDFProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start2, "VDFProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// HeaderHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// Height
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start4, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// FieldVDF
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "FieldVDF", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "FieldVDF", (*uint8)(nil))
+	}
+	s.FieldVDF = uint8(b[0])
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondCompactVDF
+func (s *RespondCompactVDF) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondCompactVDF to b
+func (s *RespondCompactVDF) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// FieldVDF
+	b = append(b, byte(s.FieldVDF))
+	// VDFInfo
+	if b, err = s.VDFInfo.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// VDFProof
+	if b, err = s.VDFProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondCompactVDF
+func (s *RespondCompactVDF) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// FieldVDF
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "FieldVDF", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "FieldVDF", (*uint8)(nil))
+	}
+	s.FieldVDF = uint8(b[0])
+	d.LeaveNested()
+	// VDFInfo
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	if err = s.VDFInfo.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "VDFInfo", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// VDFProof
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "VDFProof", (*types.VDFProof)(nil))
+	}
+	if err = s.VDFProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start5, "VDFProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondEndOfSubSlot
+func (s *RespondEndOfSubSlot) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondEndOfSubSlot to b
+func (s *RespondEndOfSubSlot) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// EndOfSlotBundle
+	if b, err = s.EndOfSlotBundle.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondEndOfSubSlot
+func (s *RespondEndOfSubSlot) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// EndOfSlotBundle
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "EndOfSlotBundle", (*types.EndOfSubSlotBundle)(nil))
+	}
+	if err = s.EndOfSlotBundle.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "EndOfSlotBundle", (*types.EndOfSubSlotBundle)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondFeeEstimates
+func (s *RespondFeeEstimates) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondFeeEstimates to b
+func (s *RespondFeeEstimates) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Estimates
+	if b, err = s.Estimates.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondFeeEstimates
+func (s *RespondFeeEstimates) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// Estimates
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Estimates", (*FeeEstimateGroup)(nil))
+	}
+	if err = s.Estimates.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Estimates", (*FeeEstimateGroup)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondHeaderBlocks
+func (s *RespondHeaderBlocks) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondHeaderBlocks to b
+func (s *RespondHeaderBlocks) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// StartHeight
+	b = append(b, byte(s.StartHeight>>24), byte(s.StartHeight>>16), byte(s.StartHeight>>8), byte(s.StartHeight))
+	// EndHeight
+	b = append(b, byte(s.EndHeight>>24), byte(s.EndHeight>>16), byte(s.EndHeight>>8), byte(s.EndHeight))
+	// HeaderBlocks
+	n1 := uint32(len(s.HeaderBlocks))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.HeaderBlocks {
+		if b, err = s.HeaderBlocks[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondHeaderBlocks
+func (s *RespondHeaderBlocks) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// StartHeight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "StartHeight", (*uint32)(nil))
+	}
+	s.StartHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// EndHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "EndHeight", (*uint32)(nil))
+	}
+	s.EndHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderBlocks
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n4, unsafe.Sizeof(s.HeaderBlocks[0])); err != nil {
+		return d.FieldError(err, start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+	}
+	prealloc5 := n4
+	if prealloc5 > 1024 {
+		prealloc5 = 1024
+	}
+	s.HeaderBlocks = make([]types.HeaderBlock, 0, prealloc5)
+	for i6 := uint32(0); i6 < n4; i6++ {
+		var item7 types.HeaderBlock
+		start8 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.HeaderBlock)(nil)), start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+		}
+		if err = item7.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*types.HeaderBlock)(nil)), start3, "HeaderBlocks", (*[]types.HeaderBlock)(nil))
+		}
+		d.LeaveNested()
+		s.HeaderBlocks = append(s.HeaderBlocks, item7)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondPeers
+func (s *RespondPeers) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondPeers to b
+func (s *RespondPeers) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// PeerList
+	n1 := uint32(len(s.PeerList))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.PeerList {
+		if b, err = s.PeerList[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondPeers
+func (s *RespondPeers) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PeerList
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.PeerList[0])); err != nil {
+		return d.FieldError(err, start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.PeerList = make([]types.TimestampedPeerInfo, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.TimestampedPeerInfo
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.TimestampedPeerInfo)(nil)), start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.TimestampedPeerInfo)(nil)), start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+		}
+		d.LeaveNested()
+		s.PeerList = append(s.PeerList, item5)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondPeersIntroducer
+func (s *RespondPeersIntroducer) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondPeersIntroducer to b
+func (s *RespondPeersIntroducer) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// PeerList
+	n1 := uint32(len(s.PeerList))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.PeerList {
+		if b, err = s.PeerList[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondPeersIntroducer
+func (s *RespondPeersIntroducer) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PeerList
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.PeerList[0])); err != nil {
+		return d.FieldError(err, start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.PeerList = make([]types.TimestampedPeerInfo, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.TimestampedPeerInfo
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.TimestampedPeerInfo)(nil)), start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.TimestampedPeerInfo)(nil)), start1, "PeerList", (*[]types.TimestampedPeerInfo)(nil))
+		}
+		d.LeaveNested()
+		s.PeerList = append(s.PeerList, item5)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondPlots
+func (s *RespondPlots) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondPlots to b
+func (s *RespondPlots) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Plots
+	n1 := uint32(len(s.Plots))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Plots {
+		if b, err = s.Plots[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// FailedToOpenFilenames
+	n3 := uint32(len(s.FailedToOpenFilenames))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	for i4 := range s.FailedToOpenFilenames {
+		n5 := uint32(len(s.FailedToOpenFilenames[i4]))
+		b = append(b, byte(n5>>24), byte(n5>>16), byte(n5>>8), byte(n5))
+		b = append(b, string(s.FailedToOpenFilenames[i4])...)
+	}
+	// NoKeyFilenames
+	n6 := uint32(len(s.NoKeyFilenames))
+	b = append(b, byte(n6>>24), byte(n6>>16), byte(n6>>8), byte(n6))
+	for i7 := range s.NoKeyFilenames {
+		n8 := uint32(len(s.NoKeyFilenames[i7]))
+		b = append(b, byte(n8>>24), byte(n8>>16), byte(n8>>8), byte(n8))
+		b = append(b, string(s.NoKeyFilenames[i7])...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondPlots
+func (s *RespondPlots) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Plots
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Plots", (*[]Plot)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Plots", (*[]Plot)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.Plots[0])); err != nil {
+		return d.FieldError(err, start1, "Plots", (*[]Plot)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.Plots = make([]Plot, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 Plot
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*Plot)(nil)), start1, "Plots", (*[]Plot)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*Plot)(nil)), start1, "Plots", (*[]Plot)(nil))
+		}
+		d.LeaveNested()
+		s.Plots = append(s.Plots, item5)
+	}
+	d.LeaveNested()
+	// FailedToOpenFilenames
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "FailedToOpenFilenames", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "FailedToOpenFilenames", (*[]string)(nil))
+	}
+	n8 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n8, unsafe.Sizeof(s.FailedToOpenFilenames[0])); err != nil {
+		return d.FieldError(err, start7, "FailedToOpenFilenames", (*[]string)(nil))
+	}
+	prealloc9 := n8
+	if prealloc9 > 1024 {
+		prealloc9 = 1024
+	}
+	s.FailedToOpenFilenames = make([]string, 0, prealloc9)
+	for i10 := uint32(0); i10 < n8; i10++ {
+		var item11 string
+		start12 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*string)(nil)), start7, "FailedToOpenFilenames", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*string)(nil)), start7, "FailedToOpenFilenames", (*[]string)(nil))
+		}
+		n13 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n13); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*string)(nil)), start7, "FailedToOpenFilenames", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n13)); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*string)(nil)), start7, "FailedToOpenFilenames", (*[]string)(nil))
+		}
+		item11 = string(b)
+		d.LeaveNested()
+		s.FailedToOpenFilenames = append(s.FailedToOpenFilenames, item11)
+	}
+	d.LeaveNested()
+	// NoKeyFilenames
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "NoKeyFilenames", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start14, "NoKeyFilenames", (*[]string)(nil))
+	}
+	n15 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n15, unsafe.Sizeof(s.NoKeyFilenames[0])); err != nil {
+		return d.FieldError(err, start14, "NoKeyFilenames", (*[]string)(nil))
+	}
+	prealloc16 := n15
+	if prealloc16 > 1024 {
+		prealloc16 = 1024
+	}
+	s.NoKeyFilenames = make([]string, 0, prealloc16)
+	for i17 := uint32(0); i17 < n15; i17++ {
+		var item18 string
+		start19 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start19, fmt.Sprintf("[%d]", i17), (*string)(nil)), start14, "NoKeyFilenames", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start19, fmt.Sprintf("[%d]", i17), (*string)(nil)), start14, "NoKeyFilenames", (*[]string)(nil))
+		}
+		n20 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n20); err != nil {
+			return d.FieldError(d.FieldError(err, start19, fmt.Sprintf("[%d]", i17), (*string)(nil)), start14, "NoKeyFilenames", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n20)); err != nil {
+			return d.FieldError(d.FieldError(err, start19, fmt.Sprintf("[%d]", i17), (*string)(nil)), start14, "NoKeyFilenames", (*[]string)(nil))
+		}
+		item18 = string(b)
+		d.LeaveNested()
+		s.NoKeyFilenames = append(s.NoKeyFilenames, item18)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondProofOfWeight
+func (s *RespondProofOfWeight) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondProofOfWeight to b
+func (s *RespondProofOfWeight) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// WP
+	if b, err = s.WP.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Tip
+	b = append(b, s.Tip[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondProofOfWeight
+func (s *RespondProofOfWeight) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// WP
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "WP", (*types.WeightProof)(nil))
+	}
+	if err = s.WP.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "WP", (*types.WeightProof)(nil))
+	}
+	d.LeaveNested()
+	// Tip
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Tip", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "Tip", (*types.Bytes32)(nil))
+	}
+	copy(s.Tip[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondPuzzleSolution
+func (s *RespondPuzzleSolution) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondPuzzleSolution to b
+func (s *RespondPuzzleSolution) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Response
+	if b, err = s.Response.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondPuzzleSolution
+func (s *RespondPuzzleSolution) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// Response
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Response", (*PuzzleSolutionResponse)(nil))
+	}
+	if err = s.Response.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Response", (*PuzzleSolutionResponse)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondRemovals
+func (s *RespondRemovals) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondRemovals to b
+func (s *RespondRemovals) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// HeaderHash
+	b = append(b, s.HeaderHash[:]...)
+	// Coins
+	n1 := uint32(len(s.Coins))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Coins {
+		if b, err = s.Coins[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Proofs
+	if s.Proofs == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n3 := uint32(len((*s.Proofs)))
+		b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+		for i4 := range *s.Proofs {
+			if b, err = (*s.Proofs)[i4].AppendStreamable(b); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondRemovals
+func (s *RespondRemovals) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Height
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// HeaderHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "HeaderHash", (*types.Bytes32)(nil))
+	}
+	copy(s.HeaderHash[:], b)
+	d.LeaveNested()
+	// Coins
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Coins", (*[]RemovedCoin)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "Coins", (*[]RemovedCoin)(nil))
+	}
+	n4 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n4, unsafe.Sizeof(s.Coins[0])); err != nil {
+		return d.FieldError(err, start3, "Coins", (*[]RemovedCoin)(nil))
+	}
+	prealloc5 := n4
+	if prealloc5 > 1024 {
+		prealloc5 = 1024
+	}
+	s.Coins = make([]RemovedCoin, 0, prealloc5)
+	for i6 := uint32(0); i6 < n4; i6++ {
+		var item7 RemovedCoin
+		start8 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*RemovedCoin)(nil)), start3, "Coins", (*[]RemovedCoin)(nil))
+		}
+		if err = item7.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start8, fmt.Sprintf("[%d]", i6), (*RemovedCoin)(nil)), start3, "Coins", (*[]RemovedCoin)(nil))
+		}
+		d.LeaveNested()
+		s.Coins = append(s.Coins, item7)
+	}
+	d.LeaveNested()
+	// Proofs
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "Proofs", (**[]RemovalProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "Proofs", (**[]RemovalProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Proofs = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.Proofs)); err != nil {
+			return d.FieldError(err, start9, "Proofs", (**[]RemovalProof)(nil))
+		}
+		s.Proofs = new([]RemovalProof)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start9, "Proofs", (**[]RemovalProof)(nil))
+		}
+		n10 := binary.BigEndian.Uint32(b)
+		if err = d.LimitListLength(n10, unsafe.Sizeof((*s.Proofs)[0])); err != nil {
+			return d.FieldError(err, start9, "Proofs", (**[]RemovalProof)(nil))
+		}
+		prealloc11 := n10
+		if prealloc11 > 1024 {
+			prealloc11 = 1024
+		}
+		(*s.Proofs) = make([]RemovalProof, 0, prealloc11)
+		for i12 := uint32(0); i12 < n10; i12++ {
+			var item13 RemovalProof
+			start14 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(err, start14, fmt.Sprintf("[%d]", i12), (*RemovalProof)(nil)), start9, "Proofs", (**[]RemovalProof)(nil))
+			}
+			if err = item13.UnmarshalStreamable(d); err != nil {
+				return d.FieldError(d.FieldError(err, start14, fmt.Sprintf("[%d]", i12), (*RemovalProof)(nil)), start9, "Proofs", (**[]RemovalProof)(nil))
+			}
+			d.LeaveNested()
+			(*s.Proofs) = append((*s.Proofs), item13)
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start9, "Proofs", (**[]RemovalProof)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondSESInfo
+func (s *RespondSESInfo) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondSESInfo to b
+func (s *RespondSESInfo) AppendStreamable(b []byte) ([]byte, error) {
+	// RewardChainHash
+	n1 := uint32(len(s.RewardChainHash))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.RewardChainHash {
+		b = append(b, s.RewardChainHash[i2][:]...)
+	}
+	// Heights
+	n3 := uint32(len(s.Heights))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	for i4 := range s.Heights {
+		n5 := uint32(len(s.Heights[i4]))
+		b = append(b, byte(n5>>24), byte(n5>>16), byte(n5>>8), byte(n5))
+		for i6 := range s.Heights[i4] {
+			b = append(b, byte(s.Heights[i4][i6]>>24), byte(s.Heights[i4][i6]>>16), byte(s.Heights[i4][i6]>>8), byte(s.Heights[i4][i6]))
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondSESInfo
+func (s *RespondSESInfo) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// RewardChainHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "RewardChainHash", (*[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "RewardChainHash", (*[]types.Bytes32)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.RewardChainHash[0])); err != nil {
+		return d.FieldError(err, start1, "RewardChainHash", (*[]types.Bytes32)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.RewardChainHash = make([]types.Bytes32, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.Bytes32
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "RewardChainHash", (*[]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "RewardChainHash", (*[]types.Bytes32)(nil))
+		}
+		copy(item5[:], b)
+		d.LeaveNested()
+		s.RewardChainHash = append(s.RewardChainHash, item5)
+	}
+	d.LeaveNested()
+	// Heights
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "Heights", (*[][]uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "Heights", (*[][]uint32)(nil))
+	}
+	n8 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n8, unsafe.Sizeof(s.Heights[0])); err != nil {
+		return d.FieldError(err, start7, "Heights", (*[][]uint32)(nil))
+	}
+	prealloc9 := n8
+	if prealloc9 > 1024 {
+		prealloc9 = 1024
+	}
+	s.Heights = make([][]uint32, 0, prealloc9)
+	for i10 := uint32(0); i10 < n8; i10++ {
+		var item11 []uint32
+		start12 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*[]uint32)(nil)), start7, "Heights", (*[][]uint32)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*[]uint32)(nil)), start7, "Heights", (*[][]uint32)(nil))
+		}
+		n13 := binary.BigEndian.Uint32(b)
+		if err = d.LimitListLength(n13, unsafe.Sizeof(item11[0])); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*[]uint32)(nil)), start7, "Heights", (*[][]uint32)(nil))
+		}
+		prealloc14 := n13
+		if prealloc14 > 1024 {
+			prealloc14 = 1024
+		}
+		item11 = make([]uint32, 0, prealloc14)
+		for i15 := uint32(0); i15 < n13; i15++ {
+			var item16 uint32
+			start17 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(d.FieldError(err, start17, fmt.Sprintf("[%d]", i15), (*uint32)(nil)), start12, fmt.Sprintf("[%d]", i10), (*[]uint32)(nil)), start7, "Heights", (*[][]uint32)(nil))
+			}
+			if b, err = d.Next(4); err != nil {
+				return d.FieldError(d.FieldError(d.FieldError(err, start17, fmt.Sprintf("[%d]", i15), (*uint32)(nil)), start12, fmt.Sprintf("[%d]", i10), (*[]uint32)(nil)), start7, "Heights", (*[][]uint32)(nil))
+			}
+			item16 = uint32(binary.BigEndian.Uint32(b))
+			d.LeaveNested()
+			item11 = append(item11, item16)
+		}
+		d.LeaveNested()
+		s.Heights = append(s.Heights, item11)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondSignagePoint
+func (s *RespondSignagePoint) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondSignagePoint to b
+func (s *RespondSignagePoint) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// IndexFromChallenge
+	b = append(b, byte(s.IndexFromChallenge))
+	// ChallengeChainVDF
+	if b, err = s.ChallengeChainVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainProof
+	if b, err = s.ChallengeChainProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainVDF
+	if b, err = s.RewardChainVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainProof
+	if b, err = s.RewardChainProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondSignagePoint
+func (s *RespondSignagePoint) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// IndexFromChallenge
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "IndexFromChallenge", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "IndexFromChallenge", (*uint8)(nil))
+	}
+	s.IndexFromChallenge = uint8(b[0])
+	d.LeaveNested()
+	// ChallengeChainVDF
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainVDF", (*types.VDFInfo)(nil))
+	}
+	if err = s.ChallengeChainVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainVDF", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainProof
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "ChallengeChainProof", (*types.VDFProof)(nil))
+	}
+	if err = s.ChallengeChainProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "ChallengeChainProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainVDF
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "RewardChainVDF", (*types.VDFInfo)(nil))
+	}
+	if err = s.RewardChainVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "RewardChainVDF", (*types.VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainProof
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "RewardChainProof", (*types.VDFProof)(nil))
+	}
+	if err = s.RewardChainProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start5, "RewardChainProof", (*types.VDFProof)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondSignatures
+func (s *RespondSignatures) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondSignatures to b
+func (s *RespondSignatures) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// PlotIdentifier
+	n1 := uint32(len(s.PlotIdentifier))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.PlotIdentifier)...)
+	// ChallengeHash
+	b = append(b, s.ChallengeHash[:]...)
+	// SPHash
+	b = append(b, s.SPHash[:]...)
+	// LocalPK
+	b = append(b, s.LocalPK[:]...)
+	// FarmerPK
+	b = append(b, s.FarmerPK[:]...)
+	// MessageSignatures
+	n2 := uint32(len(s.MessageSignatures))
+	b = append(b, byte(n2>>24), byte(n2>>16), byte(n2>>8), byte(n2))
+	for i3 := range s.MessageSignatures {
+		if b, err = s.MessageSignatures[i3].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// IncludeSourceSignatureData
+	if s.IncludeSourceSignatureData {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	// FarmerRewardAddressOverride
+	if s.FarmerRewardAddressOverride == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.FarmerRewardAddressOverride)[:]...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondSignatures
+func (s *RespondSignatures) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PlotIdentifier
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n2); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n2)); err != nil {
+		return d.FieldError(err, start1, "PlotIdentifier", (*string)(nil))
+	}
+	s.PlotIdentifier = string(b)
+	d.LeaveNested()
+	// ChallengeHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "ChallengeHash", (*types.Bytes32)(nil))
+	}
+	copy(s.ChallengeHash[:], b)
+	d.LeaveNested()
+	// SPHash
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "SPHash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start4, "SPHash", (*types.Bytes32)(nil))
+	}
+	copy(s.SPHash[:], b)
+	d.LeaveNested()
+	// LocalPK
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "LocalPK", (*types.G1Element)(nil))
+	}
+	if b, err = d.Next(48); err != nil {
+		return d.FieldError(err, start5, "LocalPK", (*types.G1Element)(nil))
+	}
+	copy(s.LocalPK[:], b)
+	d.LeaveNested()
+	// FarmerPK
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "FarmerPK", (*types.G1Element)(nil))
+	}
+	if b, err = d.Next(48); err != nil {
+		return d.FieldError(err, start6, "FarmerPK", (*types.G1Element)(nil))
+	}
+	copy(s.FarmerPK[:], b)
+	d.LeaveNested()
+	// MessageSignatures
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "MessageSignatures", (*[]MessageSignature)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "MessageSignatures", (*[]MessageSignature)(nil))
+	}
+	n8 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n8, unsafe.Sizeof(s.MessageSignatures[0])); err != nil {
+		return d.FieldError(err, start7, "MessageSignatures", (*[]MessageSignature)(nil))
+	}
+	prealloc9 := n8
+	if prealloc9 > 1024 {
+		prealloc9 = 1024
+	}
+	s.MessageSignatures = make([]MessageSignature, 0, prealloc9)
+	for i10 := uint32(0); i10 < n8; i10++ {
+		var item11 MessageSignature
+		start12 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*MessageSignature)(nil)), start7, "MessageSignatures", (*[]MessageSignature)(nil))
+		}
+		if err = item11.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*MessageSignature)(nil)), start7, "MessageSignatures", (*[]MessageSignature)(nil))
+		}
+		d.LeaveNested()
+		s.MessageSignatures = append(s.MessageSignatures, item11)
+	}
+	d.LeaveNested()
+	// IncludeSourceSignatureData
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "IncludeSourceSignatureData", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start13, "IncludeSourceSignatureData", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.IncludeSourceSignatureData = false
+	case 1:
+		s.IncludeSourceSignatureData = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start13, "IncludeSourceSignatureData", (*bool)(nil))
+	}
+	d.LeaveNested()
+	// FarmerRewardAddressOverride
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start14, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FarmerRewardAddressOverride = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FarmerRewardAddressOverride)); err != nil {
+			return d.FieldError(err, start14, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+		}
+		s.FarmerRewardAddressOverride = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start14, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+		}
+		copy((*s.FarmerRewardAddressOverride)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start14, "FarmerRewardAddressOverride", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondToCoinUpdates
+func (s *RespondToCoinUpdates) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondToCoinUpdates to b
+func (s *RespondToCoinUpdates) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// CoinIDs
+	n1 := uint32(len(s.CoinIDs))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.CoinIDs {
+		b = append(b, s.CoinIDs[i2][:]...)
+	}
+	// MinHeight
+	b = append(b, byte(s.MinHeight>>24), byte(s.MinHeight>>16), byte(s.MinHeight>>8), byte(s.MinHeight))
+	// CoinStates
+	n3 := uint32(len(s.CoinStates))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	for i4 := range s.CoinStates {
+		if b, err = s.CoinStates[i4].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondToCoinUpdates
+func (s *RespondToCoinUpdates) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinIDs
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinIDs", (*[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "CoinIDs", (*[]types.Bytes32)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.CoinIDs[0])); err != nil {
+		return d.FieldError(err, start1, "CoinIDs", (*[]types.Bytes32)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.CoinIDs = make([]types.Bytes32, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.Bytes32
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "CoinIDs", (*[]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "CoinIDs", (*[]types.Bytes32)(nil))
+		}
+		copy(item5[:], b)
+		d.LeaveNested()
+		s.CoinIDs = append(s.CoinIDs, item5)
+	}
+	d.LeaveNested()
+	// MinHeight
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	s.MinHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// CoinStates
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start8, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	n9 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n9, unsafe.Sizeof(s.CoinStates[0])); err != nil {
+		return d.FieldError(err, start8, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	prealloc10 := n9
+	if prealloc10 > 1024 {
+		prealloc10 = 1024
+	}
+	s.CoinStates = make([]types.CoinState, 0, prealloc10)
+	for i11 := uint32(0); i11 < n9; i11++ {
+		var item12 types.CoinState
+		start13 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start13, fmt.Sprintf("[%d]", i11), (*types.CoinState)(nil)), start8, "CoinStates", (*[]types.CoinState)(nil))
+		}
+		if err = item12.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start13, fmt.Sprintf("[%d]", i11), (*types.CoinState)(nil)), start8, "CoinStates", (*[]types.CoinState)(nil))
+		}
+		d.LeaveNested()
+		s.CoinStates = append(s.CoinStates, item12)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondToPHUpdates
+func (s *RespondToPHUpdates) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondToPHUpdates to b
+func (s *RespondToPHUpdates) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// PuzzleHashes
+	n1 := uint32(len(s.PuzzleHashes))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.PuzzleHashes {
+		b = append(b, s.PuzzleHashes[i2][:]...)
+	}
+	// MinHeight
+	b = append(b, byte(s.MinHeight>>24), byte(s.MinHeight>>16), byte(s.MinHeight>>8), byte(s.MinHeight))
+	// CoinStates
+	n3 := uint32(len(s.CoinStates))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	for i4 := range s.CoinStates {
+		if b, err = s.CoinStates[i4].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondToPHUpdates
+func (s *RespondToPHUpdates) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PuzzleHashes
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.PuzzleHashes[0])); err != nil {
+		return d.FieldError(err, start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.PuzzleHashes = make([]types.Bytes32, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 types.Bytes32
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*types.Bytes32)(nil)), start1, "PuzzleHashes", (*[]types.Bytes32)(nil))
+		}
+		copy(item5[:], b)
+		d.LeaveNested()
+		s.PuzzleHashes = append(s.PuzzleHashes, item5)
+	}
+	d.LeaveNested()
+	// MinHeight
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "MinHeight", (*uint32)(nil))
+	}
+	s.MinHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// CoinStates
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start8, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	n9 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n9, unsafe.Sizeof(s.CoinStates[0])); err != nil {
+		return d.FieldError(err, start8, "CoinStates", (*[]types.CoinState)(nil))
+	}
+	prealloc10 := n9
+	if prealloc10 > 1024 {
+		prealloc10 = 1024
+	}
+	s.CoinStates = make([]types.CoinState, 0, prealloc10)
+	for i11 := uint32(0); i11 < n9; i11++ {
+		var item12 types.CoinState
+		start13 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start13, fmt.Sprintf("[%d]", i11), (*types.CoinState)(nil)), start8, "CoinStates", (*[]types.CoinState)(nil))
+		}
+		if err = item12.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start13, fmt.Sprintf("[%d]", i11), (*types.CoinState)(nil)), start8, "CoinStates", (*[]types.CoinState)(nil))
+		}
+		d.LeaveNested()
+		s.CoinStates = append(s.CoinStates, item12)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondTransaction
+func (s *RespondTransaction) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondTransaction to b
+func (s *RespondTransaction) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Transaction
+	if b, err = s.Transaction.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondTransaction
+func (s *RespondTransaction) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// Transaction
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Transaction", (*types.SpendBundle)(nil))
+	}
+	if err = s.Transaction.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Transaction", (*types.SpendBundle)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RespondUnfinishedBlock
+func (s *RespondUnfinishedBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RespondUnfinishedBlock to b
+func (s *RespondUnfinishedBlock) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// UnfinishedBlock
+	if b, err = s.UnfinishedBlock.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RespondUnfinishedBlock
+func (s *RespondUnfinishedBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// UnfinishedBlock
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "UnfinishedBlock", (*types.UnfinishedBlock)(nil))
+	}
+	if err = s.UnfinishedBlock.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "UnfinishedBlock", (*types.UnfinishedBlock)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RewardChallenge
+func (s *RewardChallenge) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RewardChallenge to b
+func (s *RewardChallenge) AppendStreamable(b []byte) ([]byte, error) {
+	// Challenge
+	b = append(b, s.Challenge[:]...)
+	// TotalIters
+	b = append(b, s.TotalIters.Bytes()...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RewardChallenge
+func (s *RewardChallenge) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Challenge
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Challenge", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "Challenge", (*types.Bytes32)(nil))
+	}
+	copy(s.Challenge[:], b)
+	d.LeaveNested()
+	// TotalIters
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "TotalIters", (*types.Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start2, "TotalIters", (*types.Uint128)(nil))
+	}
+	if s.TotalIters, err = types.BytesToUint128(b); err != nil {
+		return d.FieldError(err, start2, "TotalIters", (*types.Uint128)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SendTransaction
+func (s *SendTransaction) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SendTransaction to b
+func (s *SendTransaction) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Transaction
+	if b, err = s.Transaction.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SendTransaction
+func (s *SendTransaction) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// Transaction
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Transaction", (*types.SpendBundle)(nil))
+	}
+	if err = s.Transaction.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Transaction", (*types.SpendBundle)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SignatureRequestSourceData
+func (s *SignatureRequestSourceData) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SignatureRequestSourceData to b
+func (s *SignatureRequestSourceData) AppendStreamable(b []byte) ([]byte, error) {
+	// Kind
+	b = append(b, byte(s.Kind))
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Data...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SignatureRequestSourceData
+func (s *SignatureRequestSourceData) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Kind
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Kind", (*SigningDataKind)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "Kind", (*SigningDataKind)(nil))
+	}
+	s.Kind = SigningDataKind(b[0])
+	d.LeaveNested()
+	// Data
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]byte)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n3); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n3); err != nil {
+		return d.FieldError(err, start2, "Data", (*[]byte)(nil))
+	}
+	s.Data = b
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SignedValues
+func (s *SignedValues) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SignedValues to b
+func (s *SignedValues) AppendStreamable(b []byte) ([]byte, error) {
+	// QualityString
+	b = append(b, s.QualityString[:]...)
+	// FoliageBlockDataSignature
+	b = append(b, s.FoliageBlockDataSignature[:]...)
+	// FoliageTransactionBlockSignature
+	b = append(b, s.FoliageTransactionBlockSignature[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SignedValues
+func (s *SignedValues) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// QualityString
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "QualityString", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "QualityString", (*types.Bytes32)(nil))
+	}
+	copy(s.QualityString[:], b)
+	d.LeaveNested()
+	// FoliageBlockDataSignature
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "FoliageBlockDataSignature", (*types.G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start2, "FoliageBlockDataSignature", (*types.G2Element)(nil))
+	}
+	copy(s.FoliageBlockDataSignature[:], b)
+	d.LeaveNested()
+	// FoliageTransactionBlockSignature
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "FoliageTransactionBlockSignature", (*types.G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start3, "FoliageTransactionBlockSignature", (*types.G2Element)(nil))
+	}
+	copy(s.FoliageTransactionBlockSignature[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for TransactionAck
+func (s *TransactionAck) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of TransactionAck to b
+func (s *TransactionAck) AppendStreamable(b []byte) ([]byte, error) {
+	// TxID
+	b = append(b, s.TxID[:]...)
+	// Status
+	b = append(b, byte(s.Status))
+	// Error
+	if s.Error == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n1 := uint32(len((*s.Error)))
+		b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+		b = append(b, string((*s.Error))...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for TransactionAck
+func (s *TransactionAck) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// TxID
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "TxID", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "TxID", (*types.Bytes32)(nil))
+	}
+	copy(s.TxID[:], b)
+	d.LeaveNested()
+	// Status
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Status", (*MempoolInclusionStatus)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "Status", (*MempoolInclusionStatus)(nil))
+	}
+	s.Status = MempoolInclusionStatus(b[0])
+	d.LeaveNested()
+	// Error
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Error", (**string)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "Error", (**string)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Error = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.Error)); err != nil {
+			return d.FieldError(err, start3, "Error", (**string)(nil))
+		}
+		s.Error = new(string)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start3, "Error", (**string)(nil))
+		}
+		n4 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n4); err != nil {
+			return d.FieldError(err, start3, "Error", (**string)(nil))
+		}
+		if b, err = d.Next(uint(n4)); err != nil {
+			return d.FieldError(err, start3, "Error", (**string)(nil))
+		}
+		(*s.Error) = string(b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "Error", (**string)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// streamableDecoder matches streamable.GeneratedDecoder, which generated code can't import
+type streamableDecoder interface {
+	io.Reader
+	LimitListLength(numItems uint32, itemSize uintptr) error
+	LimitBytesLength(numBytes uint32) error
+	LimitAllocation(numBytes uintptr) error
+	EnterNested() error
+	LeaveNested()
+	Next(numBytes uint) ([]byte, error)
+	NextBytes(numBytes uint32) ([]byte, error)
+	Offset() int64
+	FieldError(err error, start int64, path string, v interface{}) error
+}
+
+// newStreamableDecoder returns r if it is a streamableDecoder, and wraps it otherwise
+func newStreamableDecoder(r io.Reader) streamableDecoder {
+	if d, ok := r.(streamableDecoder); ok {
+		return d
+	}
+
+	return &streamableReader{r: r}
+}
+
+// streamableReadChunk limits how far ahead of the data actually arriving streamableReader allocates
+const streamableReadChunk = 64 * 1024
+
+// streamableReader is the streamableDecoder for a plain io.Reader, which has no limits other than the data itself
+type streamableReader struct {
+	r      io.Reader
+	offset int64
+	buf    []byte
+}
+
+func (s *streamableReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	s.offset += int64(n)
+	return n, err
+}
+
+// Next reads numBytes in chunks, so the buffer only grows as data arrives
+func (s *streamableReader) Next(numBytes uint) ([]byte, error) {
+	s.buf = s.buf[:0]
+	for uint(len(s.buf)) < numBytes {
+		chunk := numBytes - uint(len(s.buf))
+		if chunk > streamableReadChunk {
+			chunk = streamableReadChunk
+		}
+
+		filled := len(s.buf)
+		s.buf = append(s.buf, make([]byte, chunk)...)
+		n, err := io.ReadFull(s.r, s.buf[filled:])
+		s.offset += int64(n)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+
+	return s.buf, nil
+}
+
+func (s *streamableReader) NextBytes(numBytes uint32) ([]byte, error) {
+	b, err := s.Next(uint(numBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(make([]byte, 0, len(b)), b...), nil
+}
+
+func (s *streamableReader) LimitListLength(numItems uint32, itemSize uintptr) error { return nil }
+func (s *streamableReader) LimitBytesLength(numBytes uint32) error                  { return nil }
+func (s *streamableReader) LimitAllocation(numBytes uintptr) error                  { return nil }
+func (s *streamableReader) EnterNested() error                                      { return nil }
+func (s *streamableReader) LeaveNested()                                            {}
+func (s *streamableReader) Offset() int64                                           { return s.offset }
+
+func (s *streamableReader) FieldError(err error, start int64, path string, v interface{}) error {
+	return err
+}
@@ -0,0 +1,112 @@
+package protocols
+
+import (
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// RewardChallenge is a reward chain challenge and the total iters it was at
+// This represents the Tuple[bytes32, uint128] in the Python code
+type RewardChallenge struct {
+	Challenge  types.Bytes32 `streamable:""`
+	TotalIters types.Uint128 `streamable:""`
+}
+
+// NewPeakTimelord is sent from the full node to the timelord when the node has a new peak
+type NewPeakTimelord struct {
+	RewardChainBlock                 types.RewardChainBlock `streamable:""`
+	Difficulty                       uint64                 `streamable:""`
+	Deficit                          uint8                  `streamable:""`
+	SubSlotIters                     uint64                 `streamable:""`
+	SubEpochSummary                  *types.SubEpochSummary `streamable:"optional"`
+	PreviousRewardChallenges         []RewardChallenge      `streamable:"tuple"` // List[Tuple[bytes32, uint128]]
+	LastChallengeSBOrEOSTotalIters   types.Uint128          `streamable:""`
+	PassesSESHeightButNotYetIncluded bool                   `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewPeakTimelord) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewPeakTimelord
+}
+
+// NewUnfinishedBlockTimelord is sent from the full node to the timelord with an unfinished block to infuse
+type NewUnfinishedBlockTimelord struct {
+	RewardChainBlock types.RewardChainBlockUnfinished `streamable:""`
+	Difficulty       uint64                           `streamable:""`
+	SubSlotIters     uint64                           `streamable:""`
+	Foliage          types.Foliage                    `streamable:""`
+	SubEpochSummary  *types.SubEpochSummary           `streamable:"optional"`
+	RCPrev           types.Bytes32                    `streamable:""` // The reward chain challenge the block is infused on
+}
+
+// ProtocolMessageType implements Payload
+func (n NewUnfinishedBlockTimelord) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewUnfinishedBlockTimelord
+}
+
+// NewInfusionPointVDF is sent from the timelord to the full node with the infusion point VDFs of an unfinished block
+// The infused challenge chain VDF and proof are only set when the infused challenge chain exists
+type NewInfusionPointVDF struct {
+	UnfinishedRewardHash         types.Bytes32   `streamable:""`
+	ChallengeChainIPVDF          types.VDFInfo   `streamable:"name=challenge_chain_ip_vdf"`
+	ChallengeChainIPProof        types.VDFProof  `streamable:""`
+	RewardChainIPVDF             types.VDFInfo   `streamable:"name=reward_chain_ip_vdf"`
+	RewardChainIPProof           types.VDFProof  `streamable:""`
+	InfusedChallengeChainIPVDF   *types.VDFInfo  `streamable:"optional,name=infused_challenge_chain_ip_vdf"`
+	InfusedChallengeChainIPProof *types.VDFProof `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewInfusionPointVDF) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewInfusionPointVDF
+}
+
+// NewSignagePointVDF is sent from the timelord to the full node with the VDFs for a signage point
+type NewSignagePointVDF struct {
+	IndexFromChallenge    uint8          `streamable:""`
+	ChallengeChainSPVDF   types.VDFInfo  `streamable:"name=challenge_chain_sp_vdf"`
+	ChallengeChainSPProof types.VDFProof `streamable:""`
+	RewardChainSPVDF      types.VDFInfo  `streamable:"name=reward_chain_sp_vdf"`
+	RewardChainSPProof    types.VDFProof `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewSignagePointVDF) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewSignagePointVDF
+}
+
+// NewEndOfSubSlotVDF is sent from the timelord to the full node when a sub slot ends
+type NewEndOfSubSlotVDF struct {
+	EndOfSubSlotBundle types.EndOfSubSlotBundle `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewEndOfSubSlotVDF) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewEndOfSubSlotVDF
+}
+
+// RequestCompactProofOfTime is sent from the full node to a bluebox timelord, to compact the proof of a VDF in a block
+type RequestCompactProofOfTime struct {
+	NewProofOfTime types.VDFInfo `streamable:""`
+	HeaderHash     types.Bytes32 `streamable:""`
+	Height         uint32        `streamable:""`
+	FieldVDF       uint8         `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestCompactProofOfTime) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestCompactProofOfTime
+}
+
+// RespondCompactProofOfTime is the response to request_compact_proof_of_time
+type RespondCompactProofOfTime struct {
+	VDFInfo    types.VDFInfo  `streamable:""`
+	VDFProof   types.VDFProof `streamable:""`
+	HeaderHash types.Bytes32  `streamable:""`
+	Height     uint32         `streamable:""`
+	FieldVDF   uint8          `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondCompactProofOfTime) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondCompactProofOfTime
+}
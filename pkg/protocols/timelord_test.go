@@ -0,0 +1,179 @@
+package protocols_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+func TestTimelordMessages(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		expected   protocols.Payload
+	}{
+		{
+			// NewInfusionPointVDF(
+			//   bytes32(0x11 * 32),
+			//   VDFInfo(0x77 * 32, ...), VDFProof(uint8(0), 0x0102, False),
+			//   VDFInfo(0x88 * 32, ...), VDFProof(uint8(0), 0x0304, False),
+			//   None, None,
+			// )
+			encodedHex: rep("11", 32) +
+				vdfInfoHex + "00" + "000000020102" + "00" +
+				rep("88", 32) + vdfInfoHex[64:] + "00" + "000000020304" + "00" +
+				"00" + "00",
+			expected: &protocols.NewInfusionPointVDF{
+				UnfinishedRewardHash:  bytes32("11"),
+				ChallengeChainIPVDF:   vdfInfo("77"),
+				ChallengeChainIPProof: types.VDFProof{Witness: []byte{1, 2}},
+				RewardChainIPVDF:      vdfInfo("88"),
+				RewardChainIPProof:    types.VDFProof{Witness: []byte{3, 4}},
+			},
+		},
+		{
+			// Same as above, with VDFInfo(0x99 * 32, ...) and VDFProof(uint8(0), 0x0506, True) for the infused challenge chain
+			encodedHex: rep("11", 32) +
+				vdfInfoHex + "00" + "000000020102" + "00" +
+				rep("88", 32) + vdfInfoHex[64:] + "00" + "000000020304" + "00" +
+				"01" + rep("99", 32) + vdfInfoHex[64:] + "01" + "00" + "000000020506" + "01",
+			expected: &protocols.NewInfusionPointVDF{
+				UnfinishedRewardHash:         bytes32("11"),
+				ChallengeChainIPVDF:          vdfInfo("77"),
+				ChallengeChainIPProof:        types.VDFProof{Witness: []byte{1, 2}},
+				RewardChainIPVDF:             vdfInfo("88"),
+				RewardChainIPProof:           types.VDFProof{Witness: []byte{3, 4}},
+				InfusedChallengeChainIPVDF:   &[]types.VDFInfo{vdfInfo("99")}[0],
+				InfusedChallengeChainIPProof: &types.VDFProof{Witness: []byte{5, 6}, NormalizedToIdentity: true},
+			},
+		},
+		{
+			// NewSignagePointVDF(uint8(4), VDFInfo(0x77 * 32, ...), VDFProof(uint8(0), 0x0102, False), VDFInfo(0x88 * 32, ...), VDFProof(uint8(0), 0x0304, True))
+			encodedHex: "04" +
+				vdfInfoHex + "00" + "000000020102" + "00" +
+				rep("88", 32) + vdfInfoHex[64:] + "00" + "000000020304" + "01",
+			expected: &protocols.NewSignagePointVDF{
+				IndexFromChallenge:    4,
+				ChallengeChainSPVDF:   vdfInfo("77"),
+				ChallengeChainSPProof: types.VDFProof{Witness: []byte{1, 2}},
+				RewardChainSPVDF:      vdfInfo("88"),
+				RewardChainSPProof:    types.VDFProof{Witness: []byte{3, 4}, NormalizedToIdentity: true},
+			},
+		},
+		{
+			// RequestCompactProofOfTime(VDFInfo(0x77 * 32, 1000, default), bytes32(0x11 * 32), uint32(1000000), uint8(1))
+			encodedHex: vdfInfoHex + rep("11", 32) + "000f4240" + "01",
+			expected:   &protocols.RequestCompactProofOfTime{NewProofOfTime: vdfInfo("77"), HeaderHash: bytes32("11"), Height: 1000000, FieldVDF: 1},
+		},
+		{
+			// RespondCompactProofOfTime(VDFInfo(0x77 * 32, 1000, default), VDFProof(uint8(0), 0x0102, True), bytes32(0x11 * 32), uint32(1000000), uint8(1))
+			encodedHex: vdfInfoHex + "00" + "000000020102" + "01" + rep("11", 32) + "000f4240" + "01",
+			expected: &protocols.RespondCompactProofOfTime{
+				VDFInfo:    vdfInfo("77"),
+				VDFProof:   types.VDFProof{Witness: []byte{1, 2}, NormalizedToIdentity: true},
+				HeaderHash: bytes32("11"),
+				Height:     1000000,
+				FieldVDF:   1,
+			},
+		},
+	} {
+		assertPayloadRoundTrip(t, test.encodedHex, test.expected)
+	}
+}
+
+// marshalHex is the streamable encoding of v, as hex
+func marshalHex(t *testing.T, v interface{}) string {
+	encodedBytes, err := streamable.Marshal(v)
+	assert.NoError(t, err)
+
+	return hex.EncodeToString(encodedBytes)
+}
+
+func TestTimelordMessages_Blocks(t *testing.T) {
+	fullBlockBytes, err := hex.DecodeString(readBlockHex(t, "full_block"))
+	assert.NoError(t, err)
+	block := types.FullBlock{}
+	assert.NoError(t, streamable.Unmarshal(fullBlockBytes, &block))
+
+	unfinishedBlockBytes, err := hex.DecodeString(readBlockHex(t, "unfinished_block"))
+	assert.NoError(t, err)
+	unfinishedBlock := types.UnfinishedBlock{}
+	assert.NoError(t, streamable.Unmarshal(unfinishedBlockBytes, &unfinishedBlock))
+
+	// SubEpochSummary(bytes32(0xaa * 32), bytes32(0xbb * 32), uint8(3), None, uint64(147849216))
+	subEpochSummaryHex := rep("aa", 32) + rep("bb", 32) + "03" + "00" + "01" + "0000000008d00000"
+	subEpochSummary := &types.SubEpochSummary{
+		PrevSubepochSummaryHash: bytes32("aa"),
+		RewardChainHash:         bytes32("bb"),
+		NumBlocksOverflow:       3,
+		NewSubSlotIters:         util.PtrUint64(147849216),
+	}
+
+	// NewPeakTimelord(
+	//   full_block.reward_chain_block, uint64(2000), uint8(16), uint64(147849216), SubEpochSummary(...),
+	//   [(bytes32(0xcc * 32), uint128(5000000000)), (bytes32(0xdd * 32), uint128(4999000000))],
+	//   uint128(4999900000), False,
+	// )
+	assertPayloadRoundTrip(t,
+		marshalHex(t, block.RewardChainBlock)+"00000000000007d0"+"10"+"0000000008d00000"+"01"+subEpochSummaryHex+
+			"00000002"+rep("cc", 32)+"0000000000000000000000012a05f200"+rep("dd", 32)+"00000000000000000000000129f6afc0"+
+			"0000000000000000000000012a046b60"+"00",
+		&protocols.NewPeakTimelord{
+			RewardChainBlock: block.RewardChainBlock,
+			Difficulty:       2000,
+			Deficit:          16,
+			SubSlotIters:     147849216,
+			SubEpochSummary:  subEpochSummary,
+			PreviousRewardChallenges: []protocols.RewardChallenge{
+				{Challenge: bytes32("cc"), TotalIters: types.Uint128From64(5000000000)},
+				{Challenge: bytes32("dd"), TotalIters: types.Uint128From64(4999000000)},
+			},
+			LastChallengeSBOrEOSTotalIters: types.Uint128From64(4999900000),
+		},
+	)
+
+	// NewUnfinishedBlockTimelord(unfinished_block.reward_chain_block, uint64(2000), uint64(147849216), unfinished_block.foliage, None, bytes32(0xee * 32))
+	assertPayloadRoundTrip(t,
+		marshalHex(t, unfinishedBlock.RewardChainBlock)+"00000000000007d0"+"0000000008d00000"+marshalHex(t, unfinishedBlock.Foliage)+"00"+rep("ee", 32),
+		&protocols.NewUnfinishedBlockTimelord{
+			RewardChainBlock: unfinishedBlock.RewardChainBlock,
+			Difficulty:       2000,
+			SubSlotIters:     147849216,
+			Foliage:          unfinishedBlock.Foliage,
+			RCPrev:           bytes32("ee"),
+		},
+	)
+
+	// NewEndOfSubSlotVDF(full_block.finished_sub_slots[0])
+	assertPayloadRoundTrip(t, marshalHex(t, block.FinishedSubSlots[0]), &protocols.NewEndOfSubSlotVDF{EndOfSubSlotBundle: block.FinishedSubSlots[0]})
+}
+
+func TestTimelordMessages_JSON(t *testing.T) {
+	jsonBytes, err := streamable.MarshalJSON(&protocols.NewInfusionPointVDF{})
+	assert.NoError(t, err)
+
+	for _, name := range []string{
+		`"challenge_chain_ip_vdf":`,
+		`"challenge_chain_ip_proof":`,
+		`"infused_challenge_chain_ip_vdf":null`,
+		`"infused_challenge_chain_ip_proof":null`,
+	} {
+		assert.Contains(t, string(jsonBytes), name)
+	}
+
+	jsonBytes, err = streamable.MarshalJSON(&protocols.NewPeakTimelord{})
+	assert.NoError(t, err)
+
+	for _, name := range []string{
+		`"previous_reward_challenges":[]`,
+		`"last_challenge_sb_or_eos_total_iters":`,
+		`"passes_ses_height_but_not_yet_included":false`,
+	} {
+		assert.Contains(t, string(jsonBytes), name)
+	}
+}
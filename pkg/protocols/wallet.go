@@ -0,0 +1,442 @@
+package protocols
+
+import (
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// MempoolInclusionStatus is the status of a transaction sent to the mempool
+// Source for the statuses is chia/types/mempool_inclusion_status.py
+type MempoolInclusionStatus uint8
+
+const (
+	// MempoolInclusionStatusSuccess Transaction added to mempool
+	MempoolInclusionStatusSuccess MempoolInclusionStatus = 1
+
+	// MempoolInclusionStatusPending Transaction not yet added to mempool
+	MempoolInclusionStatusPending MempoolInclusionStatus = 2
+
+	// MempoolInclusionStatusFailed Transaction was invalid and dropped
+	MempoolInclusionStatusFailed MempoolInclusionStatus = 3
+)
+
+// RequestPuzzleSolution requests the puzzle and solution a coin was spent with
+type RequestPuzzleSolution struct {
+	CoinName types.Bytes32 `streamable:""`
+	Height   uint32        `streamable:""` // Height the coin was spent at
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestPuzzleSolution) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestPuzzleSolution
+}
+
+// PuzzleSolutionResponse is the puzzle and solution a coin was spent with
+type PuzzleSolutionResponse struct {
+	CoinName types.Bytes32           `streamable:""`
+	Height   uint32                  `streamable:""`
+	Puzzle   types.SerializedProgram `streamable:""`
+	Solution types.SerializedProgram `streamable:""`
+}
+
+// RespondPuzzleSolution is the response to request_puzzle_solution
+type RespondPuzzleSolution struct {
+	Response PuzzleSolutionResponse `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondPuzzleSolution) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondPuzzleSolution
+}
+
+// RejectPuzzleSolution is the response to request_puzzle_solution when the coin wasn't spent at the height
+type RejectPuzzleSolution struct {
+	CoinName types.Bytes32 `streamable:""`
+	Height   uint32        `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectPuzzleSolution) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectPuzzleSolution
+}
+
+// SendTransaction sends a spend bundle to the full node for the mempool
+type SendTransaction struct {
+	Transaction types.SpendBundle `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (s SendTransaction) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeSendTransaction
+}
+
+// TransactionAck is the response to send_transaction
+// Error is only set when the status is failed
+type TransactionAck struct {
+	TxID   types.Bytes32          `streamable:"name=txid"`
+	Status MempoolInclusionStatus `streamable:""`
+	Error  *string                `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (t TransactionAck) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeTransactionAck
+}
+
+// NewPeakWallet is sent to wallets when the node has a new peak
+type NewPeakWallet struct {
+	HeaderHash                types.Bytes32 `streamable:""`
+	Height                    uint32        `streamable:""`
+	Weight                    types.Uint128 `streamable:""`
+	ForkPointWithPreviousPeak uint32        `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (n NewPeakWallet) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeNewPeakWallet
+}
+
+// RequestBlockHeader requests the header block at a height
+type RequestBlockHeader struct {
+	Height uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestBlockHeader) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestBlockHeader
+}
+
+// RespondBlockHeader is the response to request_block_header
+type RespondBlockHeader struct {
+	HeaderBlock types.HeaderBlock `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondBlockHeader) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondBlockHeader
+}
+
+// RejectHeaderRequest is the response to request_block_header when the block isn't available
+type RejectHeaderRequest struct {
+	Height uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectHeaderRequest) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectHeaderRequest
+}
+
+// RequestRemovals requests the coins spent in a block
+// If CoinNames is nil, all of the removals in the block are returned, without proofs
+type RequestRemovals struct {
+	Height     uint32           `streamable:""`
+	HeaderHash types.Bytes32    `streamable:""`
+	CoinNames  *[]types.Bytes32 `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestRemovals) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestRemovals
+}
+
+// RemovedCoin is a coin name, and the coin if it was removed in the block
+// This represents the Tuple[bytes32, Optional[Coin]] in the Python code
+type RemovedCoin struct {
+	CoinName types.Bytes32 `streamable:""`
+	Coin     *types.Coin   `streamable:"optional"`
+}
+
+// RemovalProof is a coin name and the merkle proof of its inclusion or exclusion in the removals of a block
+// This represents the Tuple[bytes32, bytes] in the Python code
+type RemovalProof struct {
+	CoinName types.Bytes32 `streamable:""`
+	Proof    []byte        `streamable:""`
+}
+
+// RespondRemovals is the response to request_removals
+type RespondRemovals struct {
+	Height     uint32          `streamable:""`
+	HeaderHash types.Bytes32   `streamable:""`
+	Coins      []RemovedCoin   `streamable:"tuple"`          // List[Tuple[bytes32, Optional[Coin]]]
+	Proofs     *[]RemovalProof `streamable:"optional,tuple"` // Optional[List[Tuple[bytes32, bytes]]]
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondRemovals) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondRemovals
+}
+
+// RejectRemovalsRequest is the response to request_removals when the block isn't available
+type RejectRemovalsRequest struct {
+	Height     uint32        `streamable:""`
+	HeaderHash types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectRemovalsRequest) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectRemovalsRequest
+}
+
+// RequestAdditions requests the coins created in a block
+// If PuzzleHashes is nil, all of the additions in the block are returned, without proofs
+type RequestAdditions struct {
+	Height       uint32           `streamable:""`
+	HeaderHash   *types.Bytes32   `streamable:"optional"`
+	PuzzleHashes *[]types.Bytes32 `streamable:"optional"`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestAdditions) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestAdditions
+}
+
+// PuzzleHashCoins is a puzzle hash, and the coins created with it in the block
+// This represents the Tuple[bytes32, List[Coin]] in the Python code
+type PuzzleHashCoins struct {
+	PuzzleHash types.Bytes32 `streamable:""`
+	Coins      []types.Coin  `streamable:""`
+}
+
+// AdditionProof is a puzzle hash, the merkle proof of the puzzle hash in the additions of a block,
+// and the proof of the list of coins when there are any
+// This represents the Tuple[bytes32, bytes, Optional[bytes]] in the Python code
+type AdditionProof struct {
+	PuzzleHash    types.Bytes32 `streamable:""`
+	Proof         []byte        `streamable:""`
+	CoinListProof *[]byte       `streamable:"optional"`
+}
+
+// RespondAdditions is the response to request_additions
+type RespondAdditions struct {
+	Height     uint32            `streamable:""`
+	HeaderHash types.Bytes32     `streamable:""`
+	Coins      []PuzzleHashCoins `streamable:"tuple"`          // List[Tuple[bytes32, List[Coin]]]
+	Proofs     *[]AdditionProof  `streamable:"optional,tuple"` // Optional[List[Tuple[bytes32, bytes, Optional[bytes]]]]
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondAdditions) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondAdditions
+}
+
+// RejectAdditionsRequest is the response to request_additions when the block isn't available
+type RejectAdditionsRequest struct {
+	Height     uint32        `streamable:""`
+	HeaderHash types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectAdditionsRequest) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectAdditionsRequest
+}
+
+// RequestHeaderBlocks requests the header blocks from start height to end height, inclusive
+type RequestHeaderBlocks struct {
+	StartHeight uint32 `streamable:""`
+	EndHeight   uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestHeaderBlocks) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestHeaderBlocks
+}
+
+// RejectHeaderBlocks is the response to request_header_blocks when the blocks aren't available
+type RejectHeaderBlocks struct {
+	StartHeight uint32 `streamable:""`
+	EndHeight   uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectHeaderBlocks) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectHeaderBlocks
+}
+
+// RespondHeaderBlocks is the response to request_header_blocks
+type RespondHeaderBlocks struct {
+	StartHeight  uint32              `streamable:""`
+	EndHeight    uint32              `streamable:""`
+	HeaderBlocks []types.HeaderBlock `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondHeaderBlocks) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondHeaderBlocks
+}
+
+// RequestBlockHeaders requests the header blocks from start height to end height, inclusive
+// This replaces request_header_blocks, and can leave out the transactions filter of each block
+type RequestBlockHeaders struct {
+	StartHeight  uint32 `streamable:""`
+	EndHeight    uint32 `streamable:""`
+	ReturnFilter bool   `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestBlockHeaders) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestBlockHeaders
+}
+
+// RejectBlockHeaders is the response to request_block_headers when the blocks aren't available
+type RejectBlockHeaders struct {
+	StartHeight uint32 `streamable:""`
+	EndHeight   uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RejectBlockHeaders) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRejectBlockHeaders
+}
+
+// RespondBlockHeaders is the response to request_block_headers
+type RespondBlockHeaders struct {
+	StartHeight  uint32              `streamable:""`
+	EndHeight    uint32              `streamable:""`
+	HeaderBlocks []types.HeaderBlock `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondBlockHeaders) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondBlockHeaders
+}
+
+// RegisterForPHUpdates subscribes to updates for coins with any of the puzzle hashes
+// Corresponds to RegisterForPhUpdates in chia
+type RegisterForPHUpdates struct {
+	PuzzleHashes []types.Bytes32 `streamable:""`
+	MinHeight    uint32          `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RegisterForPHUpdates) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRegisterInterestInPuzzleHash
+}
+
+// RespondToPHUpdates is the response to register_interest_in_puzzle_hash, with the current state of matching coins
+// Corresponds to RespondToPhUpdates in chia
+type RespondToPHUpdates struct {
+	PuzzleHashes []types.Bytes32   `streamable:""`
+	MinHeight    uint32            `streamable:""`
+	CoinStates   []types.CoinState `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondToPHUpdates) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondToPHUpdate
+}
+
+// RegisterForCoinUpdates subscribes to updates for the coins
+type RegisterForCoinUpdates struct {
+	CoinIDs   []types.Bytes32 `streamable:"name=coin_ids"`
+	MinHeight uint32          `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RegisterForCoinUpdates) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRegisterInterestInCoin
+}
+
+// RespondToCoinUpdates is the response to register_interest_in_coin, with the current state of the coins
+type RespondToCoinUpdates struct {
+	CoinIDs    []types.Bytes32   `streamable:"name=coin_ids"`
+	MinHeight  uint32            `streamable:""`
+	CoinStates []types.CoinState `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondToCoinUpdates) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondToCoinUpdate
+}
+
+// CoinStateUpdate is sent to subscribed wallets when subscribed coins change
+type CoinStateUpdate struct {
+	Height     uint32            `streamable:""`
+	ForkHeight uint32            `streamable:""`
+	PeakHash   types.Bytes32     `streamable:""`
+	Items      []types.CoinState `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (c CoinStateUpdate) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeCoinStateUpdate
+}
+
+// RequestChildren requests the coins created by spending a coin
+type RequestChildren struct {
+	CoinName types.Bytes32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestChildren) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestChildren
+}
+
+// RespondChildren is the response to request_children
+type RespondChildren struct {
+	CoinStates []types.CoinState `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondChildren) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondChildren
+}
+
+// RequestSESInfo requests the sub epoch summaries between two heights
+type RequestSESInfo struct {
+	StartHeight uint32 `streamable:""`
+	EndHeight   uint32 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestSESInfo) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestSESHashes
+}
+
+// RespondSESInfo is the response to request_ses_hashes
+// Heights has the heights covered by each of the reward chain hashes
+type RespondSESInfo struct {
+	RewardChainHash []types.Bytes32 `streamable:""`
+	Heights         [][]uint32      `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondSESInfo) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondSESHashes
+}
+
+// RequestFeeEstimates requests fee estimates for each of the time targets (unix timestamps)
+type RequestFeeEstimates struct {
+	TimeTargets []uint64 `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RequestFeeEstimates) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRequestFeeEstimates
+}
+
+// FeeRate corresponds to FeeRate in chia
+type FeeRate struct {
+	MojosPerCLVMCost uint64 `streamable:"name=mojos_per_clvm_cost"`
+}
+
+// FeeEstimate corresponds to FeeEstimate in chia
+type FeeEstimate struct {
+	Error            *string `streamable:"optional"`
+	TimeTarget       uint64  `streamable:""` // Unix timestamp in seconds
+	EstimatedFeeRate FeeRate `streamable:""`
+}
+
+// FeeEstimateGroup corresponds to FeeEstimateGroup in chia
+type FeeEstimateGroup struct {
+	Error     *string       `streamable:"optional"`
+	Estimates []FeeEstimate `streamable:""`
+}
+
+// RespondFeeEstimates is the response to request_fee_estimates
+type RespondFeeEstimates struct {
+	Estimates FeeEstimateGroup `streamable:""`
+}
+
+// ProtocolMessageType implements Payload
+func (r RespondFeeEstimates) ProtocolMessageType() ProtocolMessageType {
+	return ProtocolMessageTypeRespondFeeEstimates
+}
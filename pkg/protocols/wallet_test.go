@@ -0,0 +1,271 @@
+package protocols_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+// Coin(bytes32(0xab * 32), bytes32(0xcd * 32), uint64(1))
+var (
+	walletCoinHex = rep("ab", 32) + rep("cd", 32) + "0000000000000001"
+	walletCoin    = types.Coin{ParentCoinInfo: bytes32("ab"), PuzzleHash: bytes32("cd"), Amount: 1}
+)
+
+func ptrString(s string) *string {
+	return &s
+}
+
+func TestWalletMessages(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		expected   protocols.Payload
+	}{
+		{
+			// RequestPuzzleSolution(bytes32(0x11 * 32), uint32(1000000))
+			encodedHex: rep("11", 32) + "000f4240",
+			expected:   &protocols.RequestPuzzleSolution{CoinName: bytes32("11"), Height: 1000000},
+		},
+		{
+			// RespondPuzzleSolution(PuzzleSolutionResponse(bytes32(0x11 * 32), uint32(1000000), (q . 1), ()))
+			encodedHex: rep("11", 32) + "000f4240" + "ff0101" + "80",
+			expected: &protocols.RespondPuzzleSolution{Response: protocols.PuzzleSolutionResponse{
+				CoinName: bytes32("11"),
+				Height:   1000000,
+				Puzzle:   types.SerializedProgram{0xff, 0x01, 0x01},
+				Solution: types.SerializedProgram{0x80},
+			}},
+		},
+		{
+			// RejectPuzzleSolution(bytes32(0x11 * 32), uint32(1000000))
+			encodedHex: rep("11", 32) + "000f4240",
+			expected:   &protocols.RejectPuzzleSolution{CoinName: bytes32("11"), Height: 1000000},
+		},
+		{
+			// SendTransaction(SpendBundle([CoinSpend(Coin(0xab * 32, 0xcd * 32, 1), (q . 1), ())], G2Element()))
+			encodedHex: "00000001" + walletCoinHex + "ff0101" + "80" + "c0" + rep("00", 95),
+			expected: &protocols.SendTransaction{Transaction: types.SpendBundle{
+				CoinSpends:          []types.CoinSpend{{Coin: walletCoin, PuzzleReveal: types.SerializedProgram{0xff, 0x01, 0x01}, Solution: types.SerializedProgram{0x80}}},
+				AggregatedSignature: types.G2Element{0xc0},
+			}},
+		},
+		{
+			// TransactionAck(bytes32(0x33 * 32), uint8(MempoolInclusionStatus.FAILED), "DOUBLE_SPEND")
+			encodedHex: rep("33", 32) + "03" + "01" + "0000000c" + hex.EncodeToString([]byte("DOUBLE_SPEND")),
+			expected:   &protocols.TransactionAck{TxID: bytes32("33"), Status: protocols.MempoolInclusionStatusFailed, Error: ptrString("DOUBLE_SPEND")},
+		},
+		{
+			// NewPeakWallet(bytes32(0x11 * 32), uint32(1000000), uint128(3500000), uint32(999990))
+			encodedHex: rep("11", 32) + "000f4240" + "000000000000000000000000003567e0" + "000f4236",
+			expected: &protocols.NewPeakWallet{
+				HeaderHash:                bytes32("11"),
+				Height:                    1000000,
+				Weight:                    types.Uint128From64(3500000),
+				ForkPointWithPreviousPeak: 999990,
+			},
+		},
+		{
+			// RequestBlockHeader(uint32(1000000))
+			encodedHex: "000f4240",
+			expected:   &protocols.RequestBlockHeader{Height: 1000000},
+		},
+		{
+			// RejectHeaderRequest(uint32(1000000))
+			encodedHex: "000f4240",
+			expected:   &protocols.RejectHeaderRequest{Height: 1000000},
+		},
+		{
+			// RequestRemovals(uint32(1000000), bytes32(0x11 * 32), [bytes32(0x22 * 32)])
+			encodedHex: "000f4240" + rep("11", 32) + "01" + "00000001" + rep("22", 32),
+			expected:   &protocols.RequestRemovals{Height: 1000000, HeaderHash: bytes32("11"), CoinNames: &[]types.Bytes32{bytes32("22")}},
+		},
+		{
+			// RespondRemovals(
+			//   uint32(1000000), bytes32(0x11 * 32),
+			//   [(bytes32(0x22 * 32), Coin(0xab * 32, 0xcd * 32, 1)), (bytes32(0x33 * 32), None)],
+			//   [(bytes32(0x22 * 32), bytes(0x0102))],
+			// )
+			encodedHex: "000f4240" + rep("11", 32) +
+				"00000002" + rep("22", 32) + "01" + walletCoinHex + rep("33", 32) + "00" +
+				"01" + "00000001" + rep("22", 32) + "00000002" + "0102",
+			expected: &protocols.RespondRemovals{
+				Height:     1000000,
+				HeaderHash: bytes32("11"),
+				Coins:      []protocols.RemovedCoin{{CoinName: bytes32("22"), Coin: &walletCoin}, {CoinName: bytes32("33")}},
+				Proofs:     &[]protocols.RemovalProof{{CoinName: bytes32("22"), Proof: []byte{1, 2}}},
+			},
+		},
+		{
+			// RejectRemovalsRequest(uint32(1000000), bytes32(0x11 * 32))
+			encodedHex: "000f4240" + rep("11", 32),
+			expected:   &protocols.RejectRemovalsRequest{Height: 1000000, HeaderHash: bytes32("11")},
+		},
+		{
+			// RequestAdditions(uint32(1000000), None, [bytes32(0xcd * 32)])
+			encodedHex: "000f4240" + "00" + "01" + "00000001" + rep("cd", 32),
+			expected:   &protocols.RequestAdditions{Height: 1000000, PuzzleHashes: &[]types.Bytes32{bytes32("cd")}},
+		},
+		{
+			// RespondAdditions(
+			//   uint32(1000000), bytes32(0x11 * 32),
+			//   [(bytes32(0xcd * 32), [Coin(0xab * 32, 0xcd * 32, 1)])],
+			//   [(bytes32(0xcd * 32), bytes(0x0102), bytes(0x0304)), (bytes32(0xee * 32), bytes(0x05), None)],
+			// )
+			encodedHex: "000f4240" + rep("11", 32) +
+				"00000001" + rep("cd", 32) + "00000001" + walletCoinHex +
+				"01" + "00000002" +
+				rep("cd", 32) + "00000002" + "0102" + "01" + "00000002" + "0304" +
+				rep("ee", 32) + "00000001" + "05" + "00",
+			expected: &protocols.RespondAdditions{
+				Height:     1000000,
+				HeaderHash: bytes32("11"),
+				Coins:      []protocols.PuzzleHashCoins{{PuzzleHash: bytes32("cd"), Coins: []types.Coin{walletCoin}}},
+				Proofs: &[]protocols.AdditionProof{
+					{PuzzleHash: bytes32("cd"), Proof: []byte{1, 2}, CoinListProof: &[]byte{3, 4}},
+					{PuzzleHash: bytes32("ee"), Proof: []byte{5}},
+				},
+			},
+		},
+		{
+			// RejectAdditionsRequest(uint32(1000000), bytes32(0x11 * 32))
+			encodedHex: "000f4240" + rep("11", 32),
+			expected:   &protocols.RejectAdditionsRequest{Height: 1000000, HeaderHash: bytes32("11")},
+		},
+		{
+			// RequestHeaderBlocks(uint32(1000000), uint32(1000031))
+			encodedHex: "000f4240" + "000f425f",
+			expected:   &protocols.RequestHeaderBlocks{StartHeight: 1000000, EndHeight: 1000031},
+		},
+		{
+			// RejectHeaderBlocks(uint32(1000000), uint32(1000031))
+			encodedHex: "000f4240" + "000f425f",
+			expected:   &protocols.RejectHeaderBlocks{StartHeight: 1000000, EndHeight: 1000031},
+		},
+		{
+			// RequestBlockHeaders(uint32(1000000), uint32(1000031), True)
+			encodedHex: "000f4240" + "000f425f" + "01",
+			expected:   &protocols.RequestBlockHeaders{StartHeight: 1000000, EndHeight: 1000031, ReturnFilter: true},
+		},
+		{
+			// RejectBlockHeaders(uint32(1000000), uint32(1000031))
+			encodedHex: "000f4240" + "000f425f",
+			expected:   &protocols.RejectBlockHeaders{StartHeight: 1000000, EndHeight: 1000031},
+		},
+		{
+			// RegisterForPhUpdates([bytes32(0xcd * 32)], uint32(1000000))
+			encodedHex: "00000001" + rep("cd", 32) + "000f4240",
+			expected:   &protocols.RegisterForPHUpdates{PuzzleHashes: []types.Bytes32{bytes32("cd")}, MinHeight: 1000000},
+		},
+		{
+			// RespondToPhUpdates([bytes32(0xcd * 32)], uint32(1000000), [CoinState(Coin(0xab * 32, 0xcd * 32, 1), None, uint32(1000000))])
+			encodedHex: "00000001" + rep("cd", 32) + "000f4240" + "00000001" + walletCoinHex + "00" + "01" + "000f4240",
+			expected: &protocols.RespondToPHUpdates{
+				PuzzleHashes: []types.Bytes32{bytes32("cd")},
+				MinHeight:    1000000,
+				CoinStates:   []types.CoinState{{Coin: walletCoin, CreatedHeight: util.PtrUint32(1000000)}},
+			},
+		},
+		{
+			// RegisterForCoinUpdates([bytes32(0x22 * 32)], uint32(0))
+			encodedHex: "00000001" + rep("22", 32) + "00000000",
+			expected:   &protocols.RegisterForCoinUpdates{CoinIDs: []types.Bytes32{bytes32("22")}},
+		},
+		{
+			// RespondToCoinUpdates([bytes32(0x22 * 32)], uint32(0), [CoinState(Coin(0xab * 32, 0xcd * 32, 1), uint32(1000002), uint32(1000000))])
+			encodedHex: "00000001" + rep("22", 32) + "00000000" + "00000001" + walletCoinHex + "01" + "000f4242" + "01" + "000f4240",
+			expected: &protocols.RespondToCoinUpdates{
+				CoinIDs:    []types.Bytes32{bytes32("22")},
+				CoinStates: []types.CoinState{{Coin: walletCoin, SpentHeight: util.PtrUint32(1000002), CreatedHeight: util.PtrUint32(1000000)}},
+			},
+		},
+		{
+			// CoinStateUpdate(uint32(1000002), uint32(1000001), bytes32(0x11 * 32), [CoinState(Coin(0xab * 32, 0xcd * 32, 1), uint32(1000002), uint32(1000000))])
+			encodedHex: "000f4242" + "000f4241" + rep("11", 32) + "00000001" + walletCoinHex + "01" + "000f4242" + "01" + "000f4240",
+			expected: &protocols.CoinStateUpdate{
+				Height:     1000002,
+				ForkHeight: 1000001,
+				PeakHash:   bytes32("11"),
+				Items:      []types.CoinState{{Coin: walletCoin, SpentHeight: util.PtrUint32(1000002), CreatedHeight: util.PtrUint32(1000000)}},
+			},
+		},
+		{
+			// RequestChildren(bytes32(0x22 * 32))
+			encodedHex: rep("22", 32),
+			expected:   &protocols.RequestChildren{CoinName: bytes32("22")},
+		},
+		{
+			// RespondChildren([])
+			encodedHex: "00000000",
+			expected:   &protocols.RespondChildren{CoinStates: []types.CoinState{}},
+		},
+		{
+			// RequestSESInfo(uint32(1000000), uint32(1000031))
+			encodedHex: "000f4240" + "000f425f",
+			expected:   &protocols.RequestSESInfo{StartHeight: 1000000, EndHeight: 1000031},
+		},
+		{
+			// RespondSESInfo([bytes32(0x44 * 32), bytes32(0x55 * 32)], [[uint32(1), uint32(2)], [uint32(3)]])
+			encodedHex: "00000002" + rep("44", 32) + rep("55", 32) + "00000002" + "00000002" + "00000001" + "00000002" + "00000001" + "00000003",
+			expected: &protocols.RespondSESInfo{
+				RewardChainHash: []types.Bytes32{bytes32("44"), bytes32("55")},
+				Heights:         [][]uint32{{1, 2}, {3}},
+			},
+		},
+		{
+			// RequestFeeEstimates([uint64(1650000060), uint64(1650000300)])
+			encodedHex: "00000002" + "00000000625900bc" + "00000000625901ac",
+			expected:   &protocols.RequestFeeEstimates{TimeTargets: []uint64{1650000060, 1650000300}},
+		},
+		{
+			// RespondFeeEstimates(FeeEstimateGroup(None, [
+			//   FeeEstimate(None, uint64(1650000060), FeeRate(uint64(5))),
+			//   FeeEstimate("not enough data", uint64(1650000300), FeeRate(uint64(0))),
+			// ]))
+			encodedHex: "00" + "00000002" +
+				"00" + "00000000625900bc" + "0000000000000005" +
+				"01" + "0000000f" + hex.EncodeToString([]byte("not enough data")) + "00000000625901ac" + "0000000000000000",
+			expected: &protocols.RespondFeeEstimates{Estimates: protocols.FeeEstimateGroup{
+				Estimates: []protocols.FeeEstimate{
+					{TimeTarget: 1650000060, EstimatedFeeRate: protocols.FeeRate{MojosPerCLVMCost: 5}},
+					{Error: ptrString("not enough data"), TimeTarget: 1650000300},
+				},
+			}},
+		},
+	} {
+		assertPayloadRoundTrip(t, test.encodedHex, test.expected)
+	}
+}
+
+func TestWalletMessages_HeaderBlocks(t *testing.T) {
+	headerBlockHex := readBlockHex(t, "header_block")
+	headerBlockBytes, err := hex.DecodeString(headerBlockHex)
+	assert.NoError(t, err)
+	block := types.HeaderBlock{}
+	assert.NoError(t, streamable.Unmarshal(headerBlockBytes, &block))
+
+	assertPayloadRoundTrip(t, headerBlockHex, &protocols.RespondBlockHeader{HeaderBlock: block})
+	assertPayloadRoundTrip(t, "000f4241"+"000f4241"+"00000001"+headerBlockHex, &protocols.RespondHeaderBlocks{
+		StartHeight:  1000001,
+		EndHeight:    1000001,
+		HeaderBlocks: []types.HeaderBlock{block},
+	})
+	assertPayloadRoundTrip(t, "000f4241"+"000f4241"+"00000001"+headerBlockHex, &protocols.RespondBlockHeaders{
+		StartHeight:  1000001,
+		EndHeight:    1000001,
+		HeaderBlocks: []types.HeaderBlock{block},
+	})
+}
+
+func TestTransactionAck_JSON(t *testing.T) {
+	ack := &protocols.TransactionAck{TxID: bytes32("33"), Status: protocols.MempoolInclusionStatusSuccess}
+
+	jsonBytes, err := streamable.MarshalJSON(ack)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"txid":"0x`+rep("33", 32)+`","status":1,"error":null}`, string(jsonBytes))
+}
@@ -0,0 +1,182 @@
+package streamable_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// benchTransaction and benchBlock roughly follow the shape of a full block, to give the reflective path
+// something with plenty of nesting, optionals, lists, and fixed size bytes to work through
+type benchTransaction struct {
+	ParentCoinInfo types.Bytes32   `streamable:""`
+	PuzzleHash     types.Bytes32   `streamable:""`
+	Amount         uint64          `streamable:""`
+	Signature      types.G2Element `streamable:""`
+	Memo           []byte          `streamable:""`
+}
+
+type benchBlock struct {
+	Height            uint32             `streamable:""`
+	Weight            types.Uint128      `streamable:""`
+	TotalIters        types.Uint128      `streamable:""`
+	PrevHeaderHash    types.Bytes32      `streamable:""`
+	FarmerPublicKey   types.G1Element    `streamable:""`
+	PoolPublicKey     *types.G1Element   `streamable:"optional"`
+	IsTransactional   bool               `streamable:""`
+	Transactions      []benchTransaction `streamable:""`
+	FinishedSubSlots  []types.Bytes32    `streamable:""`
+	TransactionsInfo  *benchTransaction  `streamable:"optional"`
+	TransactionFilter []byte             `streamable:""`
+}
+
+func benchHandshake() *protocols.Handshake {
+	return &protocols.Handshake{
+		NetworkID:       "mainnet",
+		ProtocolVersion: "0.0.33",
+		SoftwareVersion: "1.2.11",
+		ServerPort:      8444,
+		NodeType:        protocols.NodeTypeFullNode,
+		Capabilities: []protocols.Capability{
+			{Capability: protocols.CapabilityTypeBase, Value: "1"},
+		},
+	}
+}
+
+func benchRespondPeers() *protocols.RespondPeers {
+	rp := &protocols.RespondPeers{}
+	for i := 0; i < 1000; i++ {
+		rp.PeerList = append(rp.PeerList, types.TimestampedPeerInfo{
+			Host:      fmt.Sprintf("192.168.%d.%d", i/256, i%256),
+			Port:      8444,
+			Timestamp: 1643000000 + uint64(i),
+		})
+	}
+
+	return rp
+}
+
+// benchLargeMessage is a message carrying 1MB of data, about the size of a large transactions_generator
+func benchLargeMessage() *protocols.Message {
+	return &protocols.Message{
+		ProtocolMessageType: protocols.ProtocolMessageTypeRespondPeers,
+		Data:                make([]byte, 1<<20),
+	}
+}
+
+func benchLargeBlock() *benchBlock {
+	block := &benchBlock{
+		Height:            1500000,
+		Weight:            types.NewUint128(1, 2),
+		TotalIters:        types.Uint128From64(123456789),
+		PoolPublicKey:     &types.G1Element{0xaa},
+		IsTransactional:   true,
+		TransactionsInfo:  &benchTransaction{Amount: 1750000000000},
+		TransactionFilter: make([]byte, 4096),
+	}
+	for i := 0; i < 2000; i++ {
+		block.Transactions = append(block.Transactions, benchTransaction{
+			ParentCoinInfo: types.Bytes32{byte(i)},
+			PuzzleHash:     types.Bytes32{byte(i >> 8)},
+			Amount:         uint64(i),
+			Memo:           []byte("memo"),
+		})
+	}
+	for i := 0; i < 64; i++ {
+		block.FinishedSubSlots = append(block.FinishedSubSlots, types.Bytes32{byte(i)})
+	}
+
+	return block
+}
+
+func benchmarkMarshal(b *testing.B, v interface{}) {
+	encodedBytes, err := streamable.Marshal(v)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(encodedBytes)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var buf []byte
+	for i := 0; i < b.N; i++ {
+		buf, err = streamable.MarshalAppend(buf[:0], v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkUnmarshal(b *testing.B, v interface{}, newValue func() interface{}) {
+	benchmarkUnmarshalWithOptions(b, v, newValue, streamable.DecodeOptions{})
+}
+
+func benchmarkUnmarshalWithOptions(b *testing.B, v interface{}, newValue func() interface{}, opts streamable.DecodeOptions) {
+	encodedBytes, err := streamable.Marshal(v)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(encodedBytes)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = streamable.UnmarshalWithOptions(encodedBytes, newValue(), opts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshal_Handshake(b *testing.B) {
+	benchmarkMarshal(b, benchHandshake())
+}
+
+func BenchmarkUnmarshal_Handshake(b *testing.B) {
+	benchmarkUnmarshal(b, benchHandshake(), func() interface{} { return &protocols.Handshake{} })
+}
+
+func BenchmarkMarshal_RespondPeers(b *testing.B) {
+	benchmarkMarshal(b, benchRespondPeers())
+}
+
+func BenchmarkUnmarshal_RespondPeers(b *testing.B) {
+	benchmarkUnmarshal(b, benchRespondPeers(), func() interface{} { return &protocols.RespondPeers{} })
+}
+
+func BenchmarkMarshal_LargeBlock(b *testing.B) {
+	benchmarkMarshal(b, benchLargeBlock())
+}
+
+func BenchmarkUnmarshal_LargeBlock(b *testing.B) {
+	benchmarkUnmarshal(b, benchLargeBlock(), func() interface{} { return &benchBlock{} })
+}
+
+func BenchmarkUnmarshal_LargeBlock_ZeroCopy(b *testing.B) {
+	opts := streamable.DecodeOptions{ZeroCopy: true}
+	benchmarkUnmarshalWithOptions(b, benchLargeBlock(), func() interface{} { return &benchBlock{} }, opts)
+}
+
+func BenchmarkUnmarshal_LargeMessage(b *testing.B) {
+	benchmarkUnmarshal(b, benchLargeMessage(), func() interface{} { return &protocols.Message{} })
+}
+
+func BenchmarkUnmarshal_LargeMessage_ZeroCopy(b *testing.B) {
+	opts := streamable.DecodeOptions{ZeroCopy: true}
+	benchmarkUnmarshalWithOptions(b, benchLargeMessage(), func() interface{} { return &protocols.Message{} }, opts)
+}
+
+func BenchmarkSize_LargeBlock(b *testing.B) {
+	block := benchLargeBlock()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, err := streamable.Size(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package streamable
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+// decodeState keeps track of where bytes are coming from while decoding
+// Bytes either come from an in memory buffer (Unmarshal) or are read from an io.Reader as they are needed (Decoder)
+type decodeState struct {
+	// bytes is the remaining bytes to decode when decoding from memory
+	bytes []byte
+
+	// reader is the source of bytes when decoding from a stream
+	// When reader is set, bytes is not used
+	reader io.Reader
+
+	// scratch is reused between reads from reader, to avoid allocating for every field
+	scratch []byte
+
+	// offset is the number of bytes consumed so far, which is reported in errors
+	offset int64
+
+	// opts are the limits for the decode, with defaults already filled in
+	opts DecodeOptions
+
+	// allocated and depth track usage against the limits in opts
+	allocated uint64
+	depth     int
+
+	// explain is set when Explain is recording what each byte of the input is
+	explain *explainer
+}
+
+// readChunkSize limits how far ahead of the data actually arriving we allocate, when reading from a stream
+// Lengths come from the data, so a large length followed by nothing shouldn't cause a large allocation
+const readChunkSize = 64 * 1024
+
+// next returns the next numBytes bytes from the source
+// The returned bytes are only valid until the next call to next, and must be copied if they need to be retained
+// If there aren't enough bytes left, a *DecodeError wrapping io.ErrUnexpectedEOF is returned
+func (d *decodeState) next(numBytes uint) ([]byte, error) {
+	if d.reader == nil {
+		requestedBytes, remainingBytes, err := util.ShiftNBytes(numBytes, d.bytes)
+		if err != nil {
+			return nil, d.shortError(numBytes, len(d.bytes))
+		}
+		d.bytes = remainingBytes
+		d.offset += int64(numBytes)
+
+		return requestedBytes, nil
+	}
+
+	// Grow the scratch buffer as data arrives, rather than all at once based on what was requested
+	requestedBytes := d.scratch[:0]
+	for uint(len(requestedBytes)) < numBytes {
+		// Each read at most doubles what we have so far, so allocations stay in proportion to the data received
+		maxChunk := uint(len(requestedBytes))
+		if maxChunk < readChunkSize {
+			maxChunk = readChunkSize
+		}
+		chunk := numBytes - uint(len(requestedBytes))
+		if chunk > maxChunk {
+			chunk = maxChunk
+		}
+
+		filled := len(requestedBytes)
+		if uint(cap(requestedBytes)-filled) < chunk {
+			grown := make([]byte, filled, uint(filled)+chunk)
+			copy(grown, requestedBytes)
+			requestedBytes = grown
+		}
+
+		n, err := io.ReadFull(d.reader, requestedBytes[filled:uint(filled)+chunk])
+		requestedBytes = requestedBytes[:filled+n]
+		if err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				// EOF before we got everything we needed still means the data is truncated
+				return nil, d.shortError(numBytes, len(requestedBytes))
+			}
+			return nil, err
+		}
+	}
+	d.scratch = requestedBytes
+	d.offset += int64(numBytes)
+
+	return requestedBytes, nil
+}
+
+// shortError is the error when the data ends before a read of numBytes could be satisfied
+func (d *decodeState) shortError(numBytes uint, remaining int) error {
+	return &DecodeError{
+		Offset:    d.offset,
+		Expected:  int(numBytes),
+		Remaining: remaining,
+		Err:       io.ErrUnexpectedEOF,
+	}
+}
+
+// Read implements io.Reader so that the decodeState can be handed to an Unmarshaler
+func (d *decodeState) Read(p []byte) (int, error) {
+	if d.reader != nil {
+		n, err := d.reader.Read(p)
+		d.offset += int64(n)
+		return n, err
+	}
+
+	if len(d.bytes) == 0 {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		return 0, io.EOF
+	}
+
+	n := copy(p, d.bytes)
+	d.bytes = d.bytes[n:]
+	d.offset += int64(n)
+
+	return n, nil
+}
+
+// ReadByte implements io.ByteReader, which is handy for Unmarshalers that parse their data one byte at a time
+func (d *decodeState) ReadByte() (byte, error) {
+	b, err := d.next(1)
+	if err != nil {
+		if _, short := err.(*DecodeError); short {
+			// Out of bytes is the same as hitting the end of the stream, as far as io.ByteReader is concerned
+			err = io.EOF
+		}
+		return 0, err
+	}
+
+	return b[0], nil
+}
+
+// GeneratedDecoder is implemented by the io.Reader that Unmarshal and Decoder pass to UnmarshalStreamable
+// Code generated by streamablegen uses it to read values, apply the DecodeOptions, and locate errors exactly the way
+// the reflective path does, so decoding a type gives the same result and the same errors whether or not it has
+// generated code. It has no use outside of generated code, which declares a matching interface instead of importing
+// this package
+type GeneratedDecoder interface {
+	io.Reader
+	DecodeLimiter
+
+	// Next returns the next numBytes bytes, which are only valid until the next read
+	Next(numBytes uint) ([]byte, error)
+
+	// NextBytes returns the next numBytes bytes as a []byte value that can be kept
+	NextBytes(numBytes uint32) ([]byte, error)
+
+	// Offset is the number of bytes read so far
+	Offset() int64
+
+	// FieldError locates an error from decoding a field or list item
+	FieldError(err error, start int64, path string, v interface{}) error
+}
+
+// Generated code checks for these methods at runtime, and falls back to plain reads without any limits if they are missing
+var _ GeneratedDecoder = (*decodeState)(nil)
+
+// Next implements GeneratedDecoder
+// If there aren't enough bytes left, a *DecodeError wrapping io.ErrUnexpectedEOF is returned
+func (d *decodeState) Next(numBytes uint) ([]byte, error) {
+	return d.next(numBytes)
+}
+
+// NextBytes implements GeneratedDecoder
+// In zero copy mode, the bytes refer directly to the input. The capacity is limited, so that appending to them can't
+// overwrite whatever comes after them in the input. Otherwise, they are copied in one go
+func (d *decodeState) NextBytes(numBytes uint32) ([]byte, error) {
+	b, err := d.next(uint(numBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	if d.opts.ZeroCopy && d.reader == nil {
+		return b[:len(b):len(b)], nil
+	}
+
+	return append(make([]byte, 0, len(b)), b...), nil
+}
+
+// Offset implements GeneratedDecoder
+func (d *decodeState) Offset() int64 {
+	return d.offset
+}
+
+// FieldError implements GeneratedDecoder
+// It returns err as a *DecodeError, located at start if it doesn't have a more specific offset, with path added to
+// the start of its path. path is the name of the field, or [i] for a list item. v is a nil pointer to the type of the
+// field or item, such as (*uint32)(nil), which is used for the type of the error
+func (d *decodeState) FieldError(err error, start int64, path string, v interface{}) error {
+	return withPath(decodeError(err, start, reflect.TypeOf(v).Elem()), path)
+}
+
+// A Decoder reads and decodes streamable values from an input stream
+type Decoder struct {
+	r *bufio.Reader
+	d *decodeState
+}
+
+// NewDecoder returns a new decoder that reads from r
+// The decoder introduces its own buffering and may read data from r beyond the streamable values requested.
+// Any data that is read but not decoded is available from Buffered
+func NewDecoder(r io.Reader) *Decoder {
+	br := bufio.NewReader(r)
+
+	return &Decoder{
+		r: br,
+		d: &decodeState{reader: br, opts: DecodeOptions{}.withDefaults()},
+	}
+}
+
+// SetOptions sets the limits used for every following call to Decode
+func (dec *Decoder) SetOptions(opts DecodeOptions) {
+	dec.d.opts = opts.withDefaults()
+}
+
+// Decode reads the next streamable value from the input and stores it in the value pointed to by v
+// Since streamable has no framing, the input must contain values in the same order/types they are decoded
+// io.EOF is returned if the input is empty when Decode is called, otherwise truncated input results in
+// a *DecodeError wrapping io.ErrUnexpectedEOF. Offsets in errors are counted from the start of the stream
+func (dec *Decoder) Decode(v interface{}) error {
+	// Peek so we can tell a clean end of stream apart from a truncated value
+	_, err := dec.r.Peek(1)
+	if err != nil {
+		return err
+	}
+
+	// Limits apply to each value separately
+	// Custom unmarshalers that fail don't always leave every level they entered, so the depth is reset as well
+	dec.d.allocated = 0
+	dec.d.depth = 0
+
+	return unmarshal(dec.d, v)
+}
+
+// Buffered returns a reader of the data remaining in the Decoder's buffer
+// The reader is valid until the next call to Decode
+func (dec *Decoder) Buffered() io.Reader {
+	remaining, _ := dec.r.Peek(dec.r.Buffered())
+	return bytes.NewReader(remaining)
+}
@@ -0,0 +1,32 @@
+package streamable
+
+import (
+	"io"
+)
+
+// An Encoder writes streamable values to an output stream
+type Encoder struct {
+	w io.Writer
+
+	// buf is reused between calls to Encode so that each value doesn't need a new allocation
+	buf []byte
+}
+
+// NewEncoder returns a new encoder that writes to w
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the streamable encoding of v to the stream
+// Each value is written with a single call to Write
+func (enc *Encoder) Encode(v interface{}) error {
+	var err error
+
+	enc.buf, err = MarshalAppend(enc.buf[:0], v)
+	if err != nil {
+		return err
+	}
+
+	_, err = enc.w.Write(enc.buf)
+	return err
+}
@@ -1,7 +1,9 @@
 package streamable
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 )
 
 // An InvalidUnmarshalError describes an invalid argument passed to Unmarshal.
@@ -21,3 +23,103 @@ func (e *InvalidUnmarshalError) Error() string {
 	}
 	return "streamable: Unmarshal(nil " + e.Type.String() + ")"
 }
+
+// A TrailingBytesError is returned in strict mode when the value was decoded successfully,
+// but there were bytes left over afterwards
+type TrailingBytesError struct {
+	// Offset is where the unconsumed bytes start
+	Offset int64
+
+	// Remaining is the number of bytes that weren't consumed
+	Remaining int
+}
+
+// Error outputs the error message and satisfies the Error interface
+func (e *TrailingBytesError) Error() string {
+	return fmt.Sprintf("streamable: %d unconsumed bytes after decoding, starting at offset %d", e.Remaining, e.Offset)
+}
+
+// A DecodeError describes a failure while decoding streamable data, and where in the data it happened
+type DecodeError struct {
+	// Path is the field that was being decoded, such as PeerList[3].Host
+	// Empty when the failure was in the value passed to Unmarshal itself
+	Path string
+
+	// Type is the go type of the value that was being decoded
+	Type reflect.Type
+
+	// Offset is the number of bytes into the data where the value that failed starts,
+	// or where the read that came up short started
+	Offset int64
+
+	// Expected and Remaining are the number of bytes needed and the number of bytes that were actually available,
+	// when the data was too short. Both are zero for any other failure
+	Expected  int
+	Remaining int
+
+	// Err is the underlying error. For data that is too short, this is io.ErrUnexpectedEOF
+	Err error
+}
+
+// Error outputs the error message and satisfies the Error interface
+func (e *DecodeError) Error() string {
+	location := fmt.Sprintf("offset %d", e.Offset)
+	if e.Path != "" {
+		location = e.Path + " at " + location
+	}
+
+	if e.Expected > 0 {
+		return fmt.Sprintf("streamable: decoding %s: need %d bytes, only %d remaining", location, e.Expected, e.Remaining)
+	}
+
+	return fmt.Sprintf("streamable: decoding %s: %s", location, e.Err.Error())
+}
+
+// Unwrap returns the underlying error
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// prependPath adds the name of a field or the index of an item to the start of the path
+func (e *DecodeError) prependPath(segment string) {
+	e.Path = joinPath(segment, e.Path)
+}
+
+// joinPath adds segment to the start of path, such as PeerList + [3].Host
+func joinPath(segment, path string) string {
+	switch {
+	case path == "":
+		return segment
+	case strings.HasPrefix(path, "["):
+		return segment + path
+	default:
+		return segment + "." + path
+	}
+}
+
+// decodeError adds the location to err if it doesn't already have one
+// start is the offset where the value being decoded started
+func decodeError(err error, start int64, t reflect.Type) error {
+	if err == nil {
+		return nil
+	}
+
+	decodeErr, ok := err.(*DecodeError)
+	if !ok {
+		return &DecodeError{Type: t, Offset: start, Err: err}
+	}
+	if decodeErr.Type == nil {
+		decodeErr.Type = t
+	}
+
+	return decodeErr
+}
+
+// withPath adds segment to the path of err, which is always a *DecodeError while decoding
+func withPath(err error, segment string) error {
+	if decodeErr, ok := err.(*DecodeError); ok {
+		decodeErr.prependPath(segment)
+	}
+
+	return err
+}
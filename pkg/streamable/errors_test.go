@@ -0,0 +1,109 @@
+package streamable_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+func TestDecodeError_ListItem(t *testing.T) {
+	rp := &protocols.RespondPeers{
+		PeerList: []types.TimestampedPeerInfo{
+			{Host: "a", Port: 1, Timestamp: 1},
+			{Host: "b", Port: 2, Timestamp: 2},
+			{Host: "c", Port: 3, Timestamp: 3},
+			{Host: "host4", Port: 4, Timestamp: 4},
+		},
+	}
+	encodedBytes, err := streamable.Marshal(rp)
+	assert.NoError(t, err)
+
+	// 4 byte list prefix, then 3 peers of 15 bytes each (4 byte prefix + 1 byte host + uint16 + uint64)
+	// The 4th host's length prefix is at 49, so the host itself starts at 53. Cut it off 2 bytes in
+	err = streamable.Unmarshal(encodedBytes[:55], &protocols.RespondPeers{})
+
+	var decodeErr *streamable.DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, "PeerList[3].Host", decodeErr.Path)
+	assert.Equal(t, reflect.TypeOf(""), decodeErr.Type)
+	assert.Equal(t, int64(53), decodeErr.Offset)
+	assert.Equal(t, 5, decodeErr.Expected)
+	assert.Equal(t, 2, decodeErr.Remaining)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.EqualError(t, err, "streamable: decoding PeerList[3].Host at offset 53: need 5 bytes, only 2 remaining")
+}
+
+// DeepBool has an invalid bool a few levels down
+type DeepBool struct {
+	Before uint16       `streamable:""`
+	Outer  *[]DeepInner `streamable:"optional"`
+}
+
+type DeepInner struct {
+	Flags [2]bool `streamable:""`
+}
+
+func TestDecodeError_InvalidValue(t *testing.T) {
+	encodedBytes := []byte{
+		0x00, 0x01, // Before
+		0x01,                   // Outer is present
+		0x00, 0x00, 0x00, 0x02, // 2 items
+		0x01, 0x00, // Outer[0].Flags
+		0x00, 0x02, // Outer[1].Flags - second one is invalid
+	}
+
+	err := streamable.Unmarshal(encodedBytes, &DeepBool{})
+	assert.EqualError(t, err, "streamable: decoding Outer[1].Flags[1] at offset 10: invalid value for bool 2")
+
+	var decodeErr *streamable.DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, reflect.TypeOf(false), decodeErr.Type)
+	assert.Equal(t, 0, decodeErr.Expected)
+}
+
+func TestDecodeError_Prefixes(t *testing.T) {
+	// Each of these is a Message that is cut short around a presence byte or length prefix
+	for _, test := range []struct {
+		encodedHex string
+		path       string
+	}{
+		{encodedHex: "01", path: "ID"},                         // Missing the presence byte for the optional ID
+		{encodedHex: "0101", path: "ID"},                       // Present, but missing the ID
+		{encodedHex: "010189", path: "ID"},                     // Partial ID
+		{encodedHex: "0100", path: "Data"},                     // Missing the length prefix for data
+		{encodedHex: "0100000000", path: "Data"},               // Partial length prefix
+		{encodedHex: "01000000000201", path: "Data"},           // Not enough bytes
+		{encodedHex: "010189b8000000", path: "Data"},           // Partial length prefix, after the ID
+		{encodedHex: "010189b80000000501020304", path: "Data"}, // Not enough bytes, after the ID
+	} {
+		encodedBytes, err := hex.DecodeString(test.encodedHex)
+		assert.NoError(t, err)
+
+		err = streamable.Unmarshal(encodedBytes, &protocols.Message{})
+
+		var decodeErr *streamable.DecodeError
+		assert.True(t, errors.As(err, &decodeErr), test.encodedHex)
+		assert.Equal(t, test.path, decodeErr.Path, test.encodedHex)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF, test.encodedHex)
+	}
+}
+
+func TestDecodeError_String(t *testing.T) {
+	// Length prefix says 16 bytes, but there are only 3
+	err := streamable.Unmarshal([]byte{0, 0, 0, 16, 'a', 'b', 'c'}, &Inner{})
+
+	var decodeErr *streamable.DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, "Name", decodeErr.Path)
+	assert.Equal(t, int64(4), decodeErr.Offset)
+	assert.Equal(t, 16, decodeErr.Expected)
+	assert.Equal(t, 3, decodeErr.Remaining)
+}
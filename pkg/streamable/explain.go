@@ -0,0 +1,172 @@
+package streamable
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// explainHexLimit is the most bytes of raw hex shown per line by Explanation.String
+const explainHexLimit = 32
+
+// Annotation describes one piece of the encoded bytes: a single value, a length prefix, or a presence byte
+type Annotation struct {
+	// Offset is where the bytes start in the input
+	Offset int
+
+	// Length is the number of bytes
+	Length int
+
+	// Path is the field the bytes belong to, such as PeerList[3].Host
+	Path string
+
+	// Raw is the bytes themselves
+	Raw []byte
+
+	// Value is the decoded value, or a description of the prefix (34 bytes, present, etc)
+	Value string
+}
+
+// Explanation is every annotation for some encoded bytes, in order
+type Explanation []Annotation
+
+// String formats the explanation as a table, with one line per annotation
+// Long values have their raw hex cut short, but the decoded value is always shown in full
+func (e Explanation) String() string {
+	var b strings.Builder
+
+	w := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "OFFSET\tLEN\tPATH\tHEX\tVALUE")
+	for _, a := range e {
+		raw := a.Raw
+		ellipsis := ""
+		if len(raw) > explainHexLimit {
+			raw = raw[:explainHexLimit]
+			ellipsis = "..."
+		}
+
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s%s\t%s\n", a.Offset, a.Length, a.Path, hex.EncodeToString(raw), ellipsis, a.Value)
+	}
+	_ = w.Flush()
+
+	return b.String()
+}
+
+// Explain decodes bytes into v the same way as Unmarshal, and returns what every byte of the input was decoded as
+// This is meant for debugging data that doesn't decode the way it should, instead of counting bytes by hand.
+// If decoding fails, the annotations up to the point of failure are returned along with the error.
+// Any bytes left over after v are included as a final annotation, rather than being an error
+// Generated code is skipped so that each field is annotated, but other custom types are annotated as one value
+func Explain(bytes []byte, v interface{}) (Explanation, error) {
+	e := &explainer{input: bytes}
+	d := &decodeState{bytes: bytes, opts: DecodeOptions{}.withDefaults(), explain: e}
+
+	err := unmarshal(d, v)
+	if err != nil {
+		return e.annotations, err
+	}
+
+	if len(d.bytes) > 0 {
+		e.annotations = append(e.annotations, Annotation{
+			Offset: int(d.offset),
+			Length: len(d.bytes),
+			Path:   "(trailing)",
+			Raw:    d.bytes,
+			Value:  fmt.Sprintf("%d unconsumed bytes", len(d.bytes)),
+		})
+	}
+
+	return e.annotations, nil
+}
+
+// explainer records annotations while decoding
+// All methods are safe to call on a nil explainer, and do nothing, so the decoder doesn't need to check first
+type explainer struct {
+	input       []byte
+	path        []string
+	annotations []Annotation
+}
+
+// push adds a field to the current path
+func (e *explainer) push(segment string) {
+	if e != nil {
+		e.path = append(e.path, segment)
+	}
+}
+
+// pushIndex adds a list index to the current path
+func (e *explainer) pushIndex(index int) {
+	if e != nil {
+		e.path = append(e.path, fmt.Sprintf("[%d]", index))
+	}
+}
+
+// pop removes the last segment from the current path
+func (e *explainer) pop() {
+	if e != nil {
+		e.path = e.path[:len(e.path)-1]
+	}
+}
+
+// prefix annotates a 4 byte length prefix
+func (e *explainer) prefix(d *decodeState, start int64, length uint32) {
+	if e != nil {
+		e.add(d, start, fmt.Sprintf("length %d", length))
+	}
+}
+
+// presence annotates the presence byte of an optional
+func (e *explainer) presence(d *decodeState, start int64, flag uint8) {
+	if e == nil {
+		return
+	}
+
+	switch flag {
+	case boolFalse:
+		e.add(d, start, "not present")
+	case boolTrue:
+		e.add(d, start, "present")
+	default:
+		e.add(d, start, fmt.Sprintf("invalid presence byte %d", flag))
+	}
+}
+
+// value annotates a single decoded value
+func (e *explainer) value(d *decodeState, start int64, c *codec, v reflect.Value) {
+	if e == nil {
+		return
+	}
+
+	var value string
+	switch c.kind {
+	case kindBytes:
+		value = "0x" + hex.EncodeToString(v.Bytes())
+	case kindByteArray:
+		value = "0x" + hex.EncodeToString(v.Slice(0, v.Len()).Bytes())
+	case kindString:
+		value = strconv.Quote(v.String())
+	default:
+		value = fmt.Sprint(v.Interface())
+	}
+
+	e.add(d, start, value)
+}
+
+// add records the bytes from start up to the current offset, at the current path
+func (e *explainer) add(d *decodeState, start int64, value string) {
+	path := ""
+	for i := len(e.path) - 1; i >= 0; i-- {
+		path = joinPath(e.path[i], path)
+	}
+
+	e.annotations = append(e.annotations, Annotation{
+		Offset: int(start),
+		Length: int(d.offset - start),
+		Path:   path,
+		Raw:    e.input[start:d.offset],
+		Value:  value,
+	})
+}
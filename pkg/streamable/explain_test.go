@@ -0,0 +1,77 @@
+package streamable_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable/internal/gentest"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+func TestExplain(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHex2)
+	assert.NoError(t, err)
+
+	msg := &protocols.Message{}
+	explanation, err := streamable.Explain(encodedBytes, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, util.PtrUint16(35256), msg.ID)
+
+	assert.Equal(t, streamable.Explanation{
+		{Offset: 0, Length: 1, Path: "ProtocolMessageType", Raw: encodedBytes[0:1], Value: "handshake"},
+		{Offset: 1, Length: 1, Path: "ID", Raw: encodedBytes[1:2], Value: "present"},
+		{Offset: 2, Length: 2, Path: "ID", Raw: encodedBytes[2:4], Value: "35256"},
+		{Offset: 4, Length: 4, Path: "Data", Raw: encodedBytes[4:8], Value: "length 34"},
+		{Offset: 8, Length: 34, Path: "Data", Raw: encodedBytes[8:], Value: "0x" + encodedHex2[16:]},
+	}, explanation)
+
+	assert.Equal(t,
+		"OFFSET  LEN  PATH                 HEX                                                                  VALUE\n"+
+			"0       1    ProtocolMessageType  01                                                                   handshake\n"+
+			"1       1    ID                   01                                                                   present\n"+
+			"2       2    ID                   89b8                                                                 35256\n"+
+			"4       4    Data                 00000022                                                             length 34\n"+
+			"8       34   Data                 5468697320697320612073616d706c65206d65737361676520746f206465636f...  0x"+encodedHex2[16:]+"\n",
+		explanation.String(),
+	)
+}
+
+func TestExplain_Partial(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHexHandshake[12:])
+	assert.NoError(t, err)
+
+	// Cut off partway through the capabilities
+	explanation, err := streamable.Explain(encodedBytes[:42], &protocols.Handshake{})
+	assert.EqualError(t, err, "streamable: decoding Capabilities[0].Value at offset 40: need 4 bytes, only 2 remaining")
+
+	last := explanation[len(explanation)-1]
+	assert.Equal(t, "Capabilities[0].Capability", last.Path)
+	assert.Equal(t, 38, last.Offset)
+	assert.Equal(t, "1", last.Value)
+
+	// Extra bytes are shown rather than being an error
+	explanation, err = streamable.Explain(append(encodedBytes, 0xff, 0xfe), &protocols.Handshake{})
+	assert.NoError(t, err)
+	assert.Equal(t, streamable.Annotation{Offset: 45, Length: 2, Path: "(trailing)", Raw: []byte{0xff, 0xfe}, Value: "2 unconsumed bytes"}, explanation[len(explanation)-1])
+}
+
+func TestExplain_Generated(t *testing.T) {
+	encodedBytes, err := streamable.Marshal(fullEverything())
+	assert.NoError(t, err)
+
+	// Generated types are walked field by field, and the annotations cover every byte exactly once
+	explanation, err := streamable.Explain(encodedBytes, &gentest.Everything{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Scalars.U8", explanation[0].Path)
+
+	var covered []byte
+	for _, annotation := range explanation {
+		assert.Equal(t, len(covered), annotation.Offset, annotation.Path)
+		covered = append(covered, annotation.Raw...)
+	}
+	assert.Equal(t, encodedBytes, covered)
+}
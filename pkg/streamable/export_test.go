@@ -0,0 +1,11 @@
+package streamable
+
+// SetIgnoreGenerated allows tests to force the reflective path for types with generated code
+func SetIgnoreGenerated(ignore bool) {
+	ignoreGenerated = ignore
+}
+
+// SnakeCase exposes snakeCase for testing the field name conversion
+func SnakeCase(name string) string {
+	return snakeCase(name)
+}
@@ -0,0 +1,114 @@
+package streamable_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// Run these with `make test-fuzz`, or `go test -run=XXX -fuzz=FuzzUnmarshal_Message ./pkg/streamable` for just one
+// Without -fuzz, only the seed inputs are checked as part of the normal tests
+
+// fuzzRoundTrip checks that data either fails to decode with a DecodeError, or decodes to a value that encodes back to
+// exactly the bytes that were consumed. Decoding from memory and from a stream must agree as well
+func fuzzRoundTrip(t *testing.T, data []byte, newValue func() interface{}) {
+	fromMemory := newValue()
+	remaining, err := streamable.UnmarshalPrefix(data, fromMemory)
+
+	fromStream := newValue()
+	streamErr := streamable.NewDecoder(bytes.NewReader(data)).Decode(fromStream)
+
+	if err != nil {
+		var decodeErr *streamable.DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Fatalf("expected a DecodeError, got %T: %s", err, err.Error())
+		}
+		if streamErr == nil {
+			t.Fatalf("decoding from memory failed with %s, but decoding from a stream succeeded", err.Error())
+		}
+		return
+	}
+	if streamErr != nil {
+		t.Fatalf("decoding from memory succeeded, but decoding from a stream failed with %s", streamErr.Error())
+	}
+	if !reflect.DeepEqual(fromMemory, fromStream) {
+		t.Fatalf("decoding from memory and a stream gave different values: %+v %+v", fromMemory, fromStream)
+	}
+
+	reencoded, err := streamable.Marshal(fromMemory)
+	if err != nil {
+		t.Fatalf("unable to marshal a value that was unmarshalled: %s", err.Error())
+	}
+	if !bytes.Equal(data[:len(data)-len(remaining)], reencoded) {
+		t.Fatalf("reencoded bytes don't match the input\ninput:     %x\nreencoded: %x", data, reencoded)
+	}
+}
+
+func fuzzSeed(f *testing.F, encodedHex string) []byte {
+	encodedBytes, err := hex.DecodeString(encodedHex)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(encodedBytes)
+
+	return encodedBytes
+}
+
+func FuzzUnmarshal_Message(f *testing.F) {
+	fuzzSeed(f, encodedHex1)
+	fuzzSeed(f, encodedHex2)
+	fuzzSeed(f, encodedHexHandshake)
+	fuzzSeed(f, "")
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, func() interface{} { return &protocols.Message{} })
+	})
+}
+
+func FuzzUnmarshal_Handshake(f *testing.F) {
+	// Seeded with the data of the handshake message
+	fuzzSeed(f, encodedHexHandshake[12:])
+	fuzzSeed(f, "000000076d61696e6e657400000006302e302e333300000006312e322e313120fc0100000000")
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, func() interface{} { return &protocols.Handshake{} })
+	})
+}
+
+func FuzzUnmarshal_RespondPeers(f *testing.F) {
+	rp := &protocols.RespondPeers{
+		PeerList: []types.TimestampedPeerInfo{
+			{Host: "127.0.0.1", Port: 8444, Timestamp: 1643000000},
+			{Host: "::1", Port: 58444, Timestamp: 0},
+		},
+	}
+	encodedBytes, err := streamable.Marshal(rp)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(encodedBytes)
+	fuzzSeed(f, "00000000")
+	fuzzSeed(f, "ffffffff")
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, func() interface{} { return &protocols.RespondPeers{} })
+	})
+}
+
+func FuzzUnmarshal_CoinSpend(f *testing.F) {
+	// Coin of zeros, with puzzle reveal (q . 1) and solution ()
+	fuzzSeed(f, strings.Repeat("00", 72)+"ff0101"+"80")
+	// Puzzle reveal atom of 0x100000001 bytes, which is larger than any limit can be checked against
+	fuzzSeed(f, strings.Repeat("00", 72)+"f900000001")
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, func() interface{} { return &types.CoinSpend{} })
+	})
+}
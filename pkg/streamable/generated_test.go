@@ -0,0 +1,315 @@
+package streamable_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable/internal/gentest"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+func fullEverything() *gentest.Everything {
+	hash, _ := types.HexStringToBytes32(strings.Repeat("ab", 32))
+	sig, _ := types.HexStringToG2Element(strings.Repeat("cd", 96))
+	u128 := types.NewUint128(1, 2)
+	note := gentest.Note("note")
+	data := []byte{9, 8, 7}
+
+	scalars := gentest.Scalars{
+		U8:    1,
+		U16:   2,
+		U32:   3,
+		U64:   4,
+		U128:  types.NewUint128(5, 6),
+		I8:    -7,
+		I16:   -8,
+		I32:   -9,
+		I64:   -10,
+		Bool:  true,
+		Str:   "str",
+		Kind:  11,
+		Label: "label",
+		Note:  "hand written",
+	}
+
+	return &gentest.Everything{
+		Scalars:       scalars,
+		OptScalars:    &scalars,
+		Hash:          hash,
+		OptHash:       &hash,
+		Signature:     sig,
+		Data:          []byte{1, 2, 3},
+		OptData:       &data,
+		OptU32:        util.PtrUint32(12),
+		OptU128:       &u128,
+		OptNote:       &note,
+		Pair:          gentest.Pair{First: 13, Second: "pair"},
+		OptPair:       &gentest.Pair{First: 14, Second: "opt"},
+		Pairs:         []gentest.Pair{{First: 15, Second: "a"}, {First: 16, Second: "b"}},
+		OptPairs:      []*gentest.Pair{nil, {First: 17, Second: "c"}},
+		Hashes:        []types.Bytes32{hash, {}},
+		Strings:       []string{"x", "", "yz"},
+		Kinds:         []gentest.Kind{1, 2},
+		Bools:         []bool{false, true},
+		Uint128s:      []types.Uint128{u128, {}},
+		Optionals:     []*uint64{nil, &scalars.U64},
+		Nested:        [][]uint32{{1, 2}, {}, {3}},
+		NestedBytes:   [][]byte{{1}, {}},
+		Notes:         []gentest.Note{"one", "two"},
+		FixedHashes:   [2]types.Bytes32{{}, hash},
+		FixedOptional: [2]*uint16{nil, &scalars.U16},
+		FixedData:     []byte{4, 3, 2, 1},
+		FixedKinds:    []gentest.Kind{3, 2, 1},
+		OptFixedPairs: &[]*gentest.Pair{{First: 18, Second: "d"}, nil},
+	}
+}
+
+// emptyEverything has zero values everywhere, apart from the fixed length lists that must have the right length
+func emptyEverything() *gentest.Everything {
+	return &gentest.Everything{
+		FixedData:  make([]byte, 4),
+		FixedKinds: make([]gentest.Kind, 3),
+	}
+}
+
+// marshalBothWays returns the result of marshalling using generated code, and the reflective path
+func marshalBothWays(t *testing.T, v interface{}) ([]byte, []byte) {
+	generated, err := streamable.Marshal(v)
+	assert.NoError(t, err)
+
+	streamable.SetIgnoreGenerated(true)
+	defer streamable.SetIgnoreGenerated(false)
+
+	reflective, err := streamable.Marshal(v)
+	assert.NoError(t, err)
+
+	return generated, reflective
+}
+
+func TestGenerated_MarshalIdentical(t *testing.T) {
+	for _, e := range []*gentest.Everything{fullEverything(), emptyEverything()} {
+		generated, reflective := marshalBothWays(t, e)
+		assert.Equal(t, reflective, generated)
+
+		// Calling the generated method directly must match as well
+		direct, err := e.MarshalStreamable()
+		assert.NoError(t, err)
+		assert.Equal(t, reflective, direct)
+	}
+}
+
+func TestGenerated_UnmarshalIdentical(t *testing.T) {
+	for _, e := range []*gentest.Everything{fullEverything(), emptyEverything()} {
+		encoded, err := streamable.Marshal(e)
+		assert.NoError(t, err)
+
+		generated := &gentest.Everything{}
+		err = streamable.Unmarshal(encoded, generated)
+		assert.NoError(t, err)
+
+		streamable.SetIgnoreGenerated(true)
+		reflective := &gentest.Everything{}
+		err = streamable.Unmarshal(encoded, reflective)
+		streamable.SetIgnoreGenerated(false)
+		assert.NoError(t, err)
+
+		assert.Equal(t, reflective, generated)
+
+		// Make sure nothing was lost along the way
+		reencoded, err := streamable.Marshal(generated)
+		assert.NoError(t, err)
+		assert.Equal(t, encoded, reencoded)
+	}
+}
+
+func TestGenerated_Decoder(t *testing.T) {
+	e := fullEverything()
+	encoded, err := streamable.Marshal(e)
+	assert.NoError(t, err)
+
+	decoded := &gentest.Everything{}
+	err = streamable.NewDecoder(bytes.NewReader(encoded)).Decode(decoded)
+	assert.NoError(t, err)
+
+	reencoded, err := streamable.Marshal(decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, encoded, reencoded)
+}
+
+// unmarshalBothWays returns the errors from unmarshalling using generated code, and the reflective path
+func unmarshalBothWays(encoded []byte, newValue func() interface{}, opts streamable.DecodeOptions) (error, error) {
+	generatedErr := streamable.UnmarshalWithOptions(encoded, newValue(), opts)
+
+	streamable.SetIgnoreGenerated(true)
+	defer streamable.SetIgnoreGenerated(false)
+
+	reflectiveErr := streamable.UnmarshalWithOptions(encoded, newValue(), opts)
+
+	return generatedErr, reflectiveErr
+}
+
+func TestGenerated_Truncated(t *testing.T) {
+	encoded, err := streamable.Marshal(fullEverything())
+	assert.NoError(t, err)
+
+	newValue := func() interface{} { return &gentest.Everything{} }
+	for length := 0; length < len(encoded); length++ {
+		generatedErr, reflectiveErr := unmarshalBothWays(encoded[:length], newValue, streamable.DecodeOptions{})
+		assert.Error(t, generatedErr)
+		assert.Equal(t, reflectiveErr, generatedErr, "truncated to %d bytes", length)
+	}
+}
+
+func TestGenerated_Limits(t *testing.T) {
+	encoded, err := streamable.Marshal(fullEverything())
+	assert.NoError(t, err)
+
+	// Every limit is hit at the same point with the same error, including allocations for lists and optionals
+	newValue := func() interface{} { return &gentest.Everything{} }
+	for limit := 1; limit <= 64; limit++ {
+		for _, opts := range []streamable.DecodeOptions{
+			{MaxListLength: limit},
+			{MaxBytesLength: limit},
+			{MaxAllocation: limit * 8},
+		} {
+			generatedErr, reflectiveErr := unmarshalBothWays(encoded, newValue, opts)
+			assert.Equal(t, reflectiveErr, generatedErr, "%+v", opts)
+		}
+	}
+}
+
+func TestGenerated_ZeroCopy(t *testing.T) {
+	e := fullEverything()
+	e.Data = []byte("zero copy")
+	encoded, err := streamable.Marshal(e)
+	assert.NoError(t, err)
+
+	aliased := &gentest.Everything{}
+	assert.NoError(t, streamable.UnmarshalWithOptions(encoded, aliased, streamable.DecodeOptions{ZeroCopy: true}))
+	assert.Equal(t, e.Data, aliased.Data)
+
+	// Data refers to the input directly, with the capacity limited so appending can't overwrite the input
+	offset := bytes.Index(encoded, e.Data)
+	assert.Same(t, &encoded[offset], &aliased.Data[0])
+	assert.Equal(t, len(aliased.Data), cap(aliased.Data))
+}
+
+func TestGenerated_PlainReader(t *testing.T) {
+	encoded, err := streamable.Marshal(fullEverything())
+	assert.NoError(t, err)
+
+	// Generated methods work with any reader, not just the ones from Unmarshal and Decoder
+	decoded := &gentest.Everything{}
+	assert.NoError(t, decoded.UnmarshalStreamable(bytes.NewReader(encoded)))
+	assert.Equal(t, fullEverything(), decoded)
+
+	// A length prefix claiming 4GB with nothing behind it doesn't allocate anywhere near that much
+	hostile := []byte{0xff, 0xff, 0xff, 0xff}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	err = (&gentest.Pair{}).UnmarshalStreamable(bytes.NewReader(append([]byte{0, 1}, hostile...)))
+	runtime.ReadMemStats(&after)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+
+	runtime.ReadMemStats(&before)
+	err = (&gentest.Tree{}).UnmarshalStreamable(bytes.NewReader(append([]byte{0}, hostile...)))
+	runtime.ReadMemStats(&after)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+}
+
+func TestGenerated_MaxDepth(t *testing.T) {
+	// Tree nested 100 deep, each level is a uint8 value and a list with one child
+	var encoded []byte
+	for i := 0; i < 100; i++ {
+		encoded = append(encoded, uint8(i), 0, 0, 0, 1)
+	}
+	encoded = append(encoded, 100, 0, 0, 0, 0)
+
+	err := streamable.Unmarshal(encoded, &gentest.Tree{})
+	var decodeErr *streamable.DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.EqualError(t, decodeErr.Err, "nesting exceeds the maximum depth of 64")
+
+	decoded := &gentest.Tree{}
+	err = streamable.UnmarshalWithOptions(encoded, decoded, streamable.DecodeOptions{MaxDepth: -1})
+	assert.NoError(t, err)
+
+	reencoded, err := streamable.Marshal(decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, encoded, reencoded)
+
+	// The depth limit is hit at exactly the same point as the reflective path
+	fullEncoded, err := streamable.Marshal(fullEverything())
+	assert.NoError(t, err)
+	for _, test := range []struct {
+		encoded  []byte
+		newValue func() interface{}
+	}{
+		{encoded: encoded, newValue: func() interface{} { return &gentest.Tree{} }},
+		{encoded: fullEncoded, newValue: func() interface{} { return &gentest.Everything{} }},
+	} {
+		for maxDepth := 1; maxDepth <= 8; maxDepth++ {
+			opts := streamable.DecodeOptions{MaxDepth: maxDepth}
+			generatedErr, reflectiveErr := unmarshalBothWays(test.encoded, test.newValue, opts)
+			assert.Equal(t, reflectiveErr, generatedErr, "max depth %d, %T", maxDepth, test.newValue())
+		}
+	}
+}
+
+func TestGenerated_LibraryTypes(t *testing.T) {
+	blockHex, err := os.ReadFile(filepath.Join("..", "types", "testdata", "full_block.hex"))
+	assert.NoError(t, err)
+	fullBlock, err := hex.DecodeString(strings.TrimSpace(string(blockHex)))
+	assert.NoError(t, err)
+
+	// A few peers are enough, since every truncated length is checked
+	rp := benchRespondPeers()
+	rp.PeerList = rp.PeerList[:10]
+	respondPeers, err := streamable.Marshal(rp)
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		name     string
+		encoded  []byte
+		newValue func() interface{}
+	}{
+		{name: "RespondPeers", encoded: respondPeers, newValue: func() interface{} { return &protocols.RespondPeers{} }},
+		{name: "FullBlock", encoded: fullBlock, newValue: func() interface{} { return &types.FullBlock{} }},
+	} {
+		assert.Implements(t, (*streamable.Unmarshaler)(nil), test.newValue(), test.name)
+
+		generated := test.newValue()
+		assert.NoError(t, streamable.Unmarshal(test.encoded, generated), test.name)
+
+		streamable.SetIgnoreGenerated(true)
+		reflective := test.newValue()
+		err = streamable.Unmarshal(test.encoded, reflective)
+		streamable.SetIgnoreGenerated(false)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, reflective, generated, test.name)
+
+		generatedBytes, reflectiveBytes := marshalBothWays(t, generated)
+		assert.Equal(t, test.encoded, generatedBytes, test.name)
+		assert.Equal(t, reflectiveBytes, generatedBytes, test.name)
+
+		for length := 0; length < len(test.encoded); length++ {
+			generatedErr, reflectiveErr := unmarshalBothWays(test.encoded[:length], test.newValue, streamable.DecodeOptions{})
+			assert.Error(t, generatedErr, "%s truncated to %d bytes", test.name, length)
+			assert.Equal(t, reflectiveErr, generatedErr, "%s truncated to %d bytes", test.name, length)
+		}
+	}
+}
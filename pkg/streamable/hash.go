@@ -0,0 +1,46 @@
+package streamable
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// Hashable is implemented by types whose hash is not just the hash of their streamable bytes
+// The main example is Coin, where the name is the hash of the parent, puzzle hash, and amount with the amount
+// encoded as a minimal CLVM integer, rather than the hash of the streamable encoding of the coin
+type Hashable interface {
+	Hash() (types.Bytes32, error)
+}
+
+var hashableType = reflect.TypeOf((*Hashable)(nil)).Elem()
+
+// Hash returns the standard hash of v (std_hash / get_hash in chia), which is the sha256 of its streamable encoding
+// This is how header hashes, spend bundle names, and most other IDs in chia are computed.
+// If v implements Hashable, its Hash method is used instead
+func Hash(v interface{}) (types.Bytes32, error) {
+	if v == nil {
+		return types.Bytes32{}, fmt.Errorf("streamable can't hash nil")
+	}
+
+	if hashable, ok := v.(Hashable); ok {
+		return hashable.Hash()
+	}
+
+	// Hash may have a pointer receiver while v was passed by value
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr && reflect.PtrTo(rv.Type()).Implements(hashableType) {
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		return ptr.Interface().(Hashable).Hash()
+	}
+
+	encodedBytes, err := Marshal(v)
+	if err != nil {
+		return types.Bytes32{}, err
+	}
+
+	return sha256.Sum256(encodedBytes), nil
+}
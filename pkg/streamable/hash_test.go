@@ -0,0 +1,100 @@
+package streamable_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// The expected hashes for encodedHex1 and encodedHexHandshake are the sha256 of exactly those bytes, computed
+// separately with sha256sum. They check that Hash uses the streamable encoding, but don't come from chia itself
+const (
+	hashMessage1  string = "07c49b56a9e49fa1b99b5bd88d724178f2877c0ccf13f0ace7a673e5e56b9eb3"
+	hashHandshake string = "4cc7d9e6f61ae2033e746d13eabf44aed0a11ea2947fe02ca25c5217b508357f"
+)
+
+// genesisPoolCoinID is the name of the pool reward coin created by the mainnet genesis block
+// Coin.name() in chia is std_hash of the parent, puzzle hash, and amount, so Hash must give the same ID for the coin
+const genesisPoolCoinID = "1fd60c070e821d785b65e10e5135e52d12c8f4d902a506f48bc1c5268b7bb45b"
+
+func TestHash(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex   string
+		expectedHash string
+		value        interface{}
+	}{
+		{encodedHex: encodedHex1, expectedHash: hashMessage1, value: &protocols.Message{}},
+		{encodedHex: encodedHexHandshake[12:], expectedHash: hashHandshake, value: &protocols.Handshake{}},
+	} {
+		encodedBytes, err := hex.DecodeString(test.encodedHex)
+		assert.NoError(t, err)
+		assert.NoError(t, streamable.Unmarshal(encodedBytes, test.value))
+
+		expected, err := types.HexStringToBytes32(test.expectedHash)
+		assert.NoError(t, err)
+
+		hash, err := streamable.Hash(test.value)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, hash)
+	}
+}
+
+func TestHash_Coin(t *testing.T) {
+	parent, err := types.HexStringToBytes32("ccd5bb71183532bff220ba46c268991a00000000000000000000000000000000")
+	assert.NoError(t, err)
+	puzzleHash, err := types.HexStringToBytes32("d23da14695a188ae5708dd152263c4db883eb27edeb936178d4d988b8f3ce5fc")
+	assert.NoError(t, err)
+	coin := types.Coin{ParentCoinInfo: parent, PuzzleHash: puzzleHash, Amount: 18375000000000000000}
+
+	expected, err := types.HexStringToBytes32(genesisPoolCoinID)
+	assert.NoError(t, err)
+
+	hash, err := streamable.Hash(coin)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, hash)
+}
+
+func TestHash_Value(t *testing.T) {
+	// Values and pointers hash the same
+	inner := Inner{Name: "abc", Value: 7}
+
+	fromValue, err := streamable.Hash(inner)
+	assert.NoError(t, err)
+
+	fromPointer, err := streamable.Hash(&inner)
+	assert.NoError(t, err)
+	assert.Equal(t, fromValue, fromPointer)
+
+	_, err = streamable.Hash(nil)
+	assert.Error(t, err)
+
+	_, err = streamable.Hash(&Typo{})
+	assert.Error(t, err)
+}
+
+// CustomHash has an identity that isn't the hash of its bytes
+type CustomHash struct {
+	ID uint32 `streamable:""`
+}
+
+func (c *CustomHash) Hash() (types.Bytes32, error) {
+	return types.Bytes32{byte(c.ID)}, nil
+}
+
+func TestHash_Hashable(t *testing.T) {
+	custom := CustomHash{ID: 5}
+
+	hash, err := streamable.Hash(&custom)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Bytes32{5}, hash)
+
+	// Pointer receiver is still used when passed by value
+	hash, err = streamable.Hash(custom)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Bytes32{5}, hash)
+}
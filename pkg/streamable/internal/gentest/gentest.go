@@ -0,0 +1,115 @@
+// Package gentest contains types with code generated by streamablegen
+// It is used to check that generated code is byte for byte identical to the reflective path
+package gentest
+
+import (
+	"io"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+//go:generate go run github.com/cmmarslender/go-chia-lib/cmd/streamablegen
+
+// Kind is a named uint8, like protocols.NodeType
+type Kind uint8
+
+// Label is a named string
+type Label string
+
+// Note is a string with a hand written encoding (null terminated), so generated code must defer to it
+type Note string
+
+// MarshalStreamable writes the string followed by a null byte
+func (n Note) MarshalStreamable() ([]byte, error) {
+	return append([]byte(n), 0), nil
+}
+
+// StreamableSize is the length of the string plus the null byte
+func (n Note) StreamableSize() int {
+	return len(n) + 1
+}
+
+// UnmarshalStreamable reads until the null byte
+func (n *Note) UnmarshalStreamable(r io.Reader) error {
+	var str []byte
+	b := make([]byte, 1)
+	for {
+		_, err := io.ReadFull(r, b)
+		if err != nil {
+			return err
+		}
+		if b[0] == 0 {
+			break
+		}
+		str = append(str, b[0])
+	}
+
+	*n = Note(str)
+	return nil
+}
+
+// Pair is a Tuple[uint16, str]
+type Pair struct {
+	First  uint16 `streamable:""`
+	Second string `streamable:""`
+}
+
+// Scalars has one of every scalar type
+type Scalars struct {
+	U8    uint8         `streamable:""`
+	U16   uint16        `streamable:""`
+	U32   uint32        `streamable:""`
+	U64   uint64        `streamable:""`
+	U128  types.Uint128 `streamable:""`
+	I8    int8          `streamable:""`
+	I16   int16         `streamable:""`
+	I32   int32         `streamable:""`
+	I64   int64         `streamable:""`
+	Bool  bool          `streamable:""`
+	Str   string        `streamable:""`
+	Kind  Kind          `streamable:""`
+	Label Label         `streamable:""`
+	Note  Note          `streamable:""`
+
+	// Not part of the streamable encoding
+	Ignored string
+}
+
+// Everything uses every kind of composite value
+type Everything struct {
+	Scalars       Scalars          `streamable:""`
+	OptScalars    *Scalars         `streamable:"optional"`
+	Hash          types.Bytes32    `streamable:""`
+	OptHash       *types.Bytes32   `streamable:"optional"`
+	Signature     types.G2Element  `streamable:""`
+	Data          []byte           `streamable:""`
+	OptData       *[]byte          `streamable:"optional"`
+	OptU32        *uint32          `streamable:"optional"`
+	OptU128       *types.Uint128   `streamable:"optional"`
+	OptNote       *Note            `streamable:"optional"`
+	Pair          Pair             `streamable:"tuple"`
+	OptPair       *Pair            `streamable:"optional,tuple"`
+	Pairs         []Pair           `streamable:"tuple"`
+	OptPairs      []*Pair          `streamable:"tuple"`
+	Hashes        []types.Bytes32  `streamable:""`
+	Strings       []string         `streamable:""`
+	Kinds         []Kind           `streamable:""`
+	Bools         []bool           `streamable:""`
+	Uint128s      []types.Uint128  `streamable:""`
+	Optionals     []*uint64        `streamable:""`
+	Nested        [][]uint32       `streamable:""`
+	NestedBytes   [][]byte         `streamable:""`
+	Notes         []Note           `streamable:""`
+	FixedHashes   [2]types.Bytes32 `streamable:""`
+	FixedOptional [2]*uint16       `streamable:""`
+	FixedData     []byte           `streamable:"len=4"`
+	FixedKinds    []Kind           `streamable:"len=3"`
+	OptFixedPairs *[]*Pair         `streamable:"optional,tuple,len=2"`
+	Skipped       string           `streamable:"skip"`
+}
+
+// Tree is recursive, so decoding it relies on the depth limit
+type Tree struct {
+	Value    uint8  `streamable:""`
+	Children []Tree `streamable:""`
+}
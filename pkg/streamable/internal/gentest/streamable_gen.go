@@ -0,0 +1,1444 @@
+// Code generated by streamablegen. DO NOT EDIT.
+
+package gentest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"unsafe"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+// MarshalStreamable implements streamable.Marshaler for Everything
+func (s *Everything) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Everything to b
+func (s *Everything) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Scalars
+	if b, err = s.Scalars.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// OptScalars
+	if s.OptScalars == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.OptScalars).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Hash
+	b = append(b, s.Hash[:]...)
+	// OptHash
+	if s.OptHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.OptHash)[:]...)
+	}
+	// Signature
+	b = append(b, s.Signature[:]...)
+	// Data
+	n1 := uint32(len(s.Data))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Data...)
+	// OptData
+	if s.OptData == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		n2 := uint32(len((*s.OptData)))
+		b = append(b, byte(n2>>24), byte(n2>>16), byte(n2>>8), byte(n2))
+		b = append(b, (*s.OptData)...)
+	}
+	// OptU32
+	if s.OptU32 == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.OptU32)>>24), byte((*s.OptU32)>>16), byte((*s.OptU32)>>8), byte((*s.OptU32)))
+	}
+	// OptU128
+	if s.OptU128 == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.OptU128).Bytes()...)
+	}
+	// OptNote
+	if s.OptNote == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		var custom3 []byte
+		if custom3, err = (*s.OptNote).MarshalStreamable(); err != nil {
+			return nil, err
+		}
+		b = append(b, custom3...)
+	}
+	// Pair
+	if b, err = s.Pair.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// OptPair
+	if s.OptPair == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.OptPair).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Pairs
+	n4 := uint32(len(s.Pairs))
+	b = append(b, byte(n4>>24), byte(n4>>16), byte(n4>>8), byte(n4))
+	for i5 := range s.Pairs {
+		if b, err = s.Pairs[i5].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// OptPairs
+	n6 := uint32(len(s.OptPairs))
+	b = append(b, byte(n6>>24), byte(n6>>16), byte(n6>>8), byte(n6))
+	for i7 := range s.OptPairs {
+		if s.OptPairs[i7] == nil {
+			b = append(b, 0)
+		} else {
+			b = append(b, 1)
+			if b, err = (*s.OptPairs[i7]).AppendStreamable(b); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Hashes
+	n8 := uint32(len(s.Hashes))
+	b = append(b, byte(n8>>24), byte(n8>>16), byte(n8>>8), byte(n8))
+	for i9 := range s.Hashes {
+		b = append(b, s.Hashes[i9][:]...)
+	}
+	// Strings
+	n10 := uint32(len(s.Strings))
+	b = append(b, byte(n10>>24), byte(n10>>16), byte(n10>>8), byte(n10))
+	for i11 := range s.Strings {
+		n12 := uint32(len(s.Strings[i11]))
+		b = append(b, byte(n12>>24), byte(n12>>16), byte(n12>>8), byte(n12))
+		b = append(b, string(s.Strings[i11])...)
+	}
+	// Kinds
+	n13 := uint32(len(s.Kinds))
+	b = append(b, byte(n13>>24), byte(n13>>16), byte(n13>>8), byte(n13))
+	for i14 := range s.Kinds {
+		b = append(b, byte(s.Kinds[i14]))
+	}
+	// Bools
+	n15 := uint32(len(s.Bools))
+	b = append(b, byte(n15>>24), byte(n15>>16), byte(n15>>8), byte(n15))
+	for i16 := range s.Bools {
+		if s.Bools[i16] {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	}
+	// Uint128s
+	n17 := uint32(len(s.Uint128s))
+	b = append(b, byte(n17>>24), byte(n17>>16), byte(n17>>8), byte(n17))
+	for i18 := range s.Uint128s {
+		b = append(b, s.Uint128s[i18].Bytes()...)
+	}
+	// Optionals
+	n19 := uint32(len(s.Optionals))
+	b = append(b, byte(n19>>24), byte(n19>>16), byte(n19>>8), byte(n19))
+	for i20 := range s.Optionals {
+		if s.Optionals[i20] == nil {
+			b = append(b, 0)
+		} else {
+			b = append(b, 1)
+			b = append(b, byte((*s.Optionals[i20])>>56), byte((*s.Optionals[i20])>>48), byte((*s.Optionals[i20])>>40), byte((*s.Optionals[i20])>>32), byte((*s.Optionals[i20])>>24), byte((*s.Optionals[i20])>>16), byte((*s.Optionals[i20])>>8), byte((*s.Optionals[i20])))
+		}
+	}
+	// Nested
+	n21 := uint32(len(s.Nested))
+	b = append(b, byte(n21>>24), byte(n21>>16), byte(n21>>8), byte(n21))
+	for i22 := range s.Nested {
+		n23 := uint32(len(s.Nested[i22]))
+		b = append(b, byte(n23>>24), byte(n23>>16), byte(n23>>8), byte(n23))
+		for i24 := range s.Nested[i22] {
+			b = append(b, byte(s.Nested[i22][i24]>>24), byte(s.Nested[i22][i24]>>16), byte(s.Nested[i22][i24]>>8), byte(s.Nested[i22][i24]))
+		}
+	}
+	// NestedBytes
+	n25 := uint32(len(s.NestedBytes))
+	b = append(b, byte(n25>>24), byte(n25>>16), byte(n25>>8), byte(n25))
+	for i26 := range s.NestedBytes {
+		n27 := uint32(len(s.NestedBytes[i26]))
+		b = append(b, byte(n27>>24), byte(n27>>16), byte(n27>>8), byte(n27))
+		b = append(b, s.NestedBytes[i26]...)
+	}
+	// Notes
+	n28 := uint32(len(s.Notes))
+	b = append(b, byte(n28>>24), byte(n28>>16), byte(n28>>8), byte(n28))
+	for i29 := range s.Notes {
+		var custom30 []byte
+		if custom30, err = s.Notes[i29].MarshalStreamable(); err != nil {
+			return nil, err
+		}
+		b = append(b, custom30...)
+	}
+	// FixedHashes
+	for i31 := range s.FixedHashes {
+		b = append(b, s.FixedHashes[i31][:]...)
+	}
+	// FixedOptional
+	for i32 := range s.FixedOptional {
+		if s.FixedOptional[i32] == nil {
+			b = append(b, 0)
+		} else {
+			b = append(b, 1)
+			b = append(b, byte((*s.FixedOptional[i32])>>8), byte((*s.FixedOptional[i32])))
+		}
+	}
+	// FixedData
+	if len(s.FixedData) != 4 {
+		return nil, fmt.Errorf("fixed length list must have exactly 4 items, got %d", len(s.FixedData))
+	}
+	b = append(b, s.FixedData...)
+	// FixedKinds
+	if len(s.FixedKinds) != 3 {
+		return nil, fmt.Errorf("fixed length list must have exactly 3 items, got %d", len(s.FixedKinds))
+	}
+	for i33 := range s.FixedKinds {
+		b = append(b, byte(s.FixedKinds[i33]))
+	}
+	// OptFixedPairs
+	if s.OptFixedPairs == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if len((*s.OptFixedPairs)) != 2 {
+			return nil, fmt.Errorf("fixed length list must have exactly 2 items, got %d", len((*s.OptFixedPairs)))
+		}
+		for i34 := range *s.OptFixedPairs {
+			if (*s.OptFixedPairs)[i34] == nil {
+				b = append(b, 0)
+			} else {
+				b = append(b, 1)
+				if b, err = (*(*s.OptFixedPairs)[i34]).AppendStreamable(b); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Everything
+func (s *Everything) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Scalars
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Scalars", (*Scalars)(nil))
+	}
+	if err = s.Scalars.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Scalars", (*Scalars)(nil))
+	}
+	d.LeaveNested()
+	// OptScalars
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "OptScalars", (**Scalars)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "OptScalars", (**Scalars)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptScalars = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptScalars)); err != nil {
+			return d.FieldError(err, start2, "OptScalars", (**Scalars)(nil))
+		}
+		s.OptScalars = new(Scalars)
+		if err = (*s.OptScalars).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start2, "OptScalars", (**Scalars)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "OptScalars", (**Scalars)(nil))
+	}
+	d.LeaveNested()
+	// Hash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Hash", (*types.Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "Hash", (*types.Bytes32)(nil))
+	}
+	copy(s.Hash[:], b)
+	d.LeaveNested()
+	// OptHash
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "OptHash", (**types.Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "OptHash", (**types.Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptHash)); err != nil {
+			return d.FieldError(err, start4, "OptHash", (**types.Bytes32)(nil))
+		}
+		s.OptHash = new(types.Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start4, "OptHash", (**types.Bytes32)(nil))
+		}
+		copy((*s.OptHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "OptHash", (**types.Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// Signature
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "Signature", (*types.G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start5, "Signature", (*types.G2Element)(nil))
+	}
+	copy(s.Signature[:], b)
+	d.LeaveNested()
+	// Data
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "Data", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start6, "Data", (*[]byte)(nil))
+	}
+	n7 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n7); err != nil {
+		return d.FieldError(err, start6, "Data", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n7); err != nil {
+		return d.FieldError(err, start6, "Data", (*[]byte)(nil))
+	}
+	s.Data = b
+	d.LeaveNested()
+	// OptData
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "OptData", (**[]byte)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "OptData", (**[]byte)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptData = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptData)); err != nil {
+			return d.FieldError(err, start8, "OptData", (**[]byte)(nil))
+		}
+		s.OptData = new([]byte)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start8, "OptData", (**[]byte)(nil))
+		}
+		n9 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n9); err != nil {
+			return d.FieldError(err, start8, "OptData", (**[]byte)(nil))
+		}
+		if b, err = d.NextBytes(n9); err != nil {
+			return d.FieldError(err, start8, "OptData", (**[]byte)(nil))
+		}
+		(*s.OptData) = b
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start8, "OptData", (**[]byte)(nil))
+	}
+	d.LeaveNested()
+	// OptU32
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "OptU32", (**uint32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "OptU32", (**uint32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptU32 = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptU32)); err != nil {
+			return d.FieldError(err, start10, "OptU32", (**uint32)(nil))
+		}
+		s.OptU32 = new(uint32)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start10, "OptU32", (**uint32)(nil))
+		}
+		(*s.OptU32) = uint32(binary.BigEndian.Uint32(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start10, "OptU32", (**uint32)(nil))
+	}
+	d.LeaveNested()
+	// OptU128
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "OptU128", (**types.Uint128)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start11, "OptU128", (**types.Uint128)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptU128 = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptU128)); err != nil {
+			return d.FieldError(err, start11, "OptU128", (**types.Uint128)(nil))
+		}
+		s.OptU128 = new(types.Uint128)
+		if b, err = d.Next(16); err != nil {
+			return d.FieldError(err, start11, "OptU128", (**types.Uint128)(nil))
+		}
+		if (*s.OptU128), err = types.BytesToUint128(b); err != nil {
+			return d.FieldError(err, start11, "OptU128", (**types.Uint128)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start11, "OptU128", (**types.Uint128)(nil))
+	}
+	d.LeaveNested()
+	// OptNote
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "OptNote", (**Note)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start12, "OptNote", (**Note)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptNote = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptNote)); err != nil {
+			return d.FieldError(err, start12, "OptNote", (**Note)(nil))
+		}
+		s.OptNote = new(Note)
+		if err = (*s.OptNote).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start12, "OptNote", (**Note)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start12, "OptNote", (**Note)(nil))
+	}
+	d.LeaveNested()
+	// Pair
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "Pair", (*Pair)(nil))
+	}
+	if err = s.Pair.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start13, "Pair", (*Pair)(nil))
+	}
+	d.LeaveNested()
+	// OptPair
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "OptPair", (**Pair)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start14, "OptPair", (**Pair)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptPair = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptPair)); err != nil {
+			return d.FieldError(err, start14, "OptPair", (**Pair)(nil))
+		}
+		s.OptPair = new(Pair)
+		if err = (*s.OptPair).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start14, "OptPair", (**Pair)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start14, "OptPair", (**Pair)(nil))
+	}
+	d.LeaveNested()
+	// Pairs
+	start15 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start15, "Pairs", (*[]Pair)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start15, "Pairs", (*[]Pair)(nil))
+	}
+	n16 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n16, unsafe.Sizeof(s.Pairs[0])); err != nil {
+		return d.FieldError(err, start15, "Pairs", (*[]Pair)(nil))
+	}
+	prealloc17 := n16
+	if prealloc17 > 1024 {
+		prealloc17 = 1024
+	}
+	s.Pairs = make([]Pair, 0, prealloc17)
+	for i18 := uint32(0); i18 < n16; i18++ {
+		var item19 Pair
+		start20 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start20, fmt.Sprintf("[%d]", i18), (*Pair)(nil)), start15, "Pairs", (*[]Pair)(nil))
+		}
+		if err = item19.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start20, fmt.Sprintf("[%d]", i18), (*Pair)(nil)), start15, "Pairs", (*[]Pair)(nil))
+		}
+		d.LeaveNested()
+		s.Pairs = append(s.Pairs, item19)
+	}
+	d.LeaveNested()
+	// OptPairs
+	start21 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start21, "OptPairs", (*[]*Pair)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start21, "OptPairs", (*[]*Pair)(nil))
+	}
+	n22 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n22, unsafe.Sizeof(s.OptPairs[0])); err != nil {
+		return d.FieldError(err, start21, "OptPairs", (*[]*Pair)(nil))
+	}
+	prealloc23 := n22
+	if prealloc23 > 1024 {
+		prealloc23 = 1024
+	}
+	s.OptPairs = make([]*Pair, 0, prealloc23)
+	for i24 := uint32(0); i24 < n22; i24++ {
+		var item25 *Pair
+		start26 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start26, fmt.Sprintf("[%d]", i24), (**Pair)(nil)), start21, "OptPairs", (*[]*Pair)(nil))
+		}
+		if b, err = d.Next(1); err != nil {
+			return d.FieldError(d.FieldError(err, start26, fmt.Sprintf("[%d]", i24), (**Pair)(nil)), start21, "OptPairs", (*[]*Pair)(nil))
+		}
+		switch b[0] {
+		case 0:
+			item25 = nil
+		case 1:
+			if err = d.LimitAllocation(unsafe.Sizeof(*item25)); err != nil {
+				return d.FieldError(d.FieldError(err, start26, fmt.Sprintf("[%d]", i24), (**Pair)(nil)), start21, "OptPairs", (*[]*Pair)(nil))
+			}
+			item25 = new(Pair)
+			if err = (*item25).UnmarshalStreamable(d); err != nil {
+				return d.FieldError(d.FieldError(err, start26, fmt.Sprintf("[%d]", i24), (**Pair)(nil)), start21, "OptPairs", (*[]*Pair)(nil))
+			}
+		default:
+			err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+			return d.FieldError(d.FieldError(err, start26, fmt.Sprintf("[%d]", i24), (**Pair)(nil)), start21, "OptPairs", (*[]*Pair)(nil))
+		}
+		d.LeaveNested()
+		s.OptPairs = append(s.OptPairs, item25)
+	}
+	d.LeaveNested()
+	// Hashes
+	start27 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start27, "Hashes", (*[]types.Bytes32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start27, "Hashes", (*[]types.Bytes32)(nil))
+	}
+	n28 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n28, unsafe.Sizeof(s.Hashes[0])); err != nil {
+		return d.FieldError(err, start27, "Hashes", (*[]types.Bytes32)(nil))
+	}
+	prealloc29 := n28
+	if prealloc29 > 1024 {
+		prealloc29 = 1024
+	}
+	s.Hashes = make([]types.Bytes32, 0, prealloc29)
+	for i30 := uint32(0); i30 < n28; i30++ {
+		var item31 types.Bytes32
+		start32 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start32, fmt.Sprintf("[%d]", i30), (*types.Bytes32)(nil)), start27, "Hashes", (*[]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start32, fmt.Sprintf("[%d]", i30), (*types.Bytes32)(nil)), start27, "Hashes", (*[]types.Bytes32)(nil))
+		}
+		copy(item31[:], b)
+		d.LeaveNested()
+		s.Hashes = append(s.Hashes, item31)
+	}
+	d.LeaveNested()
+	// Strings
+	start33 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start33, "Strings", (*[]string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start33, "Strings", (*[]string)(nil))
+	}
+	n34 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n34, unsafe.Sizeof(s.Strings[0])); err != nil {
+		return d.FieldError(err, start33, "Strings", (*[]string)(nil))
+	}
+	prealloc35 := n34
+	if prealloc35 > 1024 {
+		prealloc35 = 1024
+	}
+	s.Strings = make([]string, 0, prealloc35)
+	for i36 := uint32(0); i36 < n34; i36++ {
+		var item37 string
+		start38 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start38, fmt.Sprintf("[%d]", i36), (*string)(nil)), start33, "Strings", (*[]string)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start38, fmt.Sprintf("[%d]", i36), (*string)(nil)), start33, "Strings", (*[]string)(nil))
+		}
+		n39 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n39); err != nil {
+			return d.FieldError(d.FieldError(err, start38, fmt.Sprintf("[%d]", i36), (*string)(nil)), start33, "Strings", (*[]string)(nil))
+		}
+		if b, err = d.Next(uint(n39)); err != nil {
+			return d.FieldError(d.FieldError(err, start38, fmt.Sprintf("[%d]", i36), (*string)(nil)), start33, "Strings", (*[]string)(nil))
+		}
+		item37 = string(b)
+		d.LeaveNested()
+		s.Strings = append(s.Strings, item37)
+	}
+	d.LeaveNested()
+	// Kinds
+	start40 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start40, "Kinds", (*[]Kind)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start40, "Kinds", (*[]Kind)(nil))
+	}
+	n41 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n41); err != nil {
+		return d.FieldError(err, start40, "Kinds", (*[]Kind)(nil))
+	}
+	if b, err = d.NextBytes(n41); err != nil {
+		return d.FieldError(err, start40, "Kinds", (*[]Kind)(nil))
+	}
+	s.Kinds = make([]Kind, len(b))
+	for i42 := range b {
+		s.Kinds[i42] = Kind(b[i42])
+	}
+	d.LeaveNested()
+	// Bools
+	start43 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start43, "Bools", (*[]bool)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start43, "Bools", (*[]bool)(nil))
+	}
+	n44 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n44, unsafe.Sizeof(s.Bools[0])); err != nil {
+		return d.FieldError(err, start43, "Bools", (*[]bool)(nil))
+	}
+	prealloc45 := n44
+	if prealloc45 > 1024 {
+		prealloc45 = 1024
+	}
+	s.Bools = make([]bool, 0, prealloc45)
+	for i46 := uint32(0); i46 < n44; i46++ {
+		var item47 bool
+		start48 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start48, fmt.Sprintf("[%d]", i46), (*bool)(nil)), start43, "Bools", (*[]bool)(nil))
+		}
+		if b, err = d.Next(1); err != nil {
+			return d.FieldError(d.FieldError(err, start48, fmt.Sprintf("[%d]", i46), (*bool)(nil)), start43, "Bools", (*[]bool)(nil))
+		}
+		switch b[0] {
+		case 0:
+			item47 = false
+		case 1:
+			item47 = true
+		default:
+			err = fmt.Errorf("invalid value for bool %d", b[0])
+			return d.FieldError(d.FieldError(err, start48, fmt.Sprintf("[%d]", i46), (*bool)(nil)), start43, "Bools", (*[]bool)(nil))
+		}
+		d.LeaveNested()
+		s.Bools = append(s.Bools, item47)
+	}
+	d.LeaveNested()
+	// Uint128s
+	start49 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start49, "Uint128s", (*[]types.Uint128)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start49, "Uint128s", (*[]types.Uint128)(nil))
+	}
+	n50 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n50, unsafe.Sizeof(s.Uint128s[0])); err != nil {
+		return d.FieldError(err, start49, "Uint128s", (*[]types.Uint128)(nil))
+	}
+	prealloc51 := n50
+	if prealloc51 > 1024 {
+		prealloc51 = 1024
+	}
+	s.Uint128s = make([]types.Uint128, 0, prealloc51)
+	for i52 := uint32(0); i52 < n50; i52++ {
+		var item53 types.Uint128
+		start54 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start54, fmt.Sprintf("[%d]", i52), (*types.Uint128)(nil)), start49, "Uint128s", (*[]types.Uint128)(nil))
+		}
+		if b, err = d.Next(16); err != nil {
+			return d.FieldError(d.FieldError(err, start54, fmt.Sprintf("[%d]", i52), (*types.Uint128)(nil)), start49, "Uint128s", (*[]types.Uint128)(nil))
+		}
+		if item53, err = types.BytesToUint128(b); err != nil {
+			return d.FieldError(d.FieldError(err, start54, fmt.Sprintf("[%d]", i52), (*types.Uint128)(nil)), start49, "Uint128s", (*[]types.Uint128)(nil))
+		}
+		d.LeaveNested()
+		s.Uint128s = append(s.Uint128s, item53)
+	}
+	d.LeaveNested()
+	// Optionals
+	start55 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start55, "Optionals", (*[]*uint64)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start55, "Optionals", (*[]*uint64)(nil))
+	}
+	n56 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n56, unsafe.Sizeof(s.Optionals[0])); err != nil {
+		return d.FieldError(err, start55, "Optionals", (*[]*uint64)(nil))
+	}
+	prealloc57 := n56
+	if prealloc57 > 1024 {
+		prealloc57 = 1024
+	}
+	s.Optionals = make([]*uint64, 0, prealloc57)
+	for i58 := uint32(0); i58 < n56; i58++ {
+		var item59 *uint64
+		start60 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start60, fmt.Sprintf("[%d]", i58), (**uint64)(nil)), start55, "Optionals", (*[]*uint64)(nil))
+		}
+		if b, err = d.Next(1); err != nil {
+			return d.FieldError(d.FieldError(err, start60, fmt.Sprintf("[%d]", i58), (**uint64)(nil)), start55, "Optionals", (*[]*uint64)(nil))
+		}
+		switch b[0] {
+		case 0:
+			item59 = nil
+		case 1:
+			if err = d.LimitAllocation(unsafe.Sizeof(*item59)); err != nil {
+				return d.FieldError(d.FieldError(err, start60, fmt.Sprintf("[%d]", i58), (**uint64)(nil)), start55, "Optionals", (*[]*uint64)(nil))
+			}
+			item59 = new(uint64)
+			if b, err = d.Next(8); err != nil {
+				return d.FieldError(d.FieldError(err, start60, fmt.Sprintf("[%d]", i58), (**uint64)(nil)), start55, "Optionals", (*[]*uint64)(nil))
+			}
+			(*item59) = uint64(binary.BigEndian.Uint64(b))
+		default:
+			err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+			return d.FieldError(d.FieldError(err, start60, fmt.Sprintf("[%d]", i58), (**uint64)(nil)), start55, "Optionals", (*[]*uint64)(nil))
+		}
+		d.LeaveNested()
+		s.Optionals = append(s.Optionals, item59)
+	}
+	d.LeaveNested()
+	// Nested
+	start61 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start61, "Nested", (*[][]uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start61, "Nested", (*[][]uint32)(nil))
+	}
+	n62 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n62, unsafe.Sizeof(s.Nested[0])); err != nil {
+		return d.FieldError(err, start61, "Nested", (*[][]uint32)(nil))
+	}
+	prealloc63 := n62
+	if prealloc63 > 1024 {
+		prealloc63 = 1024
+	}
+	s.Nested = make([][]uint32, 0, prealloc63)
+	for i64 := uint32(0); i64 < n62; i64++ {
+		var item65 []uint32
+		start66 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start66, fmt.Sprintf("[%d]", i64), (*[]uint32)(nil)), start61, "Nested", (*[][]uint32)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start66, fmt.Sprintf("[%d]", i64), (*[]uint32)(nil)), start61, "Nested", (*[][]uint32)(nil))
+		}
+		n67 := binary.BigEndian.Uint32(b)
+		if err = d.LimitListLength(n67, unsafe.Sizeof(item65[0])); err != nil {
+			return d.FieldError(d.FieldError(err, start66, fmt.Sprintf("[%d]", i64), (*[]uint32)(nil)), start61, "Nested", (*[][]uint32)(nil))
+		}
+		prealloc68 := n67
+		if prealloc68 > 1024 {
+			prealloc68 = 1024
+		}
+		item65 = make([]uint32, 0, prealloc68)
+		for i69 := uint32(0); i69 < n67; i69++ {
+			var item70 uint32
+			start71 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(d.FieldError(err, start71, fmt.Sprintf("[%d]", i69), (*uint32)(nil)), start66, fmt.Sprintf("[%d]", i64), (*[]uint32)(nil)), start61, "Nested", (*[][]uint32)(nil))
+			}
+			if b, err = d.Next(4); err != nil {
+				return d.FieldError(d.FieldError(d.FieldError(err, start71, fmt.Sprintf("[%d]", i69), (*uint32)(nil)), start66, fmt.Sprintf("[%d]", i64), (*[]uint32)(nil)), start61, "Nested", (*[][]uint32)(nil))
+			}
+			item70 = uint32(binary.BigEndian.Uint32(b))
+			d.LeaveNested()
+			item65 = append(item65, item70)
+		}
+		d.LeaveNested()
+		s.Nested = append(s.Nested, item65)
+	}
+	d.LeaveNested()
+	// NestedBytes
+	start72 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start72, "NestedBytes", (*[][]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start72, "NestedBytes", (*[][]byte)(nil))
+	}
+	n73 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n73, unsafe.Sizeof(s.NestedBytes[0])); err != nil {
+		return d.FieldError(err, start72, "NestedBytes", (*[][]byte)(nil))
+	}
+	prealloc74 := n73
+	if prealloc74 > 1024 {
+		prealloc74 = 1024
+	}
+	s.NestedBytes = make([][]byte, 0, prealloc74)
+	for i75 := uint32(0); i75 < n73; i75++ {
+		var item76 []byte
+		start77 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start77, fmt.Sprintf("[%d]", i75), (*[]byte)(nil)), start72, "NestedBytes", (*[][]byte)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start77, fmt.Sprintf("[%d]", i75), (*[]byte)(nil)), start72, "NestedBytes", (*[][]byte)(nil))
+		}
+		n78 := binary.BigEndian.Uint32(b)
+		if err = d.LimitBytesLength(n78); err != nil {
+			return d.FieldError(d.FieldError(err, start77, fmt.Sprintf("[%d]", i75), (*[]byte)(nil)), start72, "NestedBytes", (*[][]byte)(nil))
+		}
+		if b, err = d.NextBytes(n78); err != nil {
+			return d.FieldError(d.FieldError(err, start77, fmt.Sprintf("[%d]", i75), (*[]byte)(nil)), start72, "NestedBytes", (*[][]byte)(nil))
+		}
+		item76 = b
+		d.LeaveNested()
+		s.NestedBytes = append(s.NestedBytes, item76)
+	}
+	d.LeaveNested()
+	// Notes
+	start79 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start79, "Notes", (*[]Note)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start79, "Notes", (*[]Note)(nil))
+	}
+	n80 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n80, unsafe.Sizeof(s.Notes[0])); err != nil {
+		return d.FieldError(err, start79, "Notes", (*[]Note)(nil))
+	}
+	prealloc81 := n80
+	if prealloc81 > 1024 {
+		prealloc81 = 1024
+	}
+	s.Notes = make([]Note, 0, prealloc81)
+	for i82 := uint32(0); i82 < n80; i82++ {
+		var item83 Note
+		start84 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start84, fmt.Sprintf("[%d]", i82), (*Note)(nil)), start79, "Notes", (*[]Note)(nil))
+		}
+		if err = item83.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start84, fmt.Sprintf("[%d]", i82), (*Note)(nil)), start79, "Notes", (*[]Note)(nil))
+		}
+		d.LeaveNested()
+		s.Notes = append(s.Notes, item83)
+	}
+	d.LeaveNested()
+	// FixedHashes
+	start85 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start85, "FixedHashes", (*[2]types.Bytes32)(nil))
+	}
+	for i86 := range s.FixedHashes {
+		start87 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start87, fmt.Sprintf("[%d]", i86), (*types.Bytes32)(nil)), start85, "FixedHashes", (*[2]types.Bytes32)(nil))
+		}
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(d.FieldError(err, start87, fmt.Sprintf("[%d]", i86), (*types.Bytes32)(nil)), start85, "FixedHashes", (*[2]types.Bytes32)(nil))
+		}
+		copy(s.FixedHashes[i86][:], b)
+		d.LeaveNested()
+	}
+	d.LeaveNested()
+	// FixedOptional
+	start88 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start88, "FixedOptional", (*[2]*uint16)(nil))
+	}
+	for i89 := range s.FixedOptional {
+		start90 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start90, fmt.Sprintf("[%d]", i89), (**uint16)(nil)), start88, "FixedOptional", (*[2]*uint16)(nil))
+		}
+		if b, err = d.Next(1); err != nil {
+			return d.FieldError(d.FieldError(err, start90, fmt.Sprintf("[%d]", i89), (**uint16)(nil)), start88, "FixedOptional", (*[2]*uint16)(nil))
+		}
+		switch b[0] {
+		case 0:
+			s.FixedOptional[i89] = nil
+		case 1:
+			if err = d.LimitAllocation(unsafe.Sizeof(*s.FixedOptional[i89])); err != nil {
+				return d.FieldError(d.FieldError(err, start90, fmt.Sprintf("[%d]", i89), (**uint16)(nil)), start88, "FixedOptional", (*[2]*uint16)(nil))
+			}
+			s.FixedOptional[i89] = new(uint16)
+			if b, err = d.Next(2); err != nil {
+				return d.FieldError(d.FieldError(err, start90, fmt.Sprintf("[%d]", i89), (**uint16)(nil)), start88, "FixedOptional", (*[2]*uint16)(nil))
+			}
+			(*s.FixedOptional[i89]) = uint16(binary.BigEndian.Uint16(b))
+		default:
+			err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+			return d.FieldError(d.FieldError(err, start90, fmt.Sprintf("[%d]", i89), (**uint16)(nil)), start88, "FixedOptional", (*[2]*uint16)(nil))
+		}
+		d.LeaveNested()
+	}
+	d.LeaveNested()
+	// FixedData
+	start91 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start91, "FixedData", (*[]byte)(nil))
+	}
+	if err = d.LimitBytesLength(4); err != nil {
+		return d.FieldError(err, start91, "FixedData", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(4); err != nil {
+		return d.FieldError(err, start91, "FixedData", (*[]byte)(nil))
+	}
+	s.FixedData = b
+	d.LeaveNested()
+	// FixedKinds
+	start92 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start92, "FixedKinds", (*[]Kind)(nil))
+	}
+	if err = d.LimitBytesLength(3); err != nil {
+		return d.FieldError(err, start92, "FixedKinds", (*[]Kind)(nil))
+	}
+	if b, err = d.NextBytes(3); err != nil {
+		return d.FieldError(err, start92, "FixedKinds", (*[]Kind)(nil))
+	}
+	s.FixedKinds = make([]Kind, len(b))
+	for i93 := range b {
+		s.FixedKinds[i93] = Kind(b[i93])
+	}
+	d.LeaveNested()
+	// OptFixedPairs
+	start94 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start94, "OptFixedPairs", (**[]*Pair)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start94, "OptFixedPairs", (**[]*Pair)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.OptFixedPairs = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.OptFixedPairs)); err != nil {
+			return d.FieldError(err, start94, "OptFixedPairs", (**[]*Pair)(nil))
+		}
+		s.OptFixedPairs = new([]*Pair)
+		if err = d.LimitListLength(2, unsafe.Sizeof((*s.OptFixedPairs)[0])); err != nil {
+			return d.FieldError(err, start94, "OptFixedPairs", (**[]*Pair)(nil))
+		}
+		(*s.OptFixedPairs) = make([]*Pair, 2)
+		for i95 := range *s.OptFixedPairs {
+			start96 := d.Offset()
+			if err = d.EnterNested(); err != nil {
+				return d.FieldError(d.FieldError(err, start96, fmt.Sprintf("[%d]", i95), (**Pair)(nil)), start94, "OptFixedPairs", (**[]*Pair)(nil))
+			}
+			if b, err = d.Next(1); err != nil {
+				return d.FieldError(d.FieldError(err, start96, fmt.Sprintf("[%d]", i95), (**Pair)(nil)), start94, "OptFixedPairs", (**[]*Pair)(nil))
+			}
+			switch b[0] {
+			case 0:
+				(*s.OptFixedPairs)[i95] = nil
+			case 1:
+				if err = d.LimitAllocation(unsafe.Sizeof(*(*s.OptFixedPairs)[i95])); err != nil {
+					return d.FieldError(d.FieldError(err, start96, fmt.Sprintf("[%d]", i95), (**Pair)(nil)), start94, "OptFixedPairs", (**[]*Pair)(nil))
+				}
+				(*s.OptFixedPairs)[i95] = new(Pair)
+				if err = (*(*s.OptFixedPairs)[i95]).UnmarshalStreamable(d); err != nil {
+					return d.FieldError(d.FieldError(err, start96, fmt.Sprintf("[%d]", i95), (**Pair)(nil)), start94, "OptFixedPairs", (**[]*Pair)(nil))
+				}
+			default:
+				err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+				return d.FieldError(d.FieldError(err, start96, fmt.Sprintf("[%d]", i95), (**Pair)(nil)), start94, "OptFixedPairs", (**[]*Pair)(nil))
+			}
+			d.LeaveNested()
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start94, "OptFixedPairs", (**[]*Pair)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Pair
+func (s *Pair) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Pair to b
+func (s *Pair) AppendStreamable(b []byte) ([]byte, error) {
+	// First
+	b = append(b, byte(s.First>>8), byte(s.First))
+	// Second
+	n1 := uint32(len(s.Second))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.Second)...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Pair
+func (s *Pair) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// First
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "First", (*uint16)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start1, "First", (*uint16)(nil))
+	}
+	s.First = uint16(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// Second
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Second", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Second", (*string)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n3); err != nil {
+		return d.FieldError(err, start2, "Second", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n3)); err != nil {
+		return d.FieldError(err, start2, "Second", (*string)(nil))
+	}
+	s.Second = string(b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Scalars
+func (s *Scalars) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Scalars to b
+func (s *Scalars) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// U8
+	b = append(b, byte(s.U8))
+	// U16
+	b = append(b, byte(s.U16>>8), byte(s.U16))
+	// U32
+	b = append(b, byte(s.U32>>24), byte(s.U32>>16), byte(s.U32>>8), byte(s.U32))
+	// U64
+	b = append(b, byte(s.U64>>56), byte(s.U64>>48), byte(s.U64>>40), byte(s.U64>>32), byte(s.U64>>24), byte(s.U64>>16), byte(s.U64>>8), byte(s.U64))
+	// U128
+	b = append(b, s.U128.Bytes()...)
+	// I8
+	b = append(b, byte(s.I8))
+	// I16
+	b = append(b, byte(s.I16>>8), byte(s.I16))
+	// I32
+	b = append(b, byte(s.I32>>24), byte(s.I32>>16), byte(s.I32>>8), byte(s.I32))
+	// I64
+	b = append(b, byte(s.I64>>56), byte(s.I64>>48), byte(s.I64>>40), byte(s.I64>>32), byte(s.I64>>24), byte(s.I64>>16), byte(s.I64>>8), byte(s.I64))
+	// Bool
+	if s.Bool {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	// Str
+	n1 := uint32(len(s.Str))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.Str)...)
+	// Kind
+	b = append(b, byte(s.Kind))
+	// Label
+	n2 := uint32(len(s.Label))
+	b = append(b, byte(n2>>24), byte(n2>>16), byte(n2>>8), byte(n2))
+	b = append(b, string(s.Label)...)
+	// Note
+	var custom3 []byte
+	if custom3, err = s.Note.MarshalStreamable(); err != nil {
+		return nil, err
+	}
+	b = append(b, custom3...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Scalars
+func (s *Scalars) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// U8
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "U8", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "U8", (*uint8)(nil))
+	}
+	s.U8 = uint8(b[0])
+	d.LeaveNested()
+	// U16
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "U16", (*uint16)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start2, "U16", (*uint16)(nil))
+	}
+	s.U16 = uint16(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// U32
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "U32", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "U32", (*uint32)(nil))
+	}
+	s.U32 = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// U64
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "U64", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start4, "U64", (*uint64)(nil))
+	}
+	s.U64 = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// U128
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "U128", (*types.Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start5, "U128", (*types.Uint128)(nil))
+	}
+	if s.U128, err = types.BytesToUint128(b); err != nil {
+		return d.FieldError(err, start5, "U128", (*types.Uint128)(nil))
+	}
+	d.LeaveNested()
+	// I8
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "I8", (*int8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "I8", (*int8)(nil))
+	}
+	s.I8 = int8(b[0])
+	d.LeaveNested()
+	// I16
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "I16", (*int16)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start7, "I16", (*int16)(nil))
+	}
+	s.I16 = int16(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// I32
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "I32", (*int32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start8, "I32", (*int32)(nil))
+	}
+	s.I32 = int32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// I64
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "I64", (*int64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start9, "I64", (*int64)(nil))
+	}
+	s.I64 = int64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// Bool
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "Bool", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "Bool", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Bool = false
+	case 1:
+		s.Bool = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start10, "Bool", (*bool)(nil))
+	}
+	d.LeaveNested()
+	// Str
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "Str", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start11, "Str", (*string)(nil))
+	}
+	n12 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n12); err != nil {
+		return d.FieldError(err, start11, "Str", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n12)); err != nil {
+		return d.FieldError(err, start11, "Str", (*string)(nil))
+	}
+	s.Str = string(b)
+	d.LeaveNested()
+	// Kind
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "Kind", (*Kind)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start13, "Kind", (*Kind)(nil))
+	}
+	s.Kind = Kind(b[0])
+	d.LeaveNested()
+	// Label
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "Label", (*Label)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start14, "Label", (*Label)(nil))
+	}
+	n15 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n15); err != nil {
+		return d.FieldError(err, start14, "Label", (*Label)(nil))
+	}
+	if b, err = d.Next(uint(n15)); err != nil {
+		return d.FieldError(err, start14, "Label", (*Label)(nil))
+	}
+	s.Label = Label(b)
+	d.LeaveNested()
+	// Note
+	start16 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start16, "Note", (*Note)(nil))
+	}
+	if err = s.Note.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start16, "Note", (*Note)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Tree
+func (s *Tree) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Tree to b
+func (s *Tree) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Value
+	b = append(b, byte(s.Value))
+	// Children
+	n1 := uint32(len(s.Children))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.Children {
+		if b, err = s.Children[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Tree
+func (s *Tree) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Value
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Value", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "Value", (*uint8)(nil))
+	}
+	s.Value = uint8(b[0])
+	d.LeaveNested()
+	// Children
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Children", (*[]Tree)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Children", (*[]Tree)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.Children[0])); err != nil {
+		return d.FieldError(err, start2, "Children", (*[]Tree)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.Children = make([]Tree, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 Tree
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*Tree)(nil)), start2, "Children", (*[]Tree)(nil))
+		}
+		if err = item6.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*Tree)(nil)), start2, "Children", (*[]Tree)(nil))
+		}
+		d.LeaveNested()
+		s.Children = append(s.Children, item6)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// streamableDecoder matches streamable.GeneratedDecoder, which generated code can't import
+type streamableDecoder interface {
+	io.Reader
+	LimitListLength(numItems uint32, itemSize uintptr) error
+	LimitBytesLength(numBytes uint32) error
+	LimitAllocation(numBytes uintptr) error
+	EnterNested() error
+	LeaveNested()
+	Next(numBytes uint) ([]byte, error)
+	NextBytes(numBytes uint32) ([]byte, error)
+	Offset() int64
+	FieldError(err error, start int64, path string, v interface{}) error
+}
+
+// newStreamableDecoder returns r if it is a streamableDecoder, and wraps it otherwise
+func newStreamableDecoder(r io.Reader) streamableDecoder {
+	if d, ok := r.(streamableDecoder); ok {
+		return d
+	}
+
+	return &streamableReader{r: r}
+}
+
+// streamableReadChunk limits how far ahead of the data actually arriving streamableReader allocates
+const streamableReadChunk = 64 * 1024
+
+// streamableReader is the streamableDecoder for a plain io.Reader, which has no limits other than the data itself
+type streamableReader struct {
+	r      io.Reader
+	offset int64
+	buf    []byte
+}
+
+func (s *streamableReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	s.offset += int64(n)
+	return n, err
+}
+
+// Next reads numBytes in chunks, so the buffer only grows as data arrives
+func (s *streamableReader) Next(numBytes uint) ([]byte, error) {
+	s.buf = s.buf[:0]
+	for uint(len(s.buf)) < numBytes {
+		chunk := numBytes - uint(len(s.buf))
+		if chunk > streamableReadChunk {
+			chunk = streamableReadChunk
+		}
+
+		filled := len(s.buf)
+		s.buf = append(s.buf, make([]byte, chunk)...)
+		n, err := io.ReadFull(s.r, s.buf[filled:])
+		s.offset += int64(n)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+
+	return s.buf, nil
+}
+
+func (s *streamableReader) NextBytes(numBytes uint32) ([]byte, error) {
+	b, err := s.Next(uint(numBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(make([]byte, 0, len(b)), b...), nil
+}
+
+func (s *streamableReader) LimitListLength(numItems uint32, itemSize uintptr) error { return nil }
+func (s *streamableReader) LimitBytesLength(numBytes uint32) error                  { return nil }
+func (s *streamableReader) LimitAllocation(numBytes uintptr) error                  { return nil }
+func (s *streamableReader) EnterNested() error                                      { return nil }
+func (s *streamableReader) LeaveNested()                                            {}
+func (s *streamableReader) Offset() int64                                           { return s.offset }
+
+func (s *streamableReader) FieldError(err error, start int64, path string, v interface{}) error {
+	return err
+}
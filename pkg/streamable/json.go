@@ -0,0 +1,475 @@
+package streamable
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// A JSONError describes a failure converting a value to or from chia's JSON format, and which field it was in
+type JSONError struct {
+	// Path is the JSON field that failed, such as peer_list[3].host
+	// Empty when the failure was in the value passed to MarshalJSON or UnmarshalJSON itself
+	Path string
+
+	// Err is the underlying error
+	Err error
+}
+
+// Error outputs the error message and satisfies the Error interface
+func (e *JSONError) Error() string {
+	if e.Path == "" {
+		return "streamable: json: " + e.Err.Error()
+	}
+
+	return fmt.Sprintf("streamable: json %s: %s", e.Path, e.Err.Error())
+}
+
+// Unwrap returns the underlying error
+func (e *JSONError) Unwrap() error {
+	return e.Err
+}
+
+// jsonWithPath adds segment to the path of err, turning it into a *JSONError if it isn't one already
+func jsonWithPath(err error, segment string) error {
+	jsonErr, ok := err.(*JSONError)
+	if !ok {
+		jsonErr = &JSONError{Err: err}
+	}
+	jsonErr.Path = joinPath(segment, jsonErr.Path)
+
+	return jsonErr
+}
+
+// MarshalJSON returns the JSON representation of v, in the same format as to_json_dict in chia
+// Field names are the snake_case version of the go name (or name=... from the tag), bytes are 0x prefixed hex,
+// uint128 values are plain numbers, optionals are null when not present, and tuples are arrays.
+// Custom types use their own MarshalJSON if they have one, and are otherwise the hex of their streamable bytes
+func MarshalJSON(v interface{}) ([]byte, error) {
+	tv := reflect.Indirect(reflect.ValueOf(v))
+	if !tv.IsValid() {
+		return nil, fmt.Errorf("streamable can't marshal nil")
+	}
+
+	// Everything below this point is addressable, so pointer receivers and byte arrays can be used directly
+	tv = addressable(tv)
+
+	c := codecFor(tv.Type())
+	if c.kind != kindStruct && !c.hasCustom() {
+		return nil, fmt.Errorf("streamable can't marshal a non-struct type")
+	}
+
+	err := c.validationError()
+	if err != nil {
+		return nil, err
+	}
+
+	jsonBytes, err := appendJSON(nil, c, tv, false)
+	if err != nil {
+		if _, ok := err.(*JSONError); !ok {
+			err = &JSONError{Err: err}
+		}
+		return nil, err
+	}
+
+	return jsonBytes, nil
+}
+
+// appendJSON appends the JSON representation of v to dst
+// tuple is set when the struct at the end of this value (through any lists and optionals) is a tuple
+func appendJSON(dst []byte, c *codec, v reflect.Value, tuple bool) ([]byte, error) {
+	if c.hasCustom() && !c.generated {
+		jsonBytes, custom, err := appendJSONCustom(dst, c, v)
+		if custom {
+			return jsonBytes, err
+		}
+	}
+
+	switch c.kind {
+	case kindOptional, kindPointer:
+		if !v.IsNil() {
+			return appendJSON(dst, c.elem, v.Elem(), tuple)
+		}
+		if c.kind == kindPointer {
+			return dst, fmt.Errorf("pointer is nil, but the field isn't optional")
+		}
+
+		return append(dst, "null"...), nil
+	case kindUint8, kindUint16, kindUint32, kindUint64:
+		return strconv.AppendUint(dst, v.Uint(), 10), nil
+	case kindInt8, kindInt16, kindInt32, kindInt64:
+		return strconv.AppendInt(dst, v.Int(), 10), nil
+	case kindBool:
+		return strconv.AppendBool(dst, v.Bool()), nil
+	case kindUint128:
+		return append(dst, v.Interface().(types.Uint128).String()...), nil
+	case kindString:
+		strBytes, err := json.Marshal(v.String())
+		return append(dst, strBytes...), err
+	case kindBytes, kindByteArray:
+		if err := c.checkLength(v.Len()); err != nil {
+			return dst, err
+		}
+
+		return appendHex(dst, v.Slice(0, v.Len()).Bytes()), nil
+	case kindSlice, kindArray:
+		if err := c.checkLength(v.Len()); err != nil {
+			return dst, err
+		}
+
+		var err error
+		dst = append(dst, '[')
+		for j := 0; j < v.Len(); j++ {
+			if j > 0 {
+				dst = append(dst, ',')
+			}
+			dst, err = appendJSON(dst, c.elem, v.Index(j), tuple)
+			if err != nil {
+				return dst, jsonWithPath(err, fmt.Sprintf("[%d]", j))
+			}
+		}
+
+		return append(dst, ']'), nil
+	case kindStruct:
+		return appendJSONStruct(dst, c, v, tuple)
+	}
+
+	return dst, fmt.Errorf("unsupported type %s", c.typ.String())
+}
+
+// appendJSONStruct appends a struct as an object keyed by the JSON field names, or as an array for tuples
+func appendJSONStruct(dst []byte, c *codec, v reflect.Value, tuple bool) ([]byte, error) {
+	open, closing := byte('{'), byte('}')
+	if tuple {
+		open, closing = '[', ']'
+	}
+
+	var err error
+	dst = append(dst, open)
+	for i := range c.fields {
+		f := &c.fields[i]
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		segment := fmt.Sprintf("[%d]", i)
+		if !tuple {
+			segment = f.jsonName
+			dst = strconv.AppendQuote(dst, f.jsonName)
+			dst = append(dst, ':')
+		}
+
+		dst, err = appendJSON(dst, f.codec, v.Field(f.index), f.opts.Tuple)
+		if err != nil {
+			return dst, jsonWithPath(err, segment)
+		}
+	}
+
+	return append(dst, closing), nil
+}
+
+// appendJSONCustom handles types with their own streamable encoding
+// These use their own MarshalJSON if available, and otherwise are the hex of their streamable bytes, which is how
+// chia represents types like SerializedProgram. The returned bool indicates if the value was handled here
+func appendJSONCustom(dst []byte, c *codec, v reflect.Value) ([]byte, bool, error) {
+	if marshaler, ok := jsonTarget(v, jsonMarshalerType); ok {
+		jsonBytes, err := marshaler.(json.Marshaler).MarshalJSON()
+		return append(dst, jsonBytes...), true, err
+	}
+
+	customBytes, custom, err := marshalCustom(nil, c, v)
+	if !custom || err != nil {
+		return dst, custom, err
+	}
+
+	return appendHex(dst, customBytes), true, nil
+}
+
+// appendHex appends bytes as a 0x prefixed JSON string
+func appendHex(dst []byte, bytes []byte) []byte {
+	dst = append(dst, `"0x`...)
+	start := len(dst)
+	dst = append(dst, make([]byte, hex.EncodedLen(len(bytes)))...)
+	hex.Encode(dst[start:], bytes)
+
+	return append(dst, '"')
+}
+
+// jsonTarget returns v as the given interface, if the value or its pointer implements it
+func jsonTarget(v reflect.Value, iface reflect.Type) (interface{}, bool) {
+	if v.Type().Implements(iface) {
+		return v.Interface(), true
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(iface) {
+		return v.Addr().Interface(), true
+	}
+
+	return nil, false
+}
+
+// UnmarshalJSON parses chia's JSON format (from to_json_dict or MarshalJSON) into v, which must be a pointer
+// Missing fields are an error, except for optionals which are treated as not present. Extra fields are ignored,
+// the same as from_json_dict
+func UnmarshalJSON(data []byte, v interface{}) error {
+	tv := reflect.ValueOf(v)
+	if tv.Kind() != reflect.Ptr || tv.IsNil() {
+		return &InvalidUnmarshalError{reflect.TypeOf(v)}
+	}
+
+	tv = tv.Elem()
+	c := codecFor(tv.Type())
+	if c.kind != kindStruct && !c.hasCustom() {
+		return fmt.Errorf("streamable can't unmarshal into non-struct type")
+	}
+
+	err := c.validationError()
+	if err != nil {
+		return err
+	}
+
+	// This checks that the whole input is valid JSON, and trims any whitespace around the value
+	var raw json.RawMessage
+	err = json.Unmarshal(data, &raw)
+	if err == nil {
+		err = unmarshalJSON(c, tv, raw, false)
+	}
+	if err != nil {
+		if _, ok := err.(*JSONError); !ok {
+			err = &JSONError{Err: err}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// unmarshalJSON parses raw into v, which is always settable
+// tuple is set when the struct at the end of this value (through any lists and optionals) is a tuple
+func unmarshalJSON(c *codec, v reflect.Value, raw json.RawMessage, tuple bool) error {
+	isNull := string(raw) == "null"
+
+	if c.hasCustom() && !c.generated && !isNull {
+		custom, err := unmarshalJSONCustom(c, v, raw)
+		if custom {
+			return err
+		}
+	}
+
+	switch c.kind {
+	case kindOptional:
+		if isNull {
+			v.Set(reflect.Zero(c.typ))
+			return nil
+		}
+		fallthrough
+	case kindPointer:
+		if isNull {
+			return fmt.Errorf("expected %s, got null", c.elem.typ.String())
+		}
+
+		ptr := reflect.New(c.elem.typ)
+		err := unmarshalJSON(c.elem, ptr.Elem(), raw, tuple)
+		if err != nil {
+			return err
+		}
+
+		v.Set(ptr)
+		return nil
+	}
+
+	if isNull {
+		return fmt.Errorf("expected %s, got null", c.typ.String())
+	}
+
+	// raw is always a single JSON value with no surrounding whitespace, so numbers can be parsed directly
+	switch c.kind {
+	case kindUint8, kindUint16, kindUint32, kindUint64:
+		parsed, err := strconv.ParseUint(string(raw), 10, c.typ.Bits())
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", c.typ.String(), err)
+		}
+		v.SetUint(parsed)
+	case kindInt8, kindInt16, kindInt32, kindInt64:
+		parsed, err := strconv.ParseInt(string(raw), 10, c.typ.Bits())
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", c.typ.String(), err)
+		}
+		v.SetInt(parsed)
+	case kindBool:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case kindUint128:
+		var u types.Uint128
+		if err := u.UnmarshalJSON(raw); err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(u))
+	case kindString:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		v.SetString(s)
+	case kindBytes, kindByteArray:
+		decoded, err := hexJSON(raw)
+		if err != nil {
+			return err
+		}
+		if err = c.checkLength(len(decoded)); err != nil {
+			return err
+		}
+
+		if c.kind == kindBytes {
+			v.SetBytes(decoded)
+			return nil
+		}
+		if len(decoded) != v.Len() {
+			return fmt.Errorf("expected %d bytes, got %d", v.Len(), len(decoded))
+		}
+		reflect.Copy(v, reflect.ValueOf(decoded))
+	case kindSlice, kindArray:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+		if err := c.checkLength(len(items)); err != nil {
+			return err
+		}
+
+		target := v
+		if c.kind == kindSlice {
+			target = reflect.MakeSlice(c.typ, len(items), len(items))
+		} else if len(items) != v.Len() {
+			return fmt.Errorf("expected %d items, got %d", v.Len(), len(items))
+		}
+
+		for j := range items {
+			if err := unmarshalJSON(c.elem, target.Index(j), items[j], tuple); err != nil {
+				return jsonWithPath(err, fmt.Sprintf("[%d]", j))
+			}
+		}
+
+		if c.kind == kindSlice {
+			v.Set(target)
+		}
+	case kindStruct:
+		return unmarshalJSONStruct(c, v, raw, tuple)
+	default:
+		return fmt.Errorf("unsupported type %s", c.typ.String())
+	}
+
+	return nil
+}
+
+// unmarshalJSONStruct parses an object keyed by the JSON field names, or an array for tuples
+func unmarshalJSONStruct(c *codec, v reflect.Value, raw json.RawMessage, tuple bool) error {
+	if tuple {
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+		if len(items) != len(c.fields) {
+			return fmt.Errorf("expected a tuple of %d items, got %d", len(c.fields), len(items))
+		}
+
+		for i := range c.fields {
+			f := &c.fields[i]
+			if err := unmarshalJSON(f.codec, v.Field(f.index), items[i], f.opts.Tuple); err != nil {
+				return jsonWithPath(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+
+		return nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return err
+	}
+
+	for i := range c.fields {
+		f := &c.fields[i]
+
+		fieldRaw, ok := obj[f.jsonName]
+		if !ok {
+			if f.codec.kind != kindOptional {
+				return fmt.Errorf("missing field %q", f.jsonName)
+			}
+			fieldRaw = json.RawMessage("null")
+		}
+
+		if err := unmarshalJSON(f.codec, v.Field(f.index), fieldRaw, f.opts.Tuple); err != nil {
+			return jsonWithPath(err, f.jsonName)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalJSONCustom handles types with their own streamable encoding, the reverse of appendJSONCustom
+// The returned bool indicates if the value was handled here
+func unmarshalJSONCustom(c *codec, v reflect.Value, raw json.RawMessage) (bool, error) {
+	if unmarshaler, ok := jsonTarget(v, jsonUnmarshalerType); ok {
+		return true, unmarshaler.(json.Unmarshaler).UnmarshalJSON(raw)
+	}
+	if !c.unmarshaler {
+		return false, nil
+	}
+
+	decoded, err := hexJSON(raw)
+	if err != nil {
+		return true, err
+	}
+
+	return true, UnmarshalWithOptions(decoded, v.Addr().Interface(), DecodeOptions{Strict: true})
+}
+
+// hexJSON decodes a JSON hex string, with or without the 0x prefix
+func hexJSON(raw json.RawMessage) ([]byte, error) {
+	var hexStr string
+	if err := json.Unmarshal(raw, &hexStr); err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(strings.TrimPrefix(hexStr, "0x"))
+}
+
+// snakeCase converts a go field name to the snake_case name chia uses, such as NetworkID to network_id
+// A run of capitals is treated as one word, with the last capital starting the next word if it is followed by
+// lowercase letters (ChallengeChainSPProof is challenge_chain_sp_proof)
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if !unicode.IsUpper(r) {
+			b.WriteRune(r)
+			continue
+		}
+
+		if i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,170 @@
+package streamable_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable/internal/gentest"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+const (
+	// The handshake from encodedHexHandshake, as produced by Handshake.to_json_dict()
+	jsonHandshake string = `{"network_id":"mainnet","protocol_version":"0.0.33","software_version":"1.2.11",` +
+		`"server_port":8444,"node_type":1,"capabilities":[[1,"1"]]}`
+
+	// The message from encodedHex2, as produced by Message.to_json_dict()
+	jsonMessage2 string = `{"type":1,"id":35256,` +
+		`"data":"0x5468697320697320612073616d706c65206d65737361676520746f206465636f6465"}`
+)
+
+func TestMarshalJSON(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		json       string
+		value      interface{}
+		empty      func() interface{}
+	}{
+		{
+			encodedHex: encodedHexHandshake[12:],
+			json:       jsonHandshake,
+			value:      &protocols.Handshake{},
+			empty:      func() interface{} { return &protocols.Handshake{} },
+		},
+		{
+			encodedHex: encodedHex2,
+			json:       jsonMessage2,
+			value:      &protocols.Message{},
+			empty:      func() interface{} { return &protocols.Message{} },
+		},
+	} {
+		encodedBytes, err := hex.DecodeString(test.encodedHex)
+		assert.NoError(t, err)
+		assert.NoError(t, streamable.Unmarshal(encodedBytes, test.value))
+
+		jsonBytes, err := streamable.MarshalJSON(test.value)
+		assert.NoError(t, err)
+		assert.Equal(t, test.json, string(jsonBytes))
+
+		// And back to the same binary encoding
+		decoded := test.empty()
+		assert.NoError(t, streamable.UnmarshalJSON(jsonBytes, decoded))
+		assert.Equal(t, test.value, decoded)
+
+		reencodedBytes, err := streamable.Marshal(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, encodedBytes, reencodedBytes)
+	}
+}
+
+func TestMarshalJSON_Optional(t *testing.T) {
+	jsonBytes, err := streamable.MarshalJSON(protocols.Message{ProtocolMessageType: protocols.ProtocolMessageTypeHandshake})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":1,"id":null,"data":"0x"}`, string(jsonBytes))
+
+	// Optionals can be left out completely
+	msg := &protocols.Message{}
+	assert.NoError(t, streamable.UnmarshalJSON([]byte(`{"type": 1, "data": "0102", "extra": true}`), msg))
+	assert.Nil(t, msg.ID)
+	assert.Equal(t, []byte{1, 2}, msg.Data)
+}
+
+func TestMarshalJSON_Everything(t *testing.T) {
+	for _, everything := range []*gentest.Everything{fullEverything(), emptyEverything()} {
+		encodedBytes, err := streamable.Marshal(everything)
+		assert.NoError(t, err)
+
+		jsonBytes, err := streamable.MarshalJSON(everything)
+		assert.NoError(t, err)
+
+		decoded := &gentest.Everything{}
+		assert.NoError(t, streamable.UnmarshalJSON(jsonBytes, decoded))
+
+		reencodedBytes, err := streamable.Marshal(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, encodedBytes, reencodedBytes)
+	}
+}
+
+func TestMarshalJSON_Values(t *testing.T) {
+	u128, err := types.Uint128FromString("340282366920938463463374607431768211455")
+	assert.NoError(t, err)
+
+	// uint128 is a plain number, and the hand written Note is the hex of its streamable bytes
+	jsonBytes, err := streamable.MarshalJSON(&gentest.Scalars{U64: 18446744073709551615, U128: u128, I8: -1, Note: "a"})
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"u8":0,"u16":0,"u32":0,"u64":18446744073709551615,"u128":340282366920938463463374607431768211455,`+
+			`"i8":-1,"i16":0,"i32":0,"i64":0,"bool":false,"str":"","kind":0,"label":"","note":"0x6100"}`,
+		string(jsonBytes),
+	)
+
+	decoded := &gentest.Scalars{}
+	assert.NoError(t, streamable.UnmarshalJSON(jsonBytes, decoded))
+	assert.Equal(t, u128, decoded.U128)
+	assert.Equal(t, uint64(18446744073709551615), decoded.U64)
+	assert.Equal(t, gentest.Note("a"), decoded.Note)
+}
+
+func TestUnmarshalJSON_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		json     string
+		expected string
+	}{
+		{json: `{"type":1}`, expected: `streamable: json: missing field "data"`},
+		{json: `{"type":null,"data":""}`, expected: "streamable: json type: expected protocols.ProtocolMessageType, got null"},
+		{json: `{"type":256,"data":""}`, expected: `streamable: json type: invalid value for protocols.ProtocolMessageType: strconv.ParseUint: parsing "256": value out of range`},
+		{json: `{"type":1,"id":-1,"data":""}`, expected: `streamable: json id: invalid value for uint16: strconv.ParseUint: parsing "-1": invalid syntax`},
+		{json: `{"type":1,"data":"0xzz"}`, expected: "streamable: json data: encoding/hex: invalid byte: U+007A 'z'"},
+		{json: `{"type":1,"data":""} {}`, expected: "streamable: json: invalid character '{' after top-level value"},
+	} {
+		err := streamable.UnmarshalJSON([]byte(test.json), &protocols.Message{})
+		assert.EqualError(t, err, test.expected, test.json)
+
+		var jsonErr *streamable.JSONError
+		assert.True(t, errors.As(err, &jsonErr), test.json)
+	}
+
+	err := streamable.UnmarshalJSON([]byte(`{"peer_list":[{"host":"a","port":1,"timestamp":1},{"host":"b","port":"x","timestamp":2}]}`), &protocols.RespondPeers{})
+	assert.EqualError(t, err, `streamable: json peer_list[1].port: invalid value for uint16: strconv.ParseUint: parsing "\"x\"": invalid syntax`)
+
+	// Tuples must have the right number of items
+	err = streamable.UnmarshalJSON([]byte(`{"network_id":"","protocol_version":"","software_version":"","server_port":1,"node_type":1,"capabilities":[[1]]}`), &protocols.Handshake{})
+	assert.EqualError(t, err, "streamable: json capabilities[0]: expected a tuple of 2 items, got 1")
+
+	// Fixed size bytes must be exactly the right length
+	err = streamable.UnmarshalJSON([]byte(`{"data":"0x01020304","hashes":["0x00","0x00"],"optional":null}`), &Fixed{})
+	assert.EqualError(t, err, "streamable: json hashes[0]: expected 32 bytes, got 1")
+
+	assert.Error(t, streamable.UnmarshalJSON([]byte(`{}`), protocols.Message{}))
+}
+
+func TestMarshalJSON_Invalid(t *testing.T) {
+	_, err := streamable.MarshalJSON(nil)
+	assert.Error(t, err)
+
+	_, err = streamable.MarshalJSON(&Typo{})
+	assert.Error(t, err)
+
+	_, err = streamable.MarshalJSON(&Fixed{Data: []byte{1}})
+	assert.EqualError(t, err, "streamable: json data: fixed length list must have exactly 4 items, got 1")
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{
+		"NetworkID":             "network_id",
+		"PeerList":              "peer_list",
+		"ID":                    "id",
+		"ChallengeChainSPProof": "challenge_chain_sp_proof",
+		"G1Element":             "g1_element",
+		"RewardChainIPProof":    "reward_chain_ip_proof",
+		"U128":                  "u128",
+	} {
+		assert.Equal(t, expected, streamable.SnakeCase(name))
+	}
+}
@@ -0,0 +1,105 @@
+package streamable
+
+import (
+	"io"
+	"reflect"
+)
+
+// Marshaler is the interface implemented by types that can marshal themselves into streamable bytes
+// This is checked for on every field, list item, and optional value, as well as the value passed to Marshal
+type Marshaler interface {
+	MarshalStreamable() ([]byte, error)
+}
+
+// Unmarshaler is the interface implemented by types that can unmarshal themselves from streamable bytes
+// There is no framing around custom types, so UnmarshalStreamable must read exactly the bytes that belong to it from r
+// and no more. This allows for types where the length is implied by the data itself, such as serialized CLVM programs.
+// This is checked for on every field, list item, and optional value, as well as the value passed to Unmarshal
+type Unmarshaler interface {
+	UnmarshalStreamable(r io.Reader) error
+}
+
+// Appender is implemented by types that can append their streamable encoding to an existing buffer
+// Code generated by streamablegen implements this along with Marshaler and Unmarshaler. When present, it is used
+// instead of MarshalStreamable to avoid an extra allocation and copy for every value
+type Appender interface {
+	AppendStreamable(b []byte) ([]byte, error)
+}
+
+// Sizer is implemented by types with a custom encoding that can report their encoded size without encoding
+// Without it, Size has to marshal custom types to find out how big they are
+type Sizer interface {
+	StreamableSize() int
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	appenderType    = reflect.TypeOf((*Appender)(nil)).Elem()
+	sizerType       = reflect.TypeOf((*Sizer)(nil)).Elem()
+
+	// ignoreGenerated makes the reflective path skip generated code (types implementing Appender)
+	// This only exists so tests can compare generated code against the reflective path
+	ignoreGenerated = false
+)
+
+// customTarget returns the value to call custom methods on, based on the receiver type of the method
+// Pointer receivers can only be used when the value is addressable
+func customTarget(method customMethod, v reflect.Value) (reflect.Value, bool) {
+	switch method {
+	case customValue:
+		return v, true
+	case customPointer:
+		if v.CanAddr() {
+			return v.Addr(), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// marshalCustom uses the value's own AppendStreamable or MarshalStreamable method if it has one
+// The returned bool indicates if a custom method was found and used
+func marshalCustom(finalBytes []byte, c *codec, v reflect.Value) ([]byte, bool, error) {
+	if ignoreGenerated && c.generated {
+		return finalBytes, false, nil
+	}
+
+	// Appending directly to the existing bytes is preferred when available
+	if target, ok := customTarget(c.appender, v); ok {
+		var err error
+		finalBytes, err = target.Interface().(Appender).AppendStreamable(finalBytes)
+		return finalBytes, true, err
+	}
+
+	if target, ok := customTarget(c.marshaler, v); ok {
+		customBytes, err := target.Interface().(Marshaler).MarshalStreamable()
+		if err != nil {
+			return finalBytes, true, err
+		}
+
+		return append(finalBytes, customBytes...), true, nil
+	}
+
+	return finalBytes, false, nil
+}
+
+// unmarshalCustom uses the value's own UnmarshalStreamable method if it has one
+// The returned bool indicates if an Unmarshaler was found and used
+func unmarshalCustom(d *decodeState, c *codec, v reflect.Value) (bool, error) {
+	if !c.unmarshaler || !v.CanAddr() {
+		return false, nil
+	}
+	if (ignoreGenerated || d.explain != nil) && c.generated {
+		// Explain walks the fields of generated types, so each field gets its own annotation
+		return false, nil
+	}
+
+	start := d.offset
+	err := v.Addr().Interface().(Unmarshaler).UnmarshalStreamable(d)
+	if err == nil {
+		d.explain.value(d, start, c, v)
+	}
+
+	return true, err
+}
@@ -0,0 +1,204 @@
+package streamable_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+)
+
+// CString is a null terminated string, so its length is only known by reading it
+type CString string
+
+// MarshalStreamable writes the string followed by a null byte
+func (c CString) MarshalStreamable() ([]byte, error) {
+	return append([]byte(c), 0), nil
+}
+
+// UnmarshalStreamable reads until the null byte
+func (c *CString) UnmarshalStreamable(r io.Reader) error {
+	var str []byte
+	b := make([]byte, 1)
+	for {
+		_, err := io.ReadFull(r, b)
+		if err != nil {
+			return err
+		}
+		if b[0] == 0 {
+			break
+		}
+		str = append(str, b[0])
+	}
+
+	*c = CString(str)
+	return nil
+}
+
+// Bits packs up to 8 bools into a single byte
+type Bits [8]bool
+
+// MarshalStreamable packs the bools into a byte
+func (b Bits) MarshalStreamable() ([]byte, error) {
+	var packed uint8
+	for i, set := range b {
+		if set {
+			packed |= 1 << i
+		}
+	}
+
+	return []byte{packed}, nil
+}
+
+// UnmarshalStreamable unpacks the byte to bools
+func (b *Bits) UnmarshalStreamable(r io.Reader) error {
+	packed := make([]byte, 1)
+	_, err := io.ReadFull(r, packed)
+	if err != nil {
+		return err
+	}
+
+	for i := range b {
+		b[i] = packed[0]&(1<<i) != 0
+	}
+
+	return nil
+}
+
+// Custom uses the custom types in all the places they can appear
+type Custom struct {
+	Name     CString   `streamable:""`
+	Flags    Bits      `streamable:""`
+	Names    []CString `streamable:""`
+	Optional *CString  `streamable:"optional"`
+	Missing  *Bits     `streamable:"optional"`
+	After    uint16    `streamable:""`
+}
+
+const (
+	// 616263 00 CString "abc"
+	// 05 Bits [true, false, true]
+	// 00000002 6400 6500 List[CString] ["d", "e"]
+	// 01 6600 Optional[CString] "f"
+	// 00 Optional[Bits] None
+	// 0007 uint16(7)
+	encodedHexCustom = "61626300" + "05" + "0000000264006500" + "016600" + "00" + "0007"
+)
+
+func TestUnmarshal_Remarshal_Custom(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHexCustom)
+	assert.NoError(t, err)
+
+	c := &Custom{}
+	err = streamable.Unmarshal(encodedBytes, c)
+	assert.NoError(t, err)
+
+	assert.Equal(t, CString("abc"), c.Name)
+	assert.Equal(t, Bits{true, false, true}, c.Flags)
+	assert.Equal(t, []CString{"d", "e"}, c.Names)
+	assert.NotNil(t, c.Optional)
+	assert.Equal(t, CString("f"), *c.Optional)
+	assert.Nil(t, c.Missing)
+	assert.Equal(t, uint16(7), c.After)
+
+	reencodedBytes, err := streamable.Marshal(c)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+
+	// Non-pointer should encode the same
+	reencodedBytes, err = streamable.Marshal(*c)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestDecoder_Custom(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHexCustom)
+	assert.NoError(t, err)
+
+	c := &Custom{}
+	err = streamable.NewDecoder(bytes.NewReader(encodedBytes)).Decode(c)
+	assert.NoError(t, err)
+	assert.Equal(t, CString("abc"), c.Name)
+	assert.Equal(t, uint16(7), c.After)
+}
+
+func TestMarshal_CustomTopLevel(t *testing.T) {
+	// Custom types don't need to be structs when passed directly
+	encodedBytes, err := streamable.Marshal(CString("hi"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{'h', 'i', 0}, encodedBytes)
+
+	var decoded CString
+	err = streamable.Unmarshal(encodedBytes, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, CString("hi"), decoded)
+
+	// Missing the null terminator
+	err = streamable.Unmarshal([]byte{'h', 'i'}, &decoded)
+	assert.Error(t, err)
+}
+
+// Failing always returns errors
+type Failing struct{}
+
+// MarshalStreamable always fails
+func (f Failing) MarshalStreamable() ([]byte, error) {
+	return nil, fmt.Errorf("marshal failed")
+}
+
+// UnmarshalStreamable always fails
+func (f *Failing) UnmarshalStreamable(r io.Reader) error {
+	return fmt.Errorf("unmarshal failed")
+}
+
+// HasFailing has a field that can't be encoded or decoded
+type HasFailing struct {
+	Before uint8   `streamable:""`
+	Failed Failing `streamable:""`
+}
+
+func TestCustom_Errors(t *testing.T) {
+	_, err := streamable.Marshal(&HasFailing{})
+	assert.EqualError(t, err, "marshal failed")
+
+	err = streamable.Unmarshal([]byte{1}, &HasFailing{})
+	assert.EqualError(t, err, "streamable: decoding Failed at offset 1: unmarshal failed")
+}
+
+// Packed is two small numbers packed into a single byte, with the methods on the pointer
+type Packed struct {
+	High uint32 `streamable:""`
+	Low  uint32 `streamable:""`
+}
+
+// MarshalStreamable packs both numbers into one byte
+func (p *Packed) MarshalStreamable() ([]byte, error) {
+	return []byte{byte(p.High<<4 | p.Low&0x0f)}, nil
+}
+
+// StreamableSize is always one byte
+func (p *Packed) StreamableSize() int {
+	return 1
+}
+
+// HasPacked has a field with a pointer receiver marshaler
+type HasPacked struct {
+	Packed Packed `streamable:""`
+}
+
+func TestCustom_PointerReceiverByValue(t *testing.T) {
+	// The custom encoding is used whether or not the value is passed as a pointer
+	for _, v := range []interface{}{Packed{High: 1, Low: 2}, &Packed{High: 1, Low: 2}, HasPacked{Packed: Packed{High: 1, Low: 2}}, &HasPacked{Packed: Packed{High: 1, Low: 2}}} {
+		encodedBytes, err := streamable.Marshal(v)
+		assert.NoError(t, err, "%T", v)
+		assert.Equal(t, []byte{0x12}, encodedBytes, "%T", v)
+
+		size, err := streamable.Size(v)
+		assert.NoError(t, err, "%T", v)
+		assert.Equal(t, 1, size, "%T", v)
+	}
+}
@@ -0,0 +1,184 @@
+package streamable
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Default limits used by Unmarshal and Decoder, unless other options are provided
+// These are well above anything chia actually sends, but stop a single message from claiming gigabytes of memory
+const (
+	DefaultMaxListLength  = 1 << 20 // 1,048,576 items
+	DefaultMaxBytesLength = 1 << 26 // 64 MiB
+	DefaultMaxAllocation  = 1 << 28 // 256 MiB
+	DefaultMaxDepth       = 64
+
+	// maxStreamPrealloc caps how many list items are allocated up front when decoding from a stream
+	maxStreamPrealloc = 1024
+)
+
+// DecodeOptions limit what a single decode is allowed to do, to protect against hostile input
+// Lengths in streamable data come from the peer, so a 4 byte length prefix of 0xFFFFFFFF shouldn't result in
+// trying to allocate 4 billion items. Limits are checked before anything is allocated for the value.
+//
+// A zero value for any limit means the default is used. Set a limit to a negative number to disable it.
+type DecodeOptions struct {
+	// MaxListLength is the maximum number of items in any single list
+	MaxListLength int
+
+	// MaxBytesLength is the maximum length of any single string or bytes value
+	MaxBytesLength int
+
+	// MaxAllocation is the maximum total number of bytes allocated for lists, strings, bytes, and optional values
+	// while decoding a single value. Lists are counted at the size of their items in memory, which may be larger than
+	// the size of the items on the wire
+	MaxAllocation int
+
+	// MaxDepth is the maximum nesting of lists, optionals, and structs
+	// This protects against running out of stack space when decoding recursive types
+	MaxDepth int
+
+	// ZeroCopy makes []byte values refer to the input bytes instead of copying them, which avoids an allocation and a
+	// copy for every bytes field. This makes a big difference for large fields such as Message.Data or
+	// transactions_generator. Strings, fixed size byte arrays, and lists of other types are still copied.
+	//
+	// The decoded value shares memory with the input, so the input must not be modified or reused (such as returning
+	// it to a pool, or reading the next message into the same buffer) for as long as the value is in use. Modifying
+	// a []byte in the value modifies the input as well. Appending to one is safe, since that always makes a copy.
+	// Only applies to UnmarshalWithOptions. Decoder always copies, since it reuses its buffer between reads
+	ZeroCopy bool
+
+	// Strict returns a *TrailingBytesError if there are any bytes left over after decoding the value
+	// Chia rejects extra data after a streamable value, and it usually points to a framing bug or a mismatch in the
+	// protocol between us and the peer. Only applies to UnmarshalWithOptions, since streams don't have an end
+	Strict bool
+}
+
+// withDefaults returns the options with the defaults filled in for any limits that weren't set
+func (o DecodeOptions) withDefaults() DecodeOptions {
+	if o.MaxListLength == 0 {
+		o.MaxListLength = DefaultMaxListLength
+	}
+	if o.MaxBytesLength == 0 {
+		o.MaxBytesLength = DefaultMaxBytesLength
+	}
+	if o.MaxAllocation == 0 {
+		o.MaxAllocation = DefaultMaxAllocation
+	}
+	if o.MaxDepth == 0 {
+		o.MaxDepth = DefaultMaxDepth
+	}
+
+	return o
+}
+
+// exceeds returns true if value is over the limit, when the limit is enabled
+func exceeds(value uint64, limit int) bool {
+	return limit > 0 && value > uint64(limit)
+}
+
+// checkListLength checks the number of items in a list before anything is allocated for it
+// itemSize is the size of a single item in memory
+func (d *decodeState) checkListLength(numItems uint32, itemSize uintptr) error {
+	if exceeds(uint64(numItems), d.opts.MaxListLength) {
+		return fmt.Errorf("list length %d exceeds the maximum of %d", numItems, d.opts.MaxListLength)
+	}
+
+	hi, total := bits.Mul64(uint64(numItems), uint64(itemSize))
+	if hi != 0 {
+		return fmt.Errorf("list of %d items is too large to allocate", numItems)
+	}
+
+	return d.allocate(total)
+}
+
+// checkBytesLength checks the length of a string or bytes value before anything is allocated for it
+func (d *decodeState) checkBytesLength(numBytes uint32) error {
+	if exceeds(uint64(numBytes), d.opts.MaxBytesLength) {
+		return fmt.Errorf("length %d exceeds the maximum of %d bytes", numBytes, d.opts.MaxBytesLength)
+	}
+
+	return d.allocate(uint64(numBytes))
+}
+
+// preallocate returns how many list items to allocate space for up front
+// When decoding from memory, almost every type takes at least one byte per item, so there can't be more items than
+// bytes remaining. Streams don't know how much data is coming, so the preallocation is capped instead.
+// Either way, the slice still grows as needed if there turn out to be more items.
+func (d *decodeState) preallocate(numItems uint32) int {
+	limit := uint64(maxStreamPrealloc)
+	if d.reader == nil {
+		limit = uint64(len(d.bytes))
+	}
+	if uint64(numItems) < limit {
+		return int(numItems)
+	}
+
+	return int(limit)
+}
+
+// allocate records that numBytes are about to be allocated, and returns an error if that goes over the limit
+func (d *decodeState) allocate(numBytes uint64) error {
+	d.allocated += numBytes
+	if exceeds(d.allocated, d.opts.MaxAllocation) {
+		return fmt.Errorf("decoding requires more than the maximum allocation of %d bytes", d.opts.MaxAllocation)
+	}
+
+	return nil
+}
+
+// DecodeLimiter is implemented by the io.Reader that Unmarshal and Decoder pass to UnmarshalStreamable
+// Unmarshalers that read their own length prefixes can use it to apply the DecodeOptions limits before allocating
+// anything based on those lengths, and unmarshalers that decode nested values can use EnterNested and LeaveNested to
+// apply MaxDepth. Code generated by streamablegen does both automatically.
+type DecodeLimiter interface {
+	LimitListLength(numItems uint32, itemSize uintptr) error
+	LimitBytesLength(numBytes uint32) error
+	LimitAllocation(numBytes uintptr) error
+	EnterNested() error
+	LeaveNested()
+}
+
+// LimitListLength implements DecodeLimiter
+// itemSize is the size of a single item in memory, which counts towards MaxAllocation. Use 0 if it isn't known
+func (d *decodeState) LimitListLength(numItems uint32, itemSize uintptr) error {
+	return d.checkListLength(numItems, itemSize)
+}
+
+// LimitBytesLength implements DecodeLimiter
+func (d *decodeState) LimitBytesLength(numBytes uint32) error {
+	return d.checkBytesLength(numBytes)
+}
+
+// LimitAllocation implements DecodeLimiter
+// It counts numBytes towards MaxAllocation, such as for the value of an optional
+func (d *decodeState) LimitAllocation(numBytes uintptr) error {
+	return d.allocate(uint64(numBytes))
+}
+
+// EnterNested implements DecodeLimiter
+// It is called before decoding each field or list item, the same places the reflective path checks the depth
+func (d *decodeState) EnterNested() error {
+	return d.enter()
+}
+
+// LeaveNested implements DecodeLimiter
+func (d *decodeState) LeaveNested() {
+	d.leave()
+}
+
+// enter is called when decoding moves into a nested value, and returns an error if that is too deep
+// Every call to enter must be followed by a call to leave
+func (d *decodeState) enter() error {
+	d.depth++
+	if d.opts.MaxDepth > 0 && d.depth > d.opts.MaxDepth {
+		return fmt.Errorf("nesting exceeds the maximum depth of %d", d.opts.MaxDepth)
+	}
+
+	return nil
+}
+
+// leave is called when decoding of a nested value is complete
+func (d *decodeState) leave() {
+	d.depth--
+}
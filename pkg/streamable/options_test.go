@@ -0,0 +1,202 @@
+package streamable_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable/internal/gentest"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+func TestDecodeOptions_HostileListLength(t *testing.T) {
+	// RespondPeers claiming 4 billion peers, with nothing behind it
+	encodedBytes := []byte{0xff, 0xff, 0xff, 0xff}
+
+	err := streamable.Unmarshal(encodedBytes, &protocols.RespondPeers{})
+	assert.EqualError(t, err, "streamable: decoding PeerList at offset 0: list length 4294967295 exceeds the maximum of 1048576")
+
+	err = streamable.NewDecoder(bytes.NewReader(encodedBytes)).Decode(&protocols.RespondPeers{})
+	assert.EqualError(t, err, "streamable: decoding PeerList at offset 0: list length 4294967295 exceeds the maximum of 1048576")
+
+	// With the limits off, we just run out of data
+	err = streamable.UnmarshalWithOptions(encodedBytes, &protocols.RespondPeers{}, streamable.DecodeOptions{MaxListLength: -1, MaxAllocation: -1})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestDecodeOptions_HostileBytesLength(t *testing.T) {
+	// Message with data claiming to be almost 4GB
+	encodedBytes := []byte{0x01, 0x00, 0xff, 0xff, 0xff, 0xf0, 0x01, 0x02}
+
+	err := streamable.Unmarshal(encodedBytes, &protocols.Message{})
+	assert.EqualError(t, err, "streamable: decoding Data at offset 2: length 4294967280 exceeds the maximum of 67108864 bytes")
+
+	// Even with no limits, reading from a stream only allocates as data actually arrives
+	dec := streamable.NewDecoder(bytes.NewReader(encodedBytes))
+	dec.SetOptions(streamable.DecodeOptions{MaxBytesLength: -1, MaxAllocation: -1})
+	err = dec.Decode(&protocols.Message{})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	var decodeErr *streamable.DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, 4294967280, decodeErr.Expected)
+	assert.Equal(t, 2, decodeErr.Remaining)
+}
+
+func TestDecodeOptions_Limits(t *testing.T) {
+	rp := benchRespondPeers()
+	encodedBytes, err := streamable.Marshal(rp)
+	assert.NoError(t, err)
+
+	// Defaults are fine for normal data
+	assert.NoError(t, streamable.Unmarshal(encodedBytes, &protocols.RespondPeers{}))
+
+	err = streamable.UnmarshalWithOptions(encodedBytes, &protocols.RespondPeers{}, streamable.DecodeOptions{MaxListLength: 999})
+	assert.EqualError(t, err, "streamable: decoding PeerList at offset 0: list length 1000 exceeds the maximum of 999")
+
+	err = streamable.UnmarshalWithOptions(encodedBytes, &protocols.RespondPeers{}, streamable.DecodeOptions{MaxBytesLength: 10})
+	assert.EqualError(t, err, "streamable: decoding PeerList[0].Host at offset 4: length 11 exceeds the maximum of 10 bytes")
+
+	// The list itself is 1000 * 32 bytes in memory, and then each host on top of that
+	err = streamable.UnmarshalWithOptions(encodedBytes, &protocols.RespondPeers{}, streamable.DecodeOptions{MaxAllocation: 31999})
+	assert.EqualError(t, err, "streamable: decoding PeerList at offset 0: decoding requires more than the maximum allocation of 31999 bytes")
+
+	err = streamable.UnmarshalWithOptions(encodedBytes, &protocols.RespondPeers{}, streamable.DecodeOptions{MaxAllocation: 32010})
+	assert.EqualError(t, err, "streamable: decoding PeerList[0].Host at offset 4: decoding requires more than the maximum allocation of 32010 bytes")
+}
+
+func TestDecodeOptions_MaxDepth(t *testing.T) {
+	// Recursive nested 100 deep, each level is a uint8 value and a list with one child
+	var encodedBytes []byte
+	for i := 0; i < 100; i++ {
+		encodedBytes = append(encodedBytes, uint8(i), 0, 0, 0, 1)
+	}
+	encodedBytes = append(encodedBytes, 100, 0, 0, 0, 0)
+
+	err := streamable.Unmarshal(encodedBytes, &Recursive{})
+	var decodeErr *streamable.DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.EqualError(t, decodeErr.Err, "nesting exceeds the maximum depth of 64")
+
+	decoded := &Recursive{}
+	err = streamable.UnmarshalWithOptions(encodedBytes, decoded, streamable.DecodeOptions{MaxDepth: -1})
+	assert.NoError(t, err)
+
+	reencodedBytes, err := streamable.Marshal(decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestUnmarshal_InvalidPresenceByte(t *testing.T) {
+	// Message with 02 for the presence byte of the ID
+	err := streamable.Unmarshal([]byte{0x01, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}, &protocols.Message{})
+	assert.EqualError(t, err, "streamable: decoding ID at offset 1: invalid value for optional presence byte 2")
+}
+
+func TestDecodeOptions_Generated(t *testing.T) {
+	encodedBytes, err := streamable.Marshal(fullEverything())
+	assert.NoError(t, err)
+
+	// Generated code checks lengths through the DecodeLimiter it is given
+	err = streamable.UnmarshalWithOptions(encodedBytes, &gentest.Everything{}, streamable.DecodeOptions{MaxBytesLength: 4})
+	assert.Error(t, err)
+
+	err = streamable.UnmarshalWithOptions(encodedBytes, &gentest.Everything{}, streamable.DecodeOptions{MaxListLength: 2})
+	assert.Error(t, err)
+
+	err = streamable.UnmarshalWithOptions(encodedBytes, &gentest.Everything{}, streamable.DecodeOptions{MaxListLength: 3, MaxBytesLength: 12})
+	assert.NoError(t, err)
+}
+
+func TestUnmarshal_Strict(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHex1 + "0102")
+	assert.NoError(t, err)
+
+	// Not strict by default, so the extra bytes are ignored
+	err = streamable.Unmarshal(encodedBytes, &protocols.Message{})
+	assert.NoError(t, err)
+
+	err = streamable.UnmarshalWithOptions(encodedBytes, &protocols.Message{}, streamable.DecodeOptions{Strict: true})
+	var trailingErr *streamable.TrailingBytesError
+	assert.True(t, errors.As(err, &trailingErr))
+	assert.Equal(t, 2, trailingErr.Remaining)
+	assert.Equal(t, int64(len(encodedBytes)-2), trailingErr.Offset)
+
+	// Exactly one value is fine
+	err = streamable.UnmarshalWithOptions(encodedBytes[:len(encodedBytes)-2], &protocols.Message{}, streamable.DecodeOptions{Strict: true})
+	assert.NoError(t, err)
+}
+
+func TestUnmarshalPrefix(t *testing.T) {
+	var records []byte
+	for _, encodedHex := range []string{encodedHex1, encodedHex2, encodedHexHandshake} {
+		encodedBytes, err := hex.DecodeString(encodedHex)
+		assert.NoError(t, err)
+		records = append(records, encodedBytes...)
+	}
+
+	var messages []*protocols.Message
+	for len(records) > 0 {
+		msg := &protocols.Message{}
+
+		var err error
+		records, err = streamable.UnmarshalPrefix(records, msg)
+		assert.NoError(t, err)
+
+		messages = append(messages, msg)
+	}
+
+	assert.Len(t, messages, 3)
+	assert.Nil(t, messages[0].ID)
+	assert.Equal(t, util.PtrUint16(35256), messages[1].ID)
+	assert.Equal(t, protocols.ProtocolMessageTypeHandshake, messages[2].ProtocolMessageType)
+
+	// Truncated records are still an error
+	remaining, err := streamable.UnmarshalPrefix([]byte{0x01, 0x00, 0x00}, &protocols.Message{})
+	assert.Nil(t, remaining)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestDecodeOptions_ZeroCopy(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHex1)
+	assert.NoError(t, err)
+
+	copied := &protocols.Message{}
+	assert.NoError(t, streamable.Unmarshal(encodedBytes, copied))
+
+	aliased := &protocols.Message{}
+	assert.NoError(t, streamable.UnmarshalWithOptions(encodedBytes, aliased, streamable.DecodeOptions{ZeroCopy: true}))
+	assert.Equal(t, copied, aliased)
+
+	// Data starts after the type, presence byte, and length prefix, and refers to the input directly
+	assert.Same(t, &encodedBytes[6], &aliased.Data[0])
+	assert.Equal(t, len(aliased.Data), cap(aliased.Data))
+
+	encodedBytes[6] = 'X'
+	assert.Equal(t, byte('X'), aliased.Data[0])
+	assert.Equal(t, byte('T'), copied.Data[0])
+
+	// Streams always copy, since the buffer is reused
+	dec := streamable.NewDecoder(bytes.NewReader(encodedBytes))
+	dec.SetOptions(streamable.DecodeOptions{ZeroCopy: true})
+	streamed := &protocols.Message{}
+	assert.NoError(t, dec.Decode(streamed))
+	assert.NotSame(t, &encodedBytes[6], &streamed.Data[0])
+}
+
+func TestDecodeOptions_ZeroCopyAllocations(t *testing.T) {
+	encodedBytes, err := streamable.Marshal(benchLargeMessage())
+	assert.NoError(t, err)
+
+	// Only the Message and the decode state, with no copy of the 1MB of data
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = streamable.UnmarshalWithOptions(encodedBytes, &protocols.Message{}, streamable.DecodeOptions{ZeroCopy: true})
+	})
+	assert.LessOrEqual(t, allocs, float64(2))
+}
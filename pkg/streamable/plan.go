@@ -0,0 +1,422 @@
+package streamable
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// codecKind is how a type is encoded on the wire
+type codecKind uint8
+
+const (
+	kindUnsupported codecKind = iota
+	kindUint8
+	kindUint16
+	kindUint32
+	kindUint64
+	kindInt8
+	kindInt16
+	kindInt32
+	kindInt64
+	kindBool
+	kindUint128
+	kindString
+	kindBytes     // []uint8 - length prefix, then the raw bytes
+	kindSlice     // any other slice - length prefix, then each item
+	kindByteArray // [N]uint8 - raw bytes, no prefix
+	kindArray     // any other array - each item, no prefix
+	kindStruct    // nested streamable or tuple - each field, no prefix
+	kindOptional  // presence byte, then the value if present
+	kindPointer   // pointer field that isn't optional - just the value being pointed to
+)
+
+// customMethod indicates which of the custom encoding interfaces a type implements, and how
+type customMethod uint8
+
+const (
+	customNone customMethod = iota
+	customValue
+	customPointer
+)
+
+// codec is the compiled encoding plan for a single type
+// Plans are built once per type and cached, so that struct tags and interface checks aren't
+// repeated every time a value is encoded or decoded
+type codec struct {
+	kind codecKind
+	typ  reflect.Type
+
+	// elem is the codec for slice/array items and the value of optionals/pointers
+	elem *codec
+
+	// fields are the streamable fields of a struct, in order
+	fields []fieldPlan
+
+	// length is set for fixed length lists (len=N), which have no length prefix
+	length int
+
+	// fixedSize is the encoded size of every value of the type, or 0 when the size depends on the value
+	fixedSize int
+
+	// Custom encodings implemented by the type
+	appender    customMethod
+	marshaler   customMethod
+	sizer       customMethod
+	unmarshaler bool
+	generated   bool
+
+	// invalid is the result of validating the plan, which only happens once
+	validateOnce sync.Once
+	invalid      error
+}
+
+// fieldPlan is a single streamable field of a struct
+type fieldPlan struct {
+	name  string
+	index int
+	opts  TagOptions
+	codec *codec
+
+	// jsonName is the name of the field in chia's JSON format
+	jsonName string
+
+	// err is set when the field's tag can't work with its type
+	// The error is returned when the field is used, the same as any other error encoding the value
+	err error
+}
+
+// codecCache holds the compiled codec for every type that has been encoded or decoded (map[reflect.Type]*codec)
+var codecCache sync.Map
+
+// codecFor returns the cached codec for t, compiling it first if needed
+func codecFor(t reflect.Type) *codec {
+	if c, ok := codecCache.Load(t); ok {
+		return c.(*codec)
+	}
+
+	c := compile(t, map[reflect.Type]*codec{})
+	actual, _ := codecCache.LoadOrStore(t, c)
+
+	return actual.(*codec)
+}
+
+// compile builds the codec for t
+// inProgress tracks structs that are currently being compiled, so recursive types refer back to the same codec
+func compile(t reflect.Type, inProgress map[reflect.Type]*codec) *codec {
+	if c, ok := codecCache.Load(t); ok {
+		return c.(*codec)
+	}
+	if c, ok := inProgress[t]; ok {
+		return c
+	}
+
+	c := &codec{typ: t}
+	if t.Kind() != reflect.Ptr {
+		compileCustom(c)
+	}
+
+	// Uint128 is a struct internally, but is encoded as 16 big-endian bytes on the wire
+	if t == uint128Type {
+		c.kind = kindUint128
+		c.fixedSize = 16
+		return c
+	}
+
+	switch t.Kind() {
+	case reflect.Uint8:
+		c.kind = kindUint8
+	case reflect.Uint16:
+		c.kind = kindUint16
+	case reflect.Uint32:
+		c.kind = kindUint32
+	case reflect.Uint64:
+		c.kind = kindUint64
+	case reflect.Int8:
+		c.kind = kindInt8
+	case reflect.Int16:
+		c.kind = kindInt16
+	case reflect.Int32:
+		c.kind = kindInt32
+	case reflect.Int64:
+		c.kind = kindInt64
+	case reflect.Bool:
+		c.kind = kindBool
+	case reflect.String:
+		c.kind = kindString
+	case reflect.Slice:
+		c.kind = kindSlice
+		if t.Elem().Kind() == reflect.Uint8 {
+			c.kind = kindBytes
+		}
+		c.elem = compileElement(t.Elem(), inProgress)
+	case reflect.Array:
+		c.kind = kindArray
+		if t.Elem().Kind() == reflect.Uint8 {
+			c.kind = kindByteArray
+		}
+		c.elem = compileElement(t.Elem(), inProgress)
+	case reflect.Struct:
+		c.kind = kindStruct
+		inProgress[t] = c
+		compileFields(c, inProgress)
+	default:
+		c.kind = kindUnsupported
+	}
+
+	c.fixedSize = c.computeFixedSize()
+
+	return c
+}
+
+// computeFixedSize returns the encoded size of every value of the type, or 0 when it depends on the value
+// Anything with a length prefix, presence byte, or custom encoding can vary. Fixed length lists (len=N) are
+// treated as varying too, so that Size still checks they have the right number of items, and so are pointers, so
+// that Size still checks they aren't nil
+func (c *codec) computeFixedSize() int {
+	if c.hasCustom() && !c.generated {
+		return 0
+	}
+
+	switch c.kind {
+	case kindUint8, kindInt8, kindBool:
+		return 1
+	case kindUint16, kindInt16:
+		return 2
+	case kindUint32, kindInt32:
+		return 4
+	case kindUint64, kindInt64:
+		return 8
+	case kindUint128:
+		return 16
+	case kindByteArray:
+		return c.typ.Len()
+	case kindArray:
+		return c.typ.Len() * c.elem.fixedSize
+	case kindStruct:
+		// Recursive types refer to a struct that is still being compiled, which has no fixed size yet
+		size := 0
+		for i := range c.fields {
+			f := &c.fields[i]
+			if f.err != nil || f.codec.fixedSize == 0 {
+				return 0
+			}
+			size += f.codec.fixedSize
+		}
+		return size
+	}
+
+	return 0
+}
+
+// compileElement builds the codec for a slice or array item
+// Items can't have tags, so pointer types are always treated as optional items (List[Optional[T]])
+func compileElement(t reflect.Type, inProgress map[reflect.Type]*codec) *codec {
+	if t.Kind() == reflect.Ptr {
+		return &codec{kind: kindOptional, typ: t, elem: compile(t.Elem(), inProgress)}
+	}
+
+	return compile(t, inProgress)
+}
+
+// compileFields parses the tags for every field of the struct
+func compileFields(c *codec, inProgress map[reflect.Type]*codec) {
+	for i := 0; i < c.typ.NumField(); i++ {
+		structField := c.typ.Field(i)
+
+		var tag string
+		var tagPresent bool
+		if tag, tagPresent = structField.Tag.Lookup(tagName); !tagPresent {
+			// Not part of the streamable encoding
+			continue
+		}
+
+		f := fieldPlan{
+			name:  structField.Name,
+			index: i,
+		}
+
+		var err error
+		f.opts, err = ParseTag(tag)
+		if err != nil {
+			f.err = fmt.Errorf("field %s has an invalid streamable tag: %w", structField.Name, err)
+			f.codec = &codec{kind: kindUnsupported, typ: structField.Type}
+			c.fields = append(c.fields, f)
+			continue
+		}
+		if f.opts.Skip {
+			continue
+		}
+
+		f.jsonName = f.opts.Name
+		if f.jsonName == "" {
+			f.jsonName = snakeCase(structField.Name)
+		}
+
+		f.codec, err = compileField(structField.Type, f.opts, inProgress)
+		if err != nil {
+			f.err = fmt.Errorf("field %s: %w", structField.Name, err)
+		}
+
+		c.fields = append(c.fields, f)
+	}
+}
+
+// compileField builds the codec for a struct field, based on its tag options
+// When the options can't work with the type, an error is returned along with an unsupported codec
+func compileField(t reflect.Type, opts TagOptions, inProgress map[reflect.Type]*codec) (*codec, error) {
+	unsupported := &codec{kind: kindUnsupported, typ: t}
+
+	if opts.Tuple {
+		if err := checkTuple(t); err != nil {
+			return unsupported, err
+		}
+	}
+
+	if t.Kind() != reflect.Ptr {
+		if opts.Optional {
+			return unsupported, fmt.Errorf("optional fields must be pointer types")
+		}
+
+		return compileValue(t, opts, inProgress)
+	}
+
+	// Pointers that aren't optional are just encoded as the value being pointed to
+	kind := kindPointer
+	if opts.Optional {
+		kind = kindOptional
+	}
+
+	elem, err := compileValue(t.Elem(), opts, inProgress)
+	if err != nil {
+		return unsupported, err
+	}
+
+	c := &codec{kind: kind, typ: t, elem: elem}
+	c.fixedSize = c.computeFixedSize()
+
+	return c, nil
+}
+
+// compileValue builds the codec for the value of a field, after any optional or pointer has been removed
+func compileValue(t reflect.Type, opts TagOptions, inProgress map[reflect.Type]*codec) (*codec, error) {
+	if opts.Len == 0 {
+		return compile(t, inProgress), nil
+	}
+
+	if t.Kind() != reflect.Slice {
+		return &codec{kind: kindUnsupported, typ: t}, fmt.Errorf("len can only be used with slices, got %s", t.String())
+	}
+
+	// Fixed length lists are encoded with their own codec, so that custom encodings of the slice type don't apply
+	list := compile(t, inProgress)
+
+	return &codec{kind: list.kind, typ: t, elem: list.elem, length: opts.Len}, nil
+}
+
+// validationError returns the first problem with the plan, which is checked once per type
+func (c *codec) validationError() error {
+	c.validateOnce.Do(func() {
+		c.invalid = c.validate(c.typ.String(), map[*codec]bool{})
+	})
+
+	return c.invalid
+}
+
+// validate walks the plan and returns the first field that can't be encoded, along with the path to it
+func (c *codec) validate(path string, seen map[*codec]bool) error {
+	if seen[c] {
+		return nil
+	}
+	seen[c] = true
+
+	// Hand written custom encodings are trusted to handle everything themselves
+	// Generated code follows the same rules as the reflective path, so those types are still checked
+	if c.hasCustom() && !c.generated {
+		return nil
+	}
+
+	switch c.kind {
+	case kindUnsupported:
+		return fmt.Errorf("%s: unsupported type %s", path, c.typ.String())
+	case kindSlice, kindArray, kindOptional, kindPointer:
+		return c.elem.validate(path, seen)
+	case kindStruct:
+		for i := range c.fields {
+			f := &c.fields[i]
+			if f.err != nil {
+				return fmt.Errorf("%s: %w", path, f.err)
+			}
+
+			err := f.codec.validate(path+"."+f.name, seen)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkLength returns an error if a fixed length list (len=N) doesn't have exactly the right number of items
+func (c *codec) checkLength(numItems int) error {
+	if c.length != 0 && numItems != c.length {
+		return fmt.Errorf("fixed length list must have exactly %d items, got %d", c.length, numItems)
+	}
+
+	return nil
+}
+
+// hasCustom returns true if the type implements any of the custom encoding interfaces
+func (c *codec) hasCustom() bool {
+	return c.appender != customNone || c.marshaler != customNone || c.unmarshaler
+}
+
+// Validate checks that every streamable field of the struct type t (or pointer to struct) can be encoded,
+// including the fields of nested structs, list items, and optionals
+// This reports malformed tags, optionals that aren't pointers, and unsupported types up front, instead of partway
+// through encoding or decoding a value. Marshal and Unmarshal perform the same check before using a type.
+func Validate(t reflect.Type) error {
+	if t == nil {
+		return fmt.Errorf("streamable can't validate nil type")
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	c := codecFor(t)
+	if c.kind != kindStruct && !c.hasCustom() {
+		return fmt.Errorf("streamable types must be structs, got %s", t.String())
+	}
+
+	return c.validationError()
+}
+
+// compileCustom records which custom encoding interfaces the type implements
+func compileCustom(c *codec) {
+	ptr := reflect.PtrTo(c.typ)
+
+	switch {
+	case c.typ.Implements(appenderType):
+		c.appender = customValue
+	case ptr.Implements(appenderType):
+		c.appender = customPointer
+	}
+
+	switch {
+	case c.typ.Implements(marshalerType):
+		c.marshaler = customValue
+	case ptr.Implements(marshalerType):
+		c.marshaler = customPointer
+	}
+
+	switch {
+	case c.typ.Implements(sizerType):
+		c.sizer = customValue
+	case ptr.Implements(sizerType):
+		c.sizer = customPointer
+	}
+
+	c.unmarshaler = ptr.Implements(unmarshalerType)
+	c.generated = c.appender != customNone
+}
@@ -0,0 +1,138 @@
+package streamable
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Size returns the number of bytes Marshal would produce for v, without encoding it
+// This can be used to check a message against a size limit, or to allocate a buffer of the right size up front.
+// Nothing is allocated, apart from custom types that don't implement Sizer, which have to be marshalled to be measured
+func Size(v interface{}) (int, error) {
+	tv := reflect.Indirect(reflect.ValueOf(v))
+	if !tv.IsValid() {
+		return 0, fmt.Errorf("streamable can't marshal nil")
+	}
+
+	// Must match MarshalAppend, which uses custom methods with pointer receivers on values too
+	tv = addressable(tv)
+	c := codecFor(tv.Type())
+
+	size, custom, err := sizeCustom(c, tv)
+	if custom {
+		return size, err
+	}
+
+	if c.kind != kindStruct {
+		return 0, fmt.Errorf("streamable can't marshal a non-struct type")
+	}
+
+	err = c.validationError()
+	if err != nil {
+		return 0, err
+	}
+
+	return sizeValue(c, tv)
+}
+
+// sizeValue returns the encoded size of a value of any supported type, including optionals and pointers
+func sizeValue(c *codec, v reflect.Value) (int, error) {
+	if c.fixedSize > 0 {
+		return c.fixedSize, nil
+	}
+
+	size, custom, err := sizeCustom(c, v)
+	if custom {
+		return size, err
+	}
+
+	switch c.kind {
+	case kindOptional:
+		if v.IsNil() {
+			return 1, nil
+		}
+
+		size, err = sizeValue(c.elem, v.Elem())
+		return 1 + size, err
+	case kindPointer:
+		if v.IsNil() {
+			return 0, fmt.Errorf("pointer is nil, but the field isn't optional")
+		}
+
+		return sizeValue(c.elem, v.Elem())
+	case kindString:
+		return 4 + v.Len(), nil
+	case kindBytes, kindSlice, kindArray:
+		return sizeList(c, v)
+	case kindStruct:
+		for i := range c.fields {
+			f := &c.fields[i]
+			if f.err != nil {
+				return 0, f.err
+			}
+
+			fieldSize, err := sizeValue(f.codec, v.Field(f.index))
+			if err != nil {
+				return 0, err
+			}
+			size += fieldSize
+		}
+
+		return size, nil
+	}
+
+	return 0, fmt.Errorf("unimplemented type %s", c.typ.String())
+}
+
+// sizeList returns the encoded size of a slice or an array, including the length prefix if it has one
+func sizeList(c *codec, v reflect.Value) (int, error) {
+	size := 0
+	if c.kind == kindBytes || c.kind == kindSlice {
+		if c.length == 0 {
+			size = 4
+		} else if err := c.checkLength(v.Len()); err != nil {
+			return 0, err
+		}
+	}
+
+	if c.kind == kindBytes {
+		return size + v.Len(), nil
+	}
+
+	// Lists of fixed size items don't need to look at the items at all
+	if c.elem.fixedSize > 0 {
+		return size + v.Len()*c.elem.fixedSize, nil
+	}
+
+	for j := 0; j < v.Len(); j++ {
+		itemSize, err := sizeValue(c.elem, v.Index(j))
+		if err != nil {
+			return 0, err
+		}
+		size += itemSize
+	}
+
+	return size, nil
+}
+
+// sizeCustom returns the size of types with their own encoding, using StreamableSize when available
+// Generated code follows the same rules as the reflective path, so generated types are measured field by field instead
+// The returned bool indicates if the value has a custom encoding
+func sizeCustom(c *codec, v reflect.Value) (int, bool, error) {
+	if c.generated || (c.appender == customNone && c.marshaler == customNone) {
+		return 0, false, nil
+	}
+
+	if c.sizer != customNone {
+		// The pointer method set includes value methods too, and avoids copying the value into an interface
+		if v.CanAddr() {
+			return v.Addr().Interface().(Sizer).StreamableSize(), true, nil
+		}
+		if c.sizer == customValue {
+			return v.Interface().(Sizer).StreamableSize(), true, nil
+		}
+	}
+
+	customBytes, custom, err := marshalCustom(nil, c, v)
+	return len(customBytes), custom, err
+}
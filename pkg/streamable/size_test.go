@@ -0,0 +1,71 @@
+package streamable_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+func TestSize(t *testing.T) {
+	for _, v := range []interface{}{
+		benchHandshake(),
+		benchRespondPeers(),
+		benchLargeBlock(),
+		fullEverything(),
+		emptyEverything(),
+		&protocols.Message{ProtocolMessageType: protocols.ProtocolMessageTypeHandshake, ID: util.PtrUint16(5), Data: []byte{1, 2}},
+		protocols.RequestPeers{},
+		&Fixed{Data: []byte{1, 2, 3, 4}, Hashes: make([]types.Bytes32, 2), Optional: &[]uint16{1, 2}},
+		&Recursive{Value: 1, Children: []Recursive{{Value: 2}, {Value: 3, Children: []Recursive{{}}}}},
+		&Custom{Name: "abc", Flags: Bits{true}, Names: []CString{"d", "e"}},
+		&NonOptionalPointers{Value: util.PtrUint32(3), Inner: &Inner{Name: "c"}},
+	} {
+		encodedBytes, err := streamable.Marshal(v)
+		assert.NoError(t, err)
+
+		size, err := streamable.Size(v)
+		assert.NoError(t, err)
+		assert.Equal(t, len(encodedBytes), size, "%T", v)
+	}
+}
+
+func TestSize_Errors(t *testing.T) {
+	_, err := streamable.Size(nil)
+	assert.Error(t, err)
+
+	_, err = streamable.Size(&Typo{})
+	assert.Error(t, err)
+
+	_, err = streamable.Size(uint32(5))
+	assert.Error(t, err)
+
+	// Same error as Marshal for fixed length lists with the wrong number of items
+	_, err = streamable.Size(&Fixed{Data: []byte{1, 2, 3, 4}, Hashes: make([]types.Bytes32, 3)})
+	assert.EqualError(t, err, "fixed length list must have exactly 2 items, got 3")
+
+	// Same error as Marshal for nil pointers that aren't optional, even when the pointer is to a fixed size value
+	_, err = streamable.Size(&NonOptionalPointers{Inner: &Inner{}})
+	assert.EqualError(t, err, "pointer is nil, but the field isn't optional")
+
+	_, err = streamable.Size(&NonOptionalPointers{Value: util.PtrUint32(3)})
+	assert.EqualError(t, err, "pointer is nil, but the field isn't optional")
+}
+
+func TestSize_NoAllocations(t *testing.T) {
+	for _, v := range []interface{}{
+		benchHandshake(),
+		benchRespondPeers(),
+		benchLargeBlock(),
+		fullEverything(),
+	} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = streamable.Size(v)
+		})
+		assert.Equal(t, float64(0), allocs, "%T", v)
+	}
+}
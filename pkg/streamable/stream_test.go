@@ -0,0 +1,123 @@
+package streamable_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+func TestDecoder_MultipleMessages(t *testing.T) {
+	var stream []byte
+	for _, encodedHex := range []string{encodedHex1, encodedHex2, encodedHexHandshake} {
+		encodedBytes, err := hex.DecodeString(encodedHex)
+		assert.NoError(t, err)
+		stream = append(stream, encodedBytes...)
+	}
+
+	// OneByteReader makes sure we don't rely on getting everything we ask for in a single read
+	dec := streamable.NewDecoder(iotest.OneByteReader(bytes.NewReader(stream)))
+
+	msg1 := &protocols.Message{}
+	err := dec.Decode(msg1)
+	assert.NoError(t, err)
+	assert.Nil(t, msg1.ID)
+	assert.Equal(t, []byte("This is a sample message to decode"), msg1.Data)
+
+	msg2 := &protocols.Message{}
+	err = dec.Decode(msg2)
+	assert.NoError(t, err)
+	assert.Equal(t, util.PtrUint16(35256), msg2.ID)
+	assert.Equal(t, []byte("This is a sample message to decode"), msg2.Data)
+
+	msg3 := &protocols.Message{}
+	err = dec.Decode(msg3)
+	assert.NoError(t, err)
+
+	handshake := &protocols.Handshake{}
+	err = streamable.NewDecoder(bytes.NewReader(msg3.Data)).Decode(handshake)
+	assert.NoError(t, err)
+	assert.Equal(t, "mainnet", handshake.NetworkID)
+	assert.Len(t, handshake.Capabilities, 1)
+
+	// Nothing left in the stream
+	err = dec.Decode(&protocols.Message{})
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDecoder_Truncated(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHex1)
+	assert.NoError(t, err)
+
+	dec := streamable.NewDecoder(bytes.NewReader(encodedBytes[:len(encodedBytes)-1]))
+	err = dec.Decode(&protocols.Message{})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.EqualError(t, err, "streamable: decoding Data at offset 6: need 34 bytes, only 33 remaining")
+}
+
+func TestDecoder_Buffered(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHex1)
+	assert.NoError(t, err)
+
+	trailing := []byte("trailing data")
+	dec := streamable.NewDecoder(bytes.NewReader(append(encodedBytes, trailing...)))
+
+	err = dec.Decode(&protocols.Message{})
+	assert.NoError(t, err)
+
+	remaining, err := io.ReadAll(dec.Buffered())
+	assert.NoError(t, err)
+	assert.Equal(t, trailing, remaining)
+}
+
+func TestEncoder(t *testing.T) {
+	msg1 := &protocols.Message{
+		ProtocolMessageType: protocols.ProtocolMessageTypeHandshake,
+		Data:                []byte("This is a sample message to decode"),
+	}
+	msg2 := &protocols.Message{
+		ProtocolMessageType: protocols.ProtocolMessageTypeHandshake,
+		ID:                  util.PtrUint16(35256),
+		Data:                []byte("This is a sample message to decode"),
+	}
+
+	buf := &bytes.Buffer{}
+	enc := streamable.NewEncoder(buf)
+	assert.NoError(t, enc.Encode(msg1))
+	assert.NoError(t, enc.Encode(msg2))
+
+	assert.Equal(t, encodedHex1+encodedHex2, hex.EncodeToString(buf.Bytes()))
+
+	// Errors don't write anything
+	assert.Error(t, enc.Encode(nil))
+	assert.Error(t, enc.Encode(uint8(1)))
+	assert.Equal(t, encodedHex1+encodedHex2, hex.EncodeToString(buf.Bytes()))
+}
+
+func TestEncoder_Decoder_RoundTrip(t *testing.T) {
+	r, w := io.Pipe()
+
+	l := &Lists{
+		Strings:   []string{"a", "b"},
+		Optionals: []*uint32{nil, util.PtrUint32(1)},
+	}
+
+	go func() {
+		enc := streamable.NewEncoder(w)
+		_ = enc.Encode(l)
+		_ = w.Close()
+	}()
+
+	decoded := &Lists{}
+	err := streamable.NewDecoder(r).Decode(decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, l.Strings, decoded.Strings)
+	assert.Equal(t, l.Optionals, decoded.Optionals)
+}
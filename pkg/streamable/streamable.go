@@ -4,8 +4,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"reflect"
-	"strings"
 
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
 	"github.com/cmmarslender/go-chia-lib/pkg/util"
 )
 
@@ -18,11 +18,79 @@ const (
 	boolTrue  uint8 = 1
 )
 
+// uint128Type is used to identify fields that need the special uint128 handling
+var uint128Type = reflect.TypeOf(types.Uint128{})
+
+// checkTuple ensures that fields tagged as tuples are actually backed by a struct
+// Tuples (Tuple[uint16, str] in python) are represented by a struct with one field per tuple item,
+// and are encoded exactly the same as a nested streamable - each item, one after the other, with no prefix.
+// The tag may be on a struct, a pointer to a struct (Optional[Tuple[...]]), or a slice of structs (List[Tuple[...]])
+func checkTuple(t reflect.Type) error {
+	underlying := t
+	for underlying.Kind() == reflect.Ptr || underlying.Kind() == reflect.Slice {
+		underlying = underlying.Elem()
+	}
+
+	if underlying.Kind() != reflect.Struct {
+		return fmt.Errorf("tuple fields must be struct types, got %s", t.String())
+	}
+
+	return nil
+}
+
 // Unmarshal unmarshals a streamable type based on struct tags
 // Struct order is extremely important in this decoding. Ensure the order/types are identical
 // on both sides of the stream
+// Any bytes left over after decoding v are ignored. Use UnmarshalWithOptions in strict mode to reject them instead.
 // Ugly, but.. it works? So we can make it pretty later...
 func Unmarshal(bytes []byte, v interface{}) error {
+	return UnmarshalWithOptions(bytes, v, DecodeOptions{})
+}
+
+// UnmarshalWithOptions is the same as Unmarshal, with limits other than the defaults or strict mode
+func UnmarshalWithOptions(bytes []byte, v interface{}, opts DecodeOptions) error {
+	d := &decodeState{bytes: bytes, opts: opts.withDefaults()}
+
+	err := unmarshal(d, v)
+	if err != nil {
+		return err
+	}
+
+	if opts.Strict && len(d.bytes) > 0 {
+		return &TrailingBytesError{Offset: d.offset, Remaining: len(d.bytes)}
+	}
+
+	return nil
+}
+
+// UnmarshalAs unmarshals bytes into a new T, and returns a pointer to it
+// This is the same as Unmarshal, without having to create the value first or being able to pass a non-pointer
+func UnmarshalAs[T any](bytes []byte) (*T, error) {
+	v := new(T)
+
+	err := Unmarshal(bytes, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// UnmarshalPrefix unmarshals a single value from the start of bytes, and returns the bytes that come after it
+// This is for data that deliberately has several records one after the other. Returns nil bytes on error
+func UnmarshalPrefix(bytes []byte, v interface{}) ([]byte, error) {
+	d := &decodeState{bytes: bytes, opts: DecodeOptions{}.withDefaults()}
+
+	err := unmarshal(d, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.bytes, nil
+}
+
+// unmarshal validates v and then decodes into it, using whatever source of bytes the decodeState has
+func unmarshal(d *decodeState, v interface{}) error {
 	tv := reflect.ValueOf(v)
 	if tv.Kind() != reflect.Ptr || tv.IsNil() {
 		return &InvalidUnmarshalError{reflect.TypeOf(v)}
@@ -30,196 +98,395 @@ func Unmarshal(bytes []byte, v interface{}) error {
 
 	// Gets rid of the pointer
 	tv = reflect.Indirect(tv)
+	c := codecFor(tv.Type())
 
-	// Get the actual type
-	t := tv.Type()
+	// Types that know how to decode themselves don't need to be structs
+	start := d.offset
+	custom, err := unmarshalCustom(d, c, tv)
+	if custom {
+		return decodeError(err, start, c.typ)
+	}
 
-	if t.Kind() != reflect.Struct {
+	if c.kind != kindStruct {
 		return fmt.Errorf("streamable can't unmarshal into non-struct type")
 	}
 
-	bytes, err := unmarshalStruct(bytes, t, tv)
-	return err
+	// Problems with the type are reported before reading anything
+	err = c.validationError()
+	if err != nil {
+		return err
+	}
+
+	return unmarshalStruct(d, c, tv)
 }
 
-func unmarshalStruct(bytes []byte, t reflect.Type, tv reflect.Value) ([]byte, error) {
+func unmarshalStruct(d *decodeState, c *codec, tv reflect.Value) error {
 	var err error
 
-	// Iterate over all available fields and read the tag value
-	for i := 0; i < t.NumField(); i++ {
-		structField := t.Field(i)
-		fieldValue := tv.Field(i)
-		fieldType := fieldValue.Type()
+	// Iterate over the streamable fields, in order
+	for i := range c.fields {
+		f := &c.fields[i]
+		if f.err != nil {
+			return f.err
+		}
 
-		bytes, err = unmarshalField(bytes, fieldType, fieldValue, structField)
+		d.explain.push(f.name)
+		err = unmarshalField(d, f.codec, tv.Field(f.index))
 		if err != nil {
-			return bytes, err
+			return withPath(err, f.name)
 		}
+		d.explain.pop()
 	}
 
-	return bytes, nil
+	return nil
 }
 
-func unmarshalSlice(bytes []byte, t reflect.Type, v reflect.Value) ([]byte, error) {
+func unmarshalSlice(d *decodeState, c *codec, v reflect.Value) error {
 	var err error
 	var newVal []byte
 
 	// Slice/List is 4 byte prefix (number of items) and then serialization of each item
-	// Get 4 byte length prefix
-	var length []byte
-	length, bytes, err = util.ShiftNBytes(4, bytes)
-	numItems := binary.BigEndian.Uint32(length)
-
-	sliceKind := t.Elem().Kind()
-	switch sliceKind {
-	case reflect.Uint8: // same as byte
+	// Fixed length lists (len=N) have no prefix, since the number of items is part of the type
+	numItems := uint32(c.length)
+	if c.length == 0 {
+		start := d.offset
+		var length []byte
+		length, err = d.next(4)
+		if err != nil {
+			return err
+		}
+		numItems = binary.BigEndian.Uint32(length)
+		d.explain.prefix(d, start, numItems)
+	}
+
+	if !v.CanSet() {
+		return fmt.Errorf("field %s is not settable", v.String())
+	}
+
+	switch c.kind {
+	case kindBytes:
 		// In this case, numItems == numBytes, because its a uint8
-		newVal, bytes, err = util.ShiftNBytes(uint(numItems), bytes)
+		err = d.checkBytesLength(numItems)
 		if err != nil {
-			return bytes, err
+			return err
 		}
-		if !v.CanSet() {
-			return bytes, fmt.Errorf("field %s is not settable", v.String())
+		start := d.offset
+		newVal, err = d.NextBytes(numItems)
+		if err != nil {
+			return err
 		}
 
-		sliceReflect := reflect.MakeSlice(v.Type(), 0, 0)
-		for _, newValBytes := range newVal {
-			sliceReflect = reflect.Append(sliceReflect, reflect.ValueOf(newValBytes))
+		// SetBytes works for named byte types too, since they are still a slice of bytes underneath
+		v.SetBytes(newVal)
+		d.explain.value(d, start, c, v)
+	default:
+		// Everything else is just each item, one after the other
+		err = d.checkListLength(numItems, c.typ.Elem().Size())
+		if err != nil {
+			return err
 		}
-		v.Set(sliceReflect)
-	case reflect.Struct:
-		// Recursion, I guess
-		sliceReflect := reflect.MakeSlice(v.Type(), 0, 0)
+
+		// Items are decoded in place, growing the slice as we go. Only preallocate as many items as there could
+		// possibly be bytes for, so a large length with no data behind it doesn't allocate much
+		v.Set(reflect.MakeSlice(c.typ, 0, d.preallocate(numItems)))
 		for j := uint32(0); j < numItems; j++ {
-			newValue := reflect.Indirect(reflect.New(v.Type().Elem()))
-			bytes, err = unmarshalStruct(bytes, t.Elem(), newValue)
-			sliceReflect = reflect.Append(sliceReflect, newValue)
+			if v.Len() < v.Cap() {
+				v.SetLen(v.Len() + 1)
+			} else {
+				v.Set(reflect.Append(v, reflect.Zero(c.typ.Elem())))
+			}
+
+			d.explain.pushIndex(int(j))
+			err = unmarshalField(d, c.elem, v.Index(int(j)))
+			if err != nil {
+				return withPath(err, fmt.Sprintf("[%d]", j))
+			}
+			d.explain.pop()
 		}
-		v.Set(sliceReflect)
-	default:
-		return bytes, fmt.Errorf("encountered type inside slice that is not implemented")
 	}
 
-	return bytes, nil
+	return nil
 }
 
-func unmarshalField(bytes []byte, fieldType reflect.Type, fieldValue reflect.Value, structField reflect.StructField) ([]byte, error) {
-	var tag string
-	var tagPresent bool
-	if tag, tagPresent = structField.Tag.Lookup(tagName); !tagPresent {
-		// Continuing because the tag isn't present
-		return bytes, nil
-	}
-
+func unmarshalArray(d *decodeState, c *codec, v reflect.Value) error {
 	var err error
 	var newVal []byte
 
-	// If optional, should be one byte bool that indicates if its present or not
-	// This is the hackiest of hacky ways to check if this is ACTUALLY optional
-	// @TODO one day need to actually parse these options out properly
-	if strings.Contains(tag, "optional") {
-		if fieldValue.Kind() != reflect.Ptr {
-			return nil, fmt.Errorf("optional fields must be pointer types")
+	if !v.CanSet() {
+		return fmt.Errorf("field %s is not settable", v.String())
+	}
+
+	// Fixed size arrays (bytes32, G1Element, etc) have no length prefix
+	// The length is implied by the type, so the raw bytes just follow
+	switch c.kind {
+	case kindByteArray:
+		start := d.offset
+		newVal, err = d.next(uint(c.typ.Len()))
+		if err != nil {
+			return err
+		}
+		reflect.Copy(v, reflect.ValueOf(newVal))
+		d.explain.value(d, start, c, v)
+	default:
+		for j := 0; j < c.typ.Len(); j++ {
+			d.explain.pushIndex(j)
+			err = unmarshalField(d, c.elem, v.Index(j))
+			if err != nil {
+				return withPath(err, fmt.Sprintf("[%d]", j))
+			}
+			d.explain.pop()
 		}
+	}
+
+	return nil
+}
 
-		// Its optional, check if we have actual data
-		var presentFlag []byte
-		presentFlag, bytes, err = util.ShiftNBytes(1, bytes)
-		if presentFlag[0] == boolFalse {
-			// Not present in the data, continue
-			return bytes, nil
+// unmarshalField unmarshals a struct field, or a single item of a slice or array
+// Handles the optional presence byte and pointer allocation, then hands off to unmarshalValue
+// Any error is returned as a *DecodeError, located at the start of the field if it doesn't have a more specific offset
+func unmarshalField(d *decodeState, c *codec, fieldValue reflect.Value) error {
+	start := d.offset
+
+	err := d.enter()
+	if err == nil {
+		err = unmarshalPointer(d, c, fieldValue)
+	}
+	d.leave()
+
+	return decodeError(err, start, c.typ)
+}
+
+// unmarshalPointer handles optionals and pointers, which need the value to be allocated before it can be decoded
+func unmarshalPointer(d *decodeState, c *codec, fieldValue reflect.Value) error {
+	switch c.kind {
+	case kindOptional:
+		// If optional, should be one byte bool that indicates if its present or not
+		start := d.offset
+		presentFlag, err := d.next(1)
+		if err != nil {
+			return err
 		}
+		d.explain.presence(d, start, presentFlag[0])
+		switch presentFlag[0] {
+		case boolFalse:
+			// Not present in the data
+			fieldValue.Set(reflect.Zero(c.typ))
+			return nil
+		case boolTrue:
+		default:
+			// Chia rejects anything other than 0 or 1, same as bools
+			return fmt.Errorf("invalid value for optional presence byte %d", presentFlag[0])
+		}
+	case kindPointer:
+	default:
+		return unmarshalValue(d, c, fieldValue)
 	}
 
-	if fieldType.Kind() == reflect.Ptr {
-		fieldType = fieldType.Elem()
+	// Need to init the pointer to something non-nil before using it
+	err := d.allocate(uint64(c.typ.Elem().Size()))
+	if err != nil {
+		return err
+	}
+	fieldValue.Set(reflect.New(c.typ.Elem()))
+
+	return unmarshalValue(d, c.elem, fieldValue.Elem())
+}
+
+// unmarshalValue unmarshals a value of any supported type into v
+// Optional handling has already happened by the time we get here
+func unmarshalValue(d *decodeState, c *codec, fieldValue reflect.Value) error {
+	var err error
+	var newVal []byte
+	start := d.offset
+
+	// Types that know how to decode themselves take priority over everything else
+	var custom bool
+	custom, err = unmarshalCustom(d, c, fieldValue)
+	if custom {
+		return err
+	}
 
-		// Need to init the field to something non-nil before using it
-		fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
-		fieldValue = fieldValue.Elem()
+	if !fieldValue.CanSet() {
+		return fmt.Errorf("field %s is not settable", fieldValue.String())
 	}
 
-	switch kind := fieldType.Kind(); kind {
-	case reflect.Uint8:
-		newVal, bytes, err = util.ShiftNBytes(1, bytes)
+	switch c.kind {
+	case kindUint128:
+		newVal, err = d.next(16)
 		if err != nil {
-			return bytes, err
+			return err
 		}
-		if !fieldValue.CanSet() {
-			return bytes, fmt.Errorf("field %s is not settable", fieldValue.String())
+		var u types.Uint128
+		u, err = types.BytesToUint128(newVal)
+		if err != nil {
+			return err
+		}
+		fieldValue.Set(reflect.ValueOf(u))
+	case kindUint8:
+		newVal, err = d.next(1)
+		if err != nil {
+			return err
 		}
 		fieldValue.SetUint(uint64(util.BytesToUint8(newVal)))
-	case reflect.Uint16:
-		newVal, bytes, err = util.ShiftNBytes(2, bytes)
+	case kindUint16:
+		newVal, err = d.next(2)
+		if err != nil {
+			return err
+		}
+		fieldValue.SetUint(uint64(util.BytesToUint16(newVal)))
+	case kindUint32:
+		newVal, err = d.next(4)
+		if err != nil {
+			return err
+		}
+		fieldValue.SetUint(uint64(util.BytesToUint32(newVal)))
+	case kindUint64:
+		newVal, err = d.next(8)
+		if err != nil {
+			return err
+		}
+		fieldValue.SetUint(util.BytesToUint64(newVal))
+	case kindInt8:
+		newVal, err = d.next(1)
 		if err != nil {
-			return bytes, err
+			return err
 		}
-		if !fieldValue.CanSet() {
-			return bytes, fmt.Errorf("field %s is not settable", fieldValue.String())
+		fieldValue.SetInt(int64(int8(util.BytesToUint8(newVal))))
+	case kindInt16:
+		newVal, err = d.next(2)
+		if err != nil {
+			return err
 		}
-		newInt := util.BytesToUint16(newVal)
-		fieldValue.SetUint(uint64(newInt))
-	case reflect.Uint64:
-		newVal, bytes, err = util.ShiftNBytes(8, bytes)
+		fieldValue.SetInt(int64(int16(util.BytesToUint16(newVal))))
+	case kindInt32:
+		newVal, err = d.next(4)
 		if err != nil {
-			return bytes, err
+			return err
 		}
-		if !fieldValue.CanSet() {
-			return bytes, fmt.Errorf("field %s is not settable", fieldValue.String())
+		fieldValue.SetInt(int64(int32(util.BytesToUint32(newVal))))
+	case kindInt64:
+		newVal, err = d.next(8)
+		if err != nil {
+			return err
 		}
-		newInt := util.BytesToUint64(newVal)
-		fieldValue.SetUint(newInt)
-	case reflect.Slice:
-		bytes, err = unmarshalSlice(bytes, fieldType, fieldValue)
+		fieldValue.SetInt(int64(util.BytesToUint64(newVal)))
+	case kindBool:
+		newVal, err = d.next(1)
 		if err != nil {
-			return bytes, err
+			return err
+		}
+		// Chia rejects anything other than 0 or 1 for bools, so we do too
+		switch newVal[0] {
+		case boolFalse:
+			fieldValue.SetBool(false)
+		case boolTrue:
+			fieldValue.SetBool(true)
+		default:
+			return fmt.Errorf("invalid value for bool %d", newVal[0])
 		}
-	case reflect.String:
+	case kindBytes, kindSlice:
+		return unmarshalSlice(d, c, fieldValue)
+	case kindByteArray, kindArray:
+		return unmarshalArray(d, c, fieldValue)
+	case kindStruct:
+		// Nested streamable or tuple - both are just the fields, one after the other
+		return unmarshalStruct(d, c, fieldValue)
+	case kindString:
 		// 4 byte size prefix, then []byte which can be converted to utf-8 string
 		// Get 4 byte length prefix
 		var length []byte
-		length, bytes, err = util.ShiftNBytes(4, bytes)
+		length, err = d.next(4)
+		if err != nil {
+			return err
+		}
 		numBytes := binary.BigEndian.Uint32(length)
+		d.explain.prefix(d, start, numBytes)
+		err = d.checkBytesLength(numBytes)
+		if err != nil {
+			return err
+		}
+
+		start = d.offset
 
 		var strBytes []byte
-		strBytes, bytes, err = util.ShiftNBytes(uint(numBytes), bytes)
+		strBytes, err = d.next(uint(numBytes))
+		if err != nil {
+			return err
+		}
 		fieldValue.SetString(string(strBytes))
 	default:
-		return bytes, fmt.Errorf("unimplemented type %s", fieldValue.Kind())
+		return fmt.Errorf("unimplemented type %s", fieldValue.Kind())
 	}
 
-	return bytes, nil
+	// Only single values make it this far, lists and structs have already returned
+	d.explain.value(d, start, c, fieldValue)
+
+	return nil
 }
 
 // Marshal marshals the item into the streamable byte format
 func Marshal(v interface{}) ([]byte, error) {
+	return MarshalAppend(nil, v)
+}
+
+// addressable returns v if it is addressable, or otherwise an addressable copy of it
+// Values passed by value aren't addressable, so without this, methods with pointer receivers would be skipped
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+
+	return ptr.Elem()
+}
+
+// MarshalAppend appends the streamable encoding of v to dst and returns the extended buffer
+// This allows callers to reuse a buffer between values and avoid an allocation for every call
+func MarshalAppend(dst []byte, v interface{}) ([]byte, error) {
 	// Doesn't matter if a pointer or not for marshalling, so
 	// we just call this and let it deal with ptr or not ptr
 	tv := reflect.Indirect(reflect.ValueOf(v))
 
-	// Get the actual type
-	t := tv.Type()
+	if !tv.IsValid() {
+		return nil, fmt.Errorf("streamable can't marshal nil")
+	}
+
+	// Custom methods with pointer receivers are used whether or not v was passed as a pointer
+	tv = addressable(tv)
+	c := codecFor(tv.Type())
+
+	// Types that know how to encode themselves don't need to be structs
+	finalBytes, custom, err := marshalCustom(dst, c, tv)
+	if custom {
+		return finalBytes, err
+	}
 
-	if t.Kind() != reflect.Struct {
+	if c.kind != kindStruct {
 		return nil, fmt.Errorf("streamable can't marshal a non-struct type")
 	}
 
-	// This will become the final encoded data
-	var finalBytes []byte
+	// Problems with the type are reported before encoding anything
+	err = c.validationError()
+	if err != nil {
+		return nil, err
+	}
 
-	return marshalStruct(finalBytes, t, tv)
+	return marshalStruct(finalBytes, c, tv)
 }
 
-func marshalStruct(finalBytes []byte, t reflect.Type, tv reflect.Value) ([]byte, error) {
+func marshalStruct(finalBytes []byte, c *codec, tv reflect.Value) ([]byte, error) {
 	var err error
 
-	// Iterate over all available fields in the type and encode to bytes
-	for i := 0; i < t.NumField(); i++ {
-		structField := t.Field(i)
-		fieldValue := tv.Field(i)
-		fieldType := fieldValue.Type()
+	// Iterate over the streamable fields, in order, and encode to bytes
+	for i := range c.fields {
+		f := &c.fields[i]
+		if f.err != nil {
+			return finalBytes, f.err
+		}
 
-		finalBytes, err = marshalField(finalBytes, fieldType, fieldValue, structField)
+		finalBytes, err = marshalField(finalBytes, f.codec, tv.Field(f.index))
 		if err != nil {
 			return finalBytes, err
 		}
@@ -228,24 +495,24 @@ func marshalStruct(finalBytes []byte, t reflect.Type, tv reflect.Value) ([]byte,
 	return finalBytes, nil
 }
 
-func marshalSlice(finalBytes []byte, t reflect.Type, v reflect.Value) ([]byte, error) {
+func marshalSlice(finalBytes []byte, c *codec, v reflect.Value) ([]byte, error) {
 	var err error
 
 	// Slice/List is 4 byte prefix (number of items) and then serialization of each item
-	// Get 4 byte length prefix
-	numItems := uint32(v.Len())
-	finalBytes = append(finalBytes, util.Uint32ToBytes(numItems)...)
+	// Fixed length lists (len=N) have no prefix, but must have exactly the right number of items
+	if c.length == 0 {
+		finalBytes = append(finalBytes, util.Uint32ToBytes(uint32(v.Len()))...)
+	} else if err = c.checkLength(v.Len()); err != nil {
+		return finalBytes, err
+	}
 
-	sliceKind := t.Elem().Kind()
-	switch sliceKind {
-	case reflect.Uint8: // same as byte
+	switch c.kind {
+	case kindBytes:
 		// This is the easy case - already a slice of bytes
 		finalBytes = append(finalBytes, v.Bytes()...)
-	case reflect.Struct:
+	default:
 		for j := 0; j < v.Len(); j++ {
-			currentStruct := v.Index(j)
-
-			finalBytes, err = marshalStruct(finalBytes, currentStruct.Type(), currentStruct)
+			finalBytes, err = marshalField(finalBytes, c.elem, v.Index(j))
 			if err != nil {
 				return finalBytes, err
 			}
@@ -255,60 +522,99 @@ func marshalSlice(finalBytes []byte, t reflect.Type, v reflect.Value) ([]byte, e
 	return finalBytes, nil
 }
 
-func marshalField(finalBytes []byte, fieldType reflect.Type, fieldValue reflect.Value, structField reflect.StructField) ([]byte, error) {
+func marshalArray(finalBytes []byte, c *codec, v reflect.Value) ([]byte, error) {
 	var err error
 
-	var tag string
-	var tagPresent bool
-	if tag, tagPresent = structField.Tag.Lookup(tagName); !tagPresent {
-		// Continuing because the tag isn't present
-		return finalBytes, nil
+	// Fixed size arrays are written as-is, with no length prefix
+	switch c.kind {
+	case kindByteArray:
+		// Grow the output and copy the array straight into it. Slicing the array would allocate a new slice header
+		// for every value, which adds up quickly for lists of hashes and keys
+		start := len(finalBytes)
+		finalBytes = append(finalBytes, make([]byte, v.Len())...)
+		reflect.Copy(reflect.ValueOf(finalBytes[start:]), v)
+	default:
+		for j := 0; j < v.Len(); j++ {
+			finalBytes, err = marshalField(finalBytes, c.elem, v.Index(j))
+			if err != nil {
+				return finalBytes, err
+			}
+		}
 	}
 
-	// If optional, the type MUST be a pointer type
-	// nil pointer will be assumed to be not present, and we'll insert 0x00 and move on
-	// Anything other than nil pointer we'll insert 0x01 and encode the value
-	// This is the hackiest of hacky ways to check if this is ACTUALLY optional
-	// @TODO one day need to actually parse these options out properly
-	if strings.Contains(tag, "optional") {
-		if fieldValue.Kind() != reflect.Ptr {
-			return nil, fmt.Errorf("optional fields must be pointer types")
-		}
+	return finalBytes, nil
+}
 
-		// Its optional, check if we have actual data
+// marshalField marshals a struct field, or a single item of a slice or array
+// Handles the optional presence byte and pointers, then hands off to marshalValue
+func marshalField(finalBytes []byte, c *codec, fieldValue reflect.Value) ([]byte, error) {
+	switch c.kind {
+	case kindOptional:
+		// nil pointer will be assumed to be not present, and we'll insert 0x00 and move on
+		// Anything other than nil pointer we'll insert 0x01 and encode the value
 		if fieldValue.IsNil() {
-			// Field is nil, insert `false` byte and continue
-			finalBytes = append(finalBytes, boolFalse)
-			return finalBytes, nil
+			return append(finalBytes, boolFalse), nil
 		}
 
 		finalBytes = append(finalBytes, boolTrue)
+	case kindPointer:
+		if fieldValue.IsNil() {
+			return finalBytes, fmt.Errorf("pointer is nil, but the field isn't optional")
+		}
+	default:
+		return marshalValue(finalBytes, c, fieldValue)
 	}
 
-	// If field is still a pointer, get rid of that now that we're past the optional checking
-	fieldValue = reflect.Indirect(fieldValue)
+	// Get rid of the pointer now that we're past the optional checking
+	return marshalValue(finalBytes, c.elem, reflect.Indirect(fieldValue))
+}
+
+// marshalValue marshals a value of any supported type
+// Optional handling has already happened by the time we get here
+func marshalValue(finalBytes []byte, c *codec, fieldValue reflect.Value) ([]byte, error) {
+	// Types that know how to encode themselves take priority over everything else
+	finalBytes, custom, err := marshalCustom(finalBytes, c, fieldValue)
+	if custom {
+		return finalBytes, err
+	}
 
-	switch fieldValue.Kind() {
-	case reflect.Uint8:
-		newInt := uint8(fieldValue.Uint())
-		finalBytes = append(finalBytes, newInt)
-	case reflect.Uint16:
-		newInt := uint16(fieldValue.Uint())
-		finalBytes = append(finalBytes, util.Uint16ToBytes(newInt)...)
-	case reflect.Uint64:
+	switch c.kind {
+	case kindUint128:
+		finalBytes = append(finalBytes, fieldValue.Interface().(types.Uint128).Bytes()...)
+	case kindUint8:
+		finalBytes = append(finalBytes, uint8(fieldValue.Uint()))
+	case kindUint16:
+		finalBytes = append(finalBytes, util.Uint16ToBytes(uint16(fieldValue.Uint()))...)
+	case kindUint32:
+		finalBytes = append(finalBytes, util.Uint32ToBytes(uint32(fieldValue.Uint()))...)
+	case kindUint64:
 		finalBytes = append(finalBytes, util.Uint64ToBytes(fieldValue.Uint())...)
-	case reflect.Slice:
-		finalBytes, err = marshalSlice(finalBytes, fieldType, fieldValue)
-		if err != nil {
-			return finalBytes, err
+	case kindInt8:
+		finalBytes = append(finalBytes, uint8(fieldValue.Int()))
+	case kindInt16:
+		finalBytes = append(finalBytes, util.Uint16ToBytes(uint16(fieldValue.Int()))...)
+	case kindInt32:
+		finalBytes = append(finalBytes, util.Uint32ToBytes(uint32(fieldValue.Int()))...)
+	case kindInt64:
+		finalBytes = append(finalBytes, util.Uint64ToBytes(uint64(fieldValue.Int()))...)
+	case kindBool:
+		if fieldValue.Bool() {
+			finalBytes = append(finalBytes, boolTrue)
+		} else {
+			finalBytes = append(finalBytes, boolFalse)
 		}
-	case reflect.String:
+	case kindBytes, kindSlice:
+		return marshalSlice(finalBytes, c, fieldValue)
+	case kindByteArray, kindArray:
+		return marshalArray(finalBytes, c, fieldValue)
+	case kindStruct:
+		// Nested streamable or tuple - both are just the fields, one after the other
+		return marshalStruct(finalBytes, c, fieldValue)
+	case kindString:
 		// Strings get converted to []byte with a 4 byte size prefix
-		strBytes := []byte(fieldValue.String())
-		numBytes := uint32(len(strBytes))
-		finalBytes = append(finalBytes, util.Uint32ToBytes(numBytes)...)
-
-		finalBytes = append(finalBytes, strBytes...)
+		str := fieldValue.String()
+		finalBytes = append(finalBytes, util.Uint32ToBytes(uint32(len(str)))...)
+		finalBytes = append(finalBytes, str...)
 	default:
 		return finalBytes, fmt.Errorf("unimplemented type %s", fieldValue.Kind())
 	}
@@ -1,13 +1,17 @@
 package streamable_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
 	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
 	"github.com/cmmarslender/go-chia-lib/pkg/util"
 )
 
@@ -221,3 +225,422 @@ func TestUnmarshal_Remarshal_Handshake(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, encodedBytes, reencodedBytes)
 }
+
+// FixedBytes has all the fixed size byte types that chia uses
+type FixedBytes struct {
+	Hash      types.Bytes32   `streamable:""`
+	Optional  *types.Bytes32  `streamable:"optional"`
+	PublicKey types.G1Element `streamable:""`
+	Signature types.G2Element `streamable:""`
+}
+
+func TestMarshal_FixedBytes(t *testing.T) {
+	fb := &FixedBytes{}
+	for i := range fb.Hash {
+		fb.Hash[i] = 0x11
+	}
+	for i := range fb.PublicKey {
+		fb.PublicKey[i] = 0x22
+	}
+	for i := range fb.Signature {
+		fb.Signature[i] = 0x33
+	}
+
+	// 32 bytes of 0x11, no length prefix
+	// 0x00 for the missing optional
+	// 48 bytes of 0x22, no length prefix
+	// 96 bytes of 0x33, no length prefix
+	expected := strings.Repeat("11", 32) + "00" + strings.Repeat("22", 48) + strings.Repeat("33", 96)
+
+	encodedBytes, err := streamable.Marshal(fb)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(encodedBytes))
+
+	// Non-pointer values must encode the same way
+	encodedBytes, err = streamable.Marshal(*fb)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(encodedBytes))
+}
+
+func TestUnmarshal_Remarshal_FixedBytes(t *testing.T) {
+	// 32 bytes of 0xaa
+	// 0x01 optional is present, followed by 32 bytes of 0xbb
+	// 48 bytes of 0xcc
+	// 96 bytes of 0xdd
+	encodedHex := strings.Repeat("aa", 32) + "01" + strings.Repeat("bb", 32) + strings.Repeat("cc", 48) + strings.Repeat("dd", 96)
+	encodedBytes, err := hex.DecodeString(encodedHex)
+	assert.NoError(t, err)
+
+	fb := &FixedBytes{}
+	err = streamable.Unmarshal(encodedBytes, fb)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("aa", 32), fb.Hash.String())
+	assert.NotNil(t, fb.Optional)
+	assert.Equal(t, strings.Repeat("bb", 32), fb.Optional.String())
+	assert.Equal(t, strings.Repeat("cc", 48), fb.PublicKey.String())
+	assert.Equal(t, strings.Repeat("dd", 96), fb.Signature.String())
+
+	reencodedBytes, err := streamable.Marshal(fb)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestUnmarshal_FixedBytesTooShort(t *testing.T) {
+	// Only 31 bytes available for the first bytes32
+	encodedBytes, err := hex.DecodeString(strings.Repeat("aa", 31))
+	assert.NoError(t, err)
+
+	fb := &FixedBytes{}
+	err = streamable.Unmarshal(encodedBytes, fb)
+	assert.Error(t, err)
+}
+
+// AllInts has every integer type chia uses, plus bool
+type AllInts struct {
+	U8      uint8          `streamable:""`
+	U16     uint16         `streamable:""`
+	U32     uint32         `streamable:""`
+	U64     uint64         `streamable:""`
+	U128    types.Uint128  `streamable:""`
+	I8      int8           `streamable:""`
+	I16     int16          `streamable:""`
+	I32     int32          `streamable:""`
+	I64     int64          `streamable:""`
+	True    bool           `streamable:""`
+	False   bool           `streamable:""`
+	OptU32  *uint32        `streamable:"optional"`
+	OptU128 *types.Uint128 `streamable:"optional"`
+}
+
+const (
+	// 01 uint8(1)
+	// 0203 uint16(0x0203)
+	// 04050607 uint32(0x04050607)
+	// 08090a0b0c0d0e0f uint64(0x08090a0b0c0d0e0f)
+	// 000102030405060708090a0b0c0d0e0f uint128(0x000102030405060708090a0b0c0d0e0f)
+	// ff int8(-1)
+	// fffe int16(-2)
+	// fffffffd int32(-3)
+	// fffffffffffffffc int64(-4)
+	// 01 true
+	// 00 false
+	// 01 0000ffff Optional[uint32] present with value 65535
+	// 00 Optional[uint128] not present
+	encodedHexAllInts = "01" + "0203" + "04050607" + "08090a0b0c0d0e0f" + "000102030405060708090a0b0c0d0e0f" +
+		"ff" + "fffe" + "fffffffd" + "fffffffffffffffc" + "01" + "00" + "010000ffff" + "00"
+)
+
+func TestUnmarshal_Remarshal_AllInts(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHexAllInts)
+	assert.NoError(t, err)
+
+	ai := &AllInts{}
+	err = streamable.Unmarshal(encodedBytes, ai)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint8(1), ai.U8)
+	assert.Equal(t, uint16(0x0203), ai.U16)
+	assert.Equal(t, uint32(0x04050607), ai.U32)
+	assert.Equal(t, uint64(0x08090a0b0c0d0e0f), ai.U64)
+	assert.Equal(t, types.NewUint128(0x0001020304050607, 0x08090a0b0c0d0e0f), ai.U128)
+	assert.Equal(t, int8(-1), ai.I8)
+	assert.Equal(t, int16(-2), ai.I16)
+	assert.Equal(t, int32(-3), ai.I32)
+	assert.Equal(t, int64(-4), ai.I64)
+	assert.True(t, ai.True)
+	assert.False(t, ai.False)
+	assert.Equal(t, util.PtrUint32(65535), ai.OptU32)
+	assert.Nil(t, ai.OptU128)
+
+	reencodedBytes, err := streamable.Marshal(ai)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestMarshal_OptionalUint128(t *testing.T) {
+	u := types.Uint128From64(1000)
+	ai := &AllInts{OptU128: &u}
+
+	encodedBytes, err := streamable.Marshal(ai)
+	assert.NoError(t, err)
+
+	// Last 17 bytes are the present flag, then the 16 byte uint128
+	assert.Equal(t, "01000000000000000000000000000003e8", hex.EncodeToString(encodedBytes[len(encodedBytes)-17:]))
+
+	decoded := &AllInts{}
+	err = streamable.Unmarshal(encodedBytes, decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, ai, decoded)
+}
+
+func TestUnmarshal_InvalidBool(t *testing.T) {
+	// Same as encodedHexAllInts, except the `true` byte is 0x02
+	encodedBytes, err := hex.DecodeString(strings.Replace(encodedHexAllInts, "fffffffffffffffc01", "fffffffffffffffc02", 1))
+	assert.NoError(t, err)
+
+	ai := &AllInts{}
+	err = streamable.Unmarshal(encodedBytes, ai)
+	assert.Error(t, err)
+}
+
+// CoinAmount is a Tuple[bytes32, uint64]
+type CoinAmount struct {
+	CoinID types.Bytes32 `streamable:""`
+	Amount uint64        `streamable:""`
+}
+
+// Inner is a regular nested streamable
+type Inner struct {
+	Name  string `streamable:""`
+	Value uint32 `streamable:""`
+}
+
+// Composite covers nested streamables and tuples in all the places they can appear
+type Composite struct {
+	Inner         Inner        `streamable:""`
+	OptionalInner *Inner       `streamable:"optional"`
+	Tuple         CoinAmount   `streamable:"tuple"`
+	OptionalTuple *CoinAmount  `streamable:"optional,tuple"`
+	TupleList     []CoinAmount `streamable:"tuple"`
+}
+
+func TestUnmarshal_Remarshal_Composite(t *testing.T) {
+	// 00000001 61 00000002 Inner("a", uint32(2))
+	// 00 OptionalInner None
+	// 32 bytes of 0x11 0000000000000003 Tuple (bytes32, uint64(3))
+	// 01 32 bytes of 0x22 0000000000000004 OptionalTuple present (bytes32, uint64(4))
+	// 00000002 List with two tuples
+	//   32 bytes of 0x33 0000000000000005
+	//   32 bytes of 0x44 0000000000000006
+	encodedHex := "000000016100000002" +
+		"00" +
+		strings.Repeat("11", 32) + "0000000000000003" +
+		"01" + strings.Repeat("22", 32) + "0000000000000004" +
+		"00000002" +
+		strings.Repeat("33", 32) + "0000000000000005" +
+		strings.Repeat("44", 32) + "0000000000000006"
+	encodedBytes, err := hex.DecodeString(encodedHex)
+	assert.NoError(t, err)
+
+	c := &Composite{}
+	err = streamable.Unmarshal(encodedBytes, c)
+	assert.NoError(t, err)
+
+	assert.Equal(t, Inner{Name: "a", Value: 2}, c.Inner)
+	assert.Nil(t, c.OptionalInner)
+	assert.Equal(t, strings.Repeat("11", 32), c.Tuple.CoinID.String())
+	assert.Equal(t, uint64(3), c.Tuple.Amount)
+	assert.NotNil(t, c.OptionalTuple)
+	assert.Equal(t, strings.Repeat("22", 32), c.OptionalTuple.CoinID.String())
+	assert.Equal(t, uint64(4), c.OptionalTuple.Amount)
+	assert.Len(t, c.TupleList, 2)
+	assert.Equal(t, strings.Repeat("33", 32), c.TupleList[0].CoinID.String())
+	assert.Equal(t, uint64(5), c.TupleList[0].Amount)
+	assert.Equal(t, strings.Repeat("44", 32), c.TupleList[1].CoinID.String())
+	assert.Equal(t, uint64(6), c.TupleList[1].Amount)
+
+	reencodedBytes, err := streamable.Marshal(c)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestMarshal_OptionalStructPresent(t *testing.T) {
+	c := &Composite{
+		OptionalInner: &Inner{Name: "b", Value: 7},
+	}
+
+	encodedBytes, err := streamable.Marshal(c)
+	assert.NoError(t, err)
+
+	// Empty Inner, then 01 for the optional being present, followed by Inner("b", uint32(7))
+	assert.Equal(t, "0000000000000000"+"01"+"000000016200000007", hex.EncodeToString(encodedBytes[:18]))
+
+	decoded := &Composite{}
+	err = streamable.Unmarshal(encodedBytes, decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, c.OptionalInner, decoded.OptionalInner)
+}
+
+// NonOptionalPointers has pointer fields that aren't optional, so they must always be set
+type NonOptionalPointers struct {
+	Value *uint32 `streamable:""`
+	Inner *Inner  `streamable:""`
+}
+
+func TestMarshal_NilNonOptionalPointer(t *testing.T) {
+	value := uint32(3)
+	encodedBytes, err := streamable.Marshal(&NonOptionalPointers{Value: &value, Inner: &Inner{Name: "c", Value: 4}})
+	assert.NoError(t, err)
+	assert.Equal(t, "00000003"+"000000016300000004", hex.EncodeToString(encodedBytes))
+
+	_, err = streamable.Marshal(&NonOptionalPointers{Value: &value})
+	assert.EqualError(t, err, "pointer is nil, but the field isn't optional")
+
+	_, err = streamable.Marshal(&NonOptionalPointers{Inner: &Inner{}})
+	assert.EqualError(t, err, "pointer is nil, but the field isn't optional")
+}
+
+// BadTuple has a tuple tag on a type that can't be a tuple
+type BadTuple struct {
+	NotATuple uint64 `streamable:"tuple"`
+}
+
+func TestMarshal_BadTuple(t *testing.T) {
+	_, err := streamable.Marshal(&BadTuple{})
+	assert.Error(t, err)
+
+	err = streamable.Unmarshal(make([]byte, 8), &BadTuple{})
+	assert.Error(t, err)
+}
+
+// Lists covers the different element types that can be inside a list
+type Lists struct {
+	Uint16s     []uint16         `streamable:""`
+	Int32s      []int32          `streamable:""`
+	Bools       []bool           `streamable:""`
+	Uint128s    []types.Uint128  `streamable:""`
+	Strings     []string         `streamable:""`
+	Hashes      []types.Bytes32  `streamable:""`
+	Optionals   []*uint32        `streamable:""` // List[Optional[uint32]]
+	Nested      [][]uint8        `streamable:""` // List[bytes]
+	NestedLists [][]string       `streamable:""` // List[List[str]]
+	OptTuples   []*CoinAmount    `streamable:"tuple"`
+	Fixed       [2]types.Bytes32 `streamable:""`
+}
+
+func TestUnmarshal_Remarshal_Lists(t *testing.T) {
+	// 00000002 0001 0002 List[uint16] [1, 2]
+	// 00000001 ffffffff List[int32] [-1]
+	// 00000002 01 00 List[bool] [True, False]
+	// 00000001 00000000000000000000000000000005 List[uint128] [5]
+	// 00000002 00000001 61 00000000 List[str] ["a", ""]
+	// 00000001 32 bytes of 0x11 List[bytes32]
+	// 00000003 01 00000007 00 01 00000008 List[Optional[uint32]] [7, None, 8]
+	// 00000002 00000002 0102 00000000 List[bytes] [b"\x01\x02", b""]
+	// 00000002 00000001 00000001 62 00000000 List[List[str]] [["b"], []]
+	// 00000002 00 01 32 bytes of 0x22 0000000000000009 List[Optional[Tuple[bytes32, uint64]]] [None, (bytes32, 9)]
+	// 32 bytes of 0x33 32 bytes of 0x44 - fixed size array of two bytes32, no prefix
+	encodedHex := "0000000200010002" +
+		"00000001ffffffff" +
+		"000000020100" +
+		"0000000100000000000000000000000000000005" +
+		"00000002000000016100000000" +
+		"00000001" + strings.Repeat("11", 32) +
+		"000000030100000007000100000008" +
+		"0000000200000002010200000000" +
+		"0000000200000001000000016200000000" +
+		"000000020001" + strings.Repeat("22", 32) + "0000000000000009" +
+		strings.Repeat("33", 32) + strings.Repeat("44", 32)
+	encodedBytes, err := hex.DecodeString(encodedHex)
+	assert.NoError(t, err)
+
+	l := &Lists{}
+	err = streamable.Unmarshal(encodedBytes, l)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []uint16{1, 2}, l.Uint16s)
+	assert.Equal(t, []int32{-1}, l.Int32s)
+	assert.Equal(t, []bool{true, false}, l.Bools)
+	assert.Equal(t, []types.Uint128{types.Uint128From64(5)}, l.Uint128s)
+	assert.Equal(t, []string{"a", ""}, l.Strings)
+	assert.Len(t, l.Hashes, 1)
+	assert.Equal(t, strings.Repeat("11", 32), l.Hashes[0].String())
+	assert.Equal(t, []*uint32{util.PtrUint32(7), nil, util.PtrUint32(8)}, l.Optionals)
+	assert.Equal(t, [][]uint8{{1, 2}, {}}, l.Nested)
+	assert.Equal(t, [][]string{{"b"}, {}}, l.NestedLists)
+	assert.Len(t, l.OptTuples, 2)
+	assert.Nil(t, l.OptTuples[0])
+	assert.Equal(t, uint64(9), l.OptTuples[1].Amount)
+	assert.Equal(t, strings.Repeat("33", 32), l.Fixed[0].String())
+	assert.Equal(t, strings.Repeat("44", 32), l.Fixed[1].String())
+
+	reencodedBytes, err := streamable.Marshal(l)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+// UnsupportedList has a list of a type streamable doesn't know how to encode
+type UnsupportedList struct {
+	Floats []float64 `streamable:""`
+}
+
+func TestMarshal_UnsupportedList(t *testing.T) {
+	// Unsupported types are reported up front, even when there aren't any items to encode
+	_, err := streamable.Marshal(&UnsupportedList{})
+	assert.EqualError(t, err, "streamable_test.UnsupportedList.Floats: unsupported type float64")
+
+	_, err = streamable.Marshal(&UnsupportedList{Floats: []float64{1.5}})
+	assert.Error(t, err)
+
+	err = streamable.Unmarshal([]byte{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0}, &UnsupportedList{})
+	assert.Error(t, err)
+}
+
+func TestMarshalAppend(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHex1)
+	assert.NoError(t, err)
+
+	msg := &protocols.Message{}
+	err = streamable.Unmarshal(encodedBytes, msg)
+	assert.NoError(t, err)
+
+	// Existing bytes are left alone and the encoding goes after them
+	prefix := []byte{0xde, 0xad}
+	appended, err := streamable.MarshalAppend(prefix, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{0xde, 0xad}, encodedBytes...), appended)
+
+	// Reusing the buffer from a previous call gives the same result
+	reused, err := streamable.MarshalAppend(appended[:0], msg)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reused)
+}
+
+// Types are compiled to encoding plans on first use, so make sure concurrent first use is safe
+func TestMarshal_Concurrent(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHexHandshake)
+	assert.NoError(t, err)
+
+	msg := &protocols.Message{}
+	err = streamable.Unmarshal(encodedBytes, msg)
+	assert.NoError(t, err)
+
+	results := make(chan error)
+	for i := 0; i < 8; i++ {
+		go func() {
+			handshake := &protocols.Handshake{}
+			err := streamable.Unmarshal(msg.Data, handshake)
+			if err != nil {
+				results <- err
+				return
+			}
+
+			reencodedBytes, err := streamable.Marshal(handshake)
+			if err == nil && !bytes.Equal(msg.Data, reencodedBytes) {
+				err = fmt.Errorf("reencoded bytes don't match")
+			}
+			results <- err
+		}()
+	}
+
+	for i := 0; i < 8; i++ {
+		assert.NoError(t, <-results)
+	}
+}
+
+func TestUnmarshalAs(t *testing.T) {
+	encodedBytes, err := hex.DecodeString(encodedHex2)
+	assert.NoError(t, err)
+
+	msg, err := streamable.UnmarshalAs[protocols.Message](encodedBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, util.PtrUint16(35256), msg.ID)
+	assert.Equal(t, []byte("This is a sample message to decode"), msg.Data)
+
+	msg, err = streamable.UnmarshalAs[protocols.Message](encodedBytes[:3])
+	assert.Nil(t, msg)
+	assert.Error(t, err)
+
+	_, err = streamable.UnmarshalAs[uint32](encodedBytes)
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package streamable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TagOptions are the options set in a `streamable:""` struct tag
+// Options are comma separated, for example `streamable:"optional,tuple"` or `streamable:"len=100"`
+//
+//	optional  Optional[T] - a presence byte, then the value if it is present. The field must be a pointer.
+//	tuple     Tuple[...] - the field, or its list/optional items, is a struct with one field per tuple item
+//	skip      not part of the streamable encoding, the same as not having the tag at all
+//	len=N     a list with exactly N items and no length prefix, such as bytes100 stored in a []byte
+//	name=foo  the name of the field in chia's JSON, when it isn't just the snake_case version of the go name
+//
+// An empty tag means the field is encoded with the default encoding for its type
+type TagOptions struct {
+	Optional bool
+	Tuple    bool
+	Skip     bool
+	Len      int
+	Name     string
+}
+
+// ParseTag parses the value of a streamable struct tag
+// Returns an error for unknown or repeated options, so that a typo can't silently change the wire format
+func ParseTag(tag string) (TagOptions, error) {
+	var opts TagOptions
+
+	if tag == "" {
+		return opts, nil
+	}
+
+	seen := map[string]bool{}
+	for _, option := range strings.Split(tag, ",") {
+		key, value, hasValue := cutOption(strings.TrimSpace(option))
+		if seen[key] {
+			return opts, fmt.Errorf("option %q is repeated", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "optional", "tuple", "skip":
+			if hasValue {
+				return opts, fmt.Errorf("option %q doesn't take a value", key)
+			}
+		case "len", "name":
+			if !hasValue || value == "" {
+				return opts, fmt.Errorf("option %q requires a value (%s=...)", key, key)
+			}
+		case "":
+			return opts, fmt.Errorf("empty option in tag %q", tag)
+		default:
+			return opts, fmt.Errorf("unknown option %q", key)
+		}
+
+		switch key {
+		case "optional":
+			opts.Optional = true
+		case "tuple":
+			opts.Tuple = true
+		case "skip":
+			opts.Skip = true
+		case "len":
+			length, err := strconv.Atoi(value)
+			if err != nil || length <= 0 {
+				return opts, fmt.Errorf("len must be a positive integer, got %q", value)
+			}
+			opts.Len = length
+		case "name":
+			opts.Name = value
+		}
+	}
+
+	if opts.Skip && len(seen) > 1 {
+		return opts, fmt.Errorf("skip can't be combined with other options")
+	}
+
+	return opts, nil
+}
+
+// cutOption splits key=value options
+func cutOption(option string) (string, string, bool) {
+	i := strings.Index(option, "=")
+	if i < 0 {
+		return option, "", false
+	}
+
+	return option[:i], option[i+1:], true
+}
@@ -0,0 +1,157 @@
+package streamable_test
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/protocols"
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected streamable.TagOptions
+	}{
+		{tag: "", expected: streamable.TagOptions{}},
+		{tag: "optional", expected: streamable.TagOptions{Optional: true}},
+		{tag: "optional,tuple", expected: streamable.TagOptions{Optional: true, Tuple: true}},
+		{tag: "tuple, optional", expected: streamable.TagOptions{Optional: true, Tuple: true}},
+		{tag: "skip", expected: streamable.TagOptions{Skip: true}},
+		{tag: "len=100", expected: streamable.TagOptions{Len: 100}},
+		{tag: "optional,name=pool_public_key", expected: streamable.TagOptions{Optional: true, Name: "pool_public_key"}},
+	}
+
+	for _, test := range tests {
+		opts, err := streamable.ParseTag(test.tag)
+		assert.NoError(t, err, test.tag)
+		assert.Equal(t, test.expected, opts, test.tag)
+	}
+}
+
+func TestParseTag_Invalid(t *testing.T) {
+	for _, tag := range []string{
+		"optinal",
+		"optional,",
+		",optional",
+		"optional,optional",
+		"optional=true",
+		"len",
+		"len=",
+		"len=0",
+		"len=-1",
+		"len=abc",
+		"name=",
+		"skip,optional",
+	} {
+		_, err := streamable.ParseTag(tag)
+		assert.Error(t, err, tag)
+	}
+}
+
+// Typo is missing a letter in optional, which used to silently make the field non-optional
+type Typo struct {
+	Value *uint32 `streamable:"optinal"`
+}
+
+// NotPointer has an optional field that can't be nil
+type NotPointer struct {
+	Value uint32 `streamable:"optional"`
+}
+
+// NestedUnsupported has a problem a few levels down
+type NestedUnsupported struct {
+	Inner *struct {
+		Items []map[string]string `streamable:""`
+	} `streamable:"optional"`
+}
+
+// BadLen has a fixed length on something that isn't a list
+type BadLen struct {
+	Value uint32 `streamable:"len=4"`
+}
+
+// Recursive refers to itself through a list, which is fine
+type Recursive struct {
+	Value    uint8       `streamable:""`
+	Children []Recursive `streamable:""`
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, streamable.Validate(reflect.TypeOf(protocols.Handshake{})))
+	assert.NoError(t, streamable.Validate(reflect.TypeOf(&protocols.Message{})))
+	assert.NoError(t, streamable.Validate(reflect.TypeOf(Lists{})))
+	assert.NoError(t, streamable.Validate(reflect.TypeOf(Recursive{})))
+	assert.NoError(t, streamable.Validate(reflect.TypeOf(Fixed{})))
+
+	assert.EqualError(t,
+		streamable.Validate(reflect.TypeOf(Typo{})),
+		`streamable_test.Typo: field Value has an invalid streamable tag: unknown option "optinal"`,
+	)
+	assert.EqualError(t,
+		streamable.Validate(reflect.TypeOf(NotPointer{})),
+		"streamable_test.NotPointer: field Value: optional fields must be pointer types",
+	)
+	assert.EqualError(t,
+		streamable.Validate(reflect.TypeOf(NestedUnsupported{})),
+		"streamable_test.NestedUnsupported.Inner.Items: unsupported type map[string]string",
+	)
+	assert.EqualError(t,
+		streamable.Validate(reflect.TypeOf(BadLen{})),
+		"streamable_test.BadLen: field Value: len can only be used with slices, got uint32",
+	)
+	assert.EqualError(t,
+		streamable.Validate(reflect.TypeOf(BadTuple{})),
+		"streamable_test.BadTuple: field NotATuple: tuple fields must be struct types, got uint64",
+	)
+	assert.Error(t, streamable.Validate(reflect.TypeOf(uint32(0))))
+	assert.Error(t, streamable.Validate(nil))
+}
+
+func TestUnmarshal_InvalidTag(t *testing.T) {
+	// The tag is checked before anything is read, so the data doesn't matter
+	err := streamable.Unmarshal([]byte{1, 0, 0, 0, 1}, &Typo{})
+	assert.Error(t, err)
+
+	_, err = streamable.Marshal(&Typo{})
+	assert.Error(t, err)
+}
+
+// Fixed has fixed length lists, and a field that is explicitly skipped
+type Fixed struct {
+	Data     []byte          `streamable:"len=4"`
+	Hashes   []types.Bytes32 `streamable:"len=2"`
+	Skipped  string          `streamable:"skip"`
+	Optional *[]uint16       `streamable:"optional,len=2"`
+}
+
+func TestUnmarshal_Remarshal_Fixed(t *testing.T) {
+	// No length prefixes on any of the lists
+	encodedHex := "01020304" + // Data
+		"1100000000000000000000000000000000000000000000000000000000000000" + // Hashes[0]
+		"2200000000000000000000000000000000000000000000000000000000000000" + // Hashes[1]
+		"01" + "0005" + "0006" // Optional present, then two uint16
+	encodedBytes, err := hex.DecodeString(encodedHex)
+	assert.NoError(t, err)
+
+	fixed := &Fixed{Skipped: "not encoded"}
+	err = streamable.Unmarshal(encodedBytes, fixed)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3, 4}, fixed.Data)
+	assert.Equal(t, []types.Bytes32{{0x11}, {0x22}}, fixed.Hashes)
+	assert.Equal(t, "not encoded", fixed.Skipped)
+	assert.Equal(t, &[]uint16{5, 6}, fixed.Optional)
+
+	reencodedBytes, err := streamable.Marshal(fixed)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestMarshal_FixedWrongLength(t *testing.T) {
+	_, err := streamable.Marshal(&Fixed{Data: []byte{1, 2, 3}, Hashes: make([]types.Bytes32, 2)})
+	assert.EqualError(t, err, "fixed length list must have exactly 4 items, got 3")
+}
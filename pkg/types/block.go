@@ -0,0 +1,69 @@
+package types
+
+// FullBlock corresponds to FullBlock in chia
+// The header hash of the block is the hash of the Foliage, which is streamable.Hash(block.Foliage)
+// The foliage transaction block, transactions info, and generator are only set for transaction blocks
+type FullBlock struct {
+	FinishedSubSlots             []EndOfSubSlotBundle     `streamable:""`
+	RewardChainBlock             RewardChainBlock         `streamable:""`
+	ChallengeChainSPProof        *VDFProof                `streamable:"optional"`
+	ChallengeChainIPProof        VDFProof                 `streamable:""`
+	RewardChainSPProof           *VDFProof                `streamable:"optional"`
+	RewardChainIPProof           VDFProof                 `streamable:""`
+	InfusedChallengeChainIPProof *VDFProof                `streamable:"optional"`
+	Foliage                      Foliage                  `streamable:""`
+	FoliageTransactionBlock      *FoliageTransactionBlock `streamable:"optional"`
+	TransactionsInfo             *TransactionsInfo        `streamable:"optional"`
+	TransactionsGenerator        *SerializedProgram       `streamable:"optional"`
+	TransactionsGeneratorRefList []uint32                 `streamable:""` // Heights of previous blocks whose generators this block references
+}
+
+// Height returns the height of the block
+func (b *FullBlock) Height() uint32 {
+	return b.RewardChainBlock.Height
+}
+
+// IsTransactionBlock returns true if the block is a transaction block
+func (b *FullBlock) IsTransactionBlock() bool {
+	return b.FoliageTransactionBlock != nil
+}
+
+// HeaderBlock corresponds to HeaderBlock in chia
+// This is a FullBlock without the transactions, which is what light clients request
+type HeaderBlock struct {
+	FinishedSubSlots             []EndOfSubSlotBundle     `streamable:""`
+	RewardChainBlock             RewardChainBlock         `streamable:""`
+	ChallengeChainSPProof        *VDFProof                `streamable:"optional"`
+	ChallengeChainIPProof        VDFProof                 `streamable:""`
+	RewardChainSPProof           *VDFProof                `streamable:"optional"`
+	RewardChainIPProof           VDFProof                 `streamable:""`
+	InfusedChallengeChainIPProof *VDFProof                `streamable:"optional"`
+	Foliage                      Foliage                  `streamable:""`
+	FoliageTransactionBlock      *FoliageTransactionBlock `streamable:"optional"`
+	TransactionsFilter           []byte                   `streamable:""` // Filter for block transactions
+	TransactionsInfo             *TransactionsInfo        `streamable:"optional"`
+}
+
+// Height returns the height of the block
+func (b *HeaderBlock) Height() uint32 {
+	return b.RewardChainBlock.Height
+}
+
+// IsTransactionBlock returns true if the block is a transaction block
+func (b *HeaderBlock) IsTransactionBlock() bool {
+	return b.FoliageTransactionBlock != nil
+}
+
+// UnfinishedBlock corresponds to UnfinishedBlock in chia
+// This is a block that hasn't been infused yet, so it doesn't have the infusion point VDFs
+type UnfinishedBlock struct {
+	FinishedSubSlots             []EndOfSubSlotBundle       `streamable:""`
+	RewardChainBlock             RewardChainBlockUnfinished `streamable:""`
+	ChallengeChainSPProof        *VDFProof                  `streamable:"optional"`
+	RewardChainSPProof           *VDFProof                  `streamable:"optional"`
+	Foliage                      Foliage                    `streamable:""`
+	FoliageTransactionBlock      *FoliageTransactionBlock   `streamable:"optional"`
+	TransactionsInfo             *TransactionsInfo          `streamable:"optional"`
+	TransactionsGenerator        *SerializedProgram         `streamable:"optional"`
+	TransactionsGeneratorRefList []uint32                   `streamable:""`
+}
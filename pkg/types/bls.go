@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/hex"
+)
+
+// G1Element is a serialized BLS public key (G1 element) from blspy
+type G1Element [48]byte
+
+// BytesToG1Element returns a G1Element from a []byte that is exactly 48 bytes long
+func BytesToG1Element(bytes []byte) (G1Element, error) {
+	g := G1Element{}
+	err := bytesToFixed(bytes, g[:])
+	return g, err
+}
+
+// HexStringToG1Element returns a G1Element from a hex string, with or without the 0x prefix
+func HexStringToG1Element(hexStr string) (G1Element, error) {
+	g := G1Element{}
+	err := hexStringToBytes(hexStr, g[:])
+	return g, err
+}
+
+// String returns the hex representation of the element
+func (g G1Element) String() string {
+	return hex.EncodeToString(g[:])
+}
+
+// MarshalJSON marshals to a 0x prefixed hex string
+func (g G1Element) MarshalJSON() ([]byte, error) {
+	return bytesToHexJSON(g[:])
+}
+
+// UnmarshalJSON unmarshals from a hex string, with or without the 0x prefix
+func (g *G1Element) UnmarshalJSON(data []byte) error {
+	return hexJSONToBytes(data, g[:])
+}
+
+// G2Element is a serialized BLS signature (G2 element) from blspy
+type G2Element [96]byte
+
+// BytesToG2Element returns a G2Element from a []byte that is exactly 96 bytes long
+func BytesToG2Element(bytes []byte) (G2Element, error) {
+	g := G2Element{}
+	err := bytesToFixed(bytes, g[:])
+	return g, err
+}
+
+// HexStringToG2Element returns a G2Element from a hex string, with or without the 0x prefix
+func HexStringToG2Element(hexStr string) (G2Element, error) {
+	g := G2Element{}
+	err := hexStringToBytes(hexStr, g[:])
+	return g, err
+}
+
+// String returns the hex representation of the element
+func (g G2Element) String() string {
+	return hex.EncodeToString(g[:])
+}
+
+// MarshalJSON marshals to a 0x prefixed hex string
+func (g G2Element) MarshalJSON() ([]byte, error) {
+	return bytesToHexJSON(g[:])
+}
+
+// UnmarshalJSON unmarshals from a hex string, with or without the 0x prefix
+func (g *G2Element) UnmarshalJSON(data []byte) error {
+	return hexJSONToBytes(data, g[:])
+}
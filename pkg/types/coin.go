@@ -0,0 +1,95 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// Coin corresponds to Coin in chia
+type Coin struct {
+	ParentCoinInfo Bytes32 `streamable:""`
+	PuzzleHash     Bytes32 `streamable:""`
+	Amount         uint64  `streamable:""`
+}
+
+// Name returns the ID of the coin
+// This is sha256(parent_coin_info + puzzle_hash + amount), where the amount is encoded the way CLVM encodes integers:
+// big-endian, with no leading zeros, and an extra leading zero byte if the high bit would otherwise be set
+// This is not the hash of the streamable bytes of the coin, where the amount is always 8 bytes
+func (c Coin) Name() Bytes32 {
+	h := sha256.New()
+	h.Write(c.ParentCoinInfo[:])
+	h.Write(c.PuzzleHash[:])
+	h.Write(clvmUint64(c.Amount))
+
+	var name Bytes32
+	h.Sum(name[:0])
+
+	return name
+}
+
+// Hash is the same as Name, which is what chia uses as the hash of a coin
+// This makes Coin implement streamable.Hashable
+func (c *Coin) Hash() (Bytes32, error) {
+	if c == nil {
+		return Bytes32{}, fmt.Errorf("can't hash a nil coin")
+	}
+
+	return c.Name(), nil
+}
+
+// clvmUint64 returns v encoded as a CLVM integer, which is the minimal signed big-endian representation
+// 0 is empty, and values with the high bit set get an extra zero byte so they aren't negative
+func clvmUint64(v uint64) []byte {
+	encoded := make([]byte, 9)
+	binary.BigEndian.PutUint64(encoded[1:], v)
+
+	// Drop leading zeros, as long as the next byte wouldn't make the value negative
+	for len(encoded) > 0 && encoded[0] == 0 && (len(encoded) == 1 || encoded[1] < 0x80) {
+		encoded = encoded[1:]
+	}
+
+	return encoded
+}
+
+// CoinSpend corresponds to CoinSpend in chia (previously CoinSolution)
+type CoinSpend struct {
+	Coin         Coin              `streamable:""`
+	PuzzleReveal SerializedProgram `streamable:""`
+	Solution     SerializedProgram `streamable:""`
+}
+
+// SpendBundle corresponds to SpendBundle in chia
+// The name of a spend bundle is the hash of its streamable bytes, which is streamable.Hash
+type SpendBundle struct {
+	CoinSpends          []CoinSpend `streamable:""`
+	AggregatedSignature G2Element   `streamable:""`
+}
+
+// CoinRecord corresponds to CoinRecord in chia, which is how the full node stores coins
+type CoinRecord struct {
+	Coin                Coin   `streamable:""`
+	ConfirmedBlockIndex uint32 `streamable:""`
+	SpentBlockIndex     uint32 `streamable:""`
+	Coinbase            bool   `streamable:""`
+	Timestamp           uint64 `streamable:""` // Timestamp of the block at height confirmed_block_index
+}
+
+// Spent returns true if the coin has been spent
+func (c CoinRecord) Spent() bool {
+	return c.SpentBlockIndex > 0
+}
+
+// Name returns the ID of the coin
+func (c CoinRecord) Name() Bytes32 {
+	return c.Coin.Name()
+}
+
+// CoinState corresponds to CoinState in chia, which is how the wallet protocol reports coins
+// The heights are nil when the coin hasn't been created or spent
+type CoinState struct {
+	Coin          Coin    `streamable:""`
+	SpentHeight   *uint32 `streamable:"optional"`
+	CreatedHeight *uint32 `streamable:"optional"`
+}
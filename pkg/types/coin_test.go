@@ -0,0 +1,231 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/streamable"
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+	"github.com/cmmarslender/go-chia-lib/pkg/util"
+)
+
+func testCoin(t *testing.T, amount uint64) types.Coin {
+	parent, err := types.HexStringToBytes32(strings.Repeat("ab", 32))
+	assert.NoError(t, err)
+	puzzleHash, err := types.HexStringToBytes32(strings.Repeat("cd", 32))
+	assert.NoError(t, err)
+
+	return types.Coin{ParentCoinInfo: parent, PuzzleHash: puzzleHash, Amount: amount}
+}
+
+func TestCoin_Name(t *testing.T) {
+	// sha256(parent + puzzle_hash + int_to_bytes(amount)), with the amount encoded as:
+	//   0             -> (empty)
+	//   1             -> 01
+	//   128           -> 0080 (leading zero, since the high bit is set)
+	//   1750000000000 -> 01977420dc00
+	//   max uint64    -> 00ffffffffffffffff
+	for amount, expected := range map[uint64]string{
+		0:                    "a6a96ccb5233f068bf41343ffd5984bf8d4d7e591f1e4bdb12bda296534409b8",
+		1:                    "fbc867b5a7c218af276d78e8343954898540b36ba738e481948330117103538c",
+		128:                  "669901afbd659230a7f3ed66bc24a5ea335fbb7f8451b1b18147ab025e93be72",
+		1750000000000:        "d010d726b6ede0194da583a61a97c0ca3a19dd3fe8ce038058da63b6d51dd840",
+		18446744073709551615: "636c1ca85896b6952ca4e2c127cc634fb2b2b5e061d2353668a1ec59d270be6b",
+	} {
+		coin := testCoin(t, amount)
+		assert.Equal(t, expected, coin.Name().String(), amount)
+
+		// The hash of a coin is its name, not the hash of its streamable bytes
+		hash, err := streamable.Hash(coin)
+		assert.NoError(t, err)
+		assert.Equal(t, coin.Name(), hash)
+	}
+
+	var nilCoin *types.Coin
+	_, err := nilCoin.Hash()
+	assert.EqualError(t, err, "can't hash a nil coin")
+
+	_, err = streamable.Hash(nilCoin)
+	assert.EqualError(t, err, "can't hash a nil coin")
+}
+
+// mainnetGenesisCoins are the pool and farmer reward coins created by the mainnet genesis block
+// Their parents come from the mainnet genesis challenge (ccd5bb71...0e5fbb): the first 16 bytes for the pool coin and
+// the last 16 bytes for the farmer coin, followed by the height (0) as 16 bytes. The puzzle hashes are the prefarm
+// puzzle hashes from the mainnet consensus constants. The pool amount has the high bit of the uint64 set
+var mainnetGenesisCoins = []struct {
+	name       string
+	parent     string
+	puzzleHash string
+	amount     uint64
+	coinID     string
+}{
+	{
+		name:       "genesis pool reward",
+		parent:     "ccd5bb71183532bff220ba46c268991a00000000000000000000000000000000",
+		puzzleHash: "d23da14695a188ae5708dd152263c4db883eb27edeb936178d4d988b8f3ce5fc",
+		amount:     18375000000000000000,
+		coinID:     "1fd60c070e821d785b65e10e5135e52d12c8f4d902a506f48bc1c5268b7bb45b",
+	},
+	{
+		name:       "genesis farmer reward",
+		parent:     "3ff07eb358e8255a65c30a2dce0e5fbb00000000000000000000000000000000",
+		puzzleHash: "3d8765d3a597ec1d99663f6c9816d915b9f68613ac94009884c4addaefcce6af",
+		amount:     2625000000000000000,
+		coinID:     "dce550a4341e5ec31c7e3fe5c6ab9801c66ed02689725939537d8d4492465800",
+	},
+}
+
+func TestCoin_NameMainnet(t *testing.T) {
+	for _, test := range mainnetGenesisCoins {
+		parent, err := types.HexStringToBytes32(test.parent)
+		assert.NoError(t, err)
+		puzzleHash, err := types.HexStringToBytes32(test.puzzleHash)
+		assert.NoError(t, err)
+
+		coin := types.Coin{ParentCoinInfo: parent, PuzzleHash: puzzleHash, Amount: test.amount}
+		assert.Equal(t, test.coinID, coin.Name().String(), test.name)
+
+		// Amount 0 isn't a mainnet coin, but the same parent and puzzle hash show the empty amount encoding
+		coin.Amount = 0
+		expected := sha256.Sum256(append(parent[:], puzzleHash[:]...))
+		assert.Equal(t, types.Bytes32(expected), coin.Name(), test.name)
+	}
+}
+
+func mustHex(t *testing.T, hexStr string) []byte {
+	decoded, err := hex.DecodeString(hexStr)
+	assert.NoError(t, err)
+	return decoded
+}
+
+func coinHex(t *testing.T, coin types.Coin) string {
+	encodedBytes, err := streamable.Marshal(coin)
+	assert.NoError(t, err)
+	return hex.EncodeToString(encodedBytes)
+}
+
+func TestCoin_Streamable(t *testing.T) {
+	coin := testCoin(t, 1750000000000)
+
+	encodedBytes, err := streamable.Marshal(coin)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("ab", 32)+strings.Repeat("cd", 32)+"000001977420dc00", hex.EncodeToString(encodedBytes))
+
+	decoded := types.Coin{}
+	assert.NoError(t, streamable.Unmarshal(encodedBytes, &decoded))
+	assert.Equal(t, coin, decoded)
+}
+
+var (
+	// (q . 1) -> ff 01 01
+	puzzleHex = "ff0101"
+
+	// (0x01020304 () <100 byte atom>)
+	//   ff 84 01020304       - first is a 4 byte atom
+	//   ff 80                - then nil
+	//   ff c064 <100 bytes>  - then a 100 byte atom, with a 2 byte size prefix
+	//   80                   - end of the list
+	solutionHex = "ff8401020304" + "ff80" + "ffc064" + strings.Repeat("ee", 100) + "80"
+)
+
+func TestSpendBundle_Streamable(t *testing.T) {
+	puzzle := mustHex(t, puzzleHex)
+	solution := mustHex(t, solutionHex)
+
+	sb := &types.SpendBundle{
+		CoinSpends: []types.CoinSpend{
+			{Coin: testCoin(t, 1), PuzzleReveal: puzzle, Solution: solution},
+			{Coin: testCoin(t, 2), PuzzleReveal: puzzle, Solution: types.SerializedProgram{0x80}},
+		},
+		AggregatedSignature: types.G2Element{0xc0},
+	}
+
+	// Programs have no length prefix, so the bytes are just everything one after the other
+	encodedHex := "00000002" +
+		coinHex(t, testCoin(t, 1)) + puzzleHex + solutionHex +
+		coinHex(t, testCoin(t, 2)) + puzzleHex + "80" +
+		"c0" + strings.Repeat("00", 95)
+
+	encodedBytes, err := streamable.Marshal(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, encodedHex, hex.EncodeToString(encodedBytes))
+
+	size, err := streamable.Size(sb)
+	assert.NoError(t, err)
+	assert.Equal(t, len(encodedBytes), size)
+
+	decoded := &types.SpendBundle{}
+	assert.NoError(t, streamable.UnmarshalWithOptions(encodedBytes, decoded, streamable.DecodeOptions{Strict: true}))
+	assert.Equal(t, sb, decoded)
+
+	// And through chia's JSON
+	jsonBytes, err := streamable.MarshalJSON(sb)
+	assert.NoError(t, err)
+	assert.Contains(t, string(jsonBytes), `"puzzle_reveal":"0xff0101","solution":"0x80"`)
+
+	fromJSON := &types.SpendBundle{}
+	assert.NoError(t, streamable.UnmarshalJSON(jsonBytes, fromJSON))
+	assert.Equal(t, sb, fromJSON)
+}
+
+func TestSerializedProgram_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		encodedHex string
+		expected   error
+	}{
+		{encodedHex: "", expected: io.ErrUnexpectedEOF},
+		{encodedHex: "ff01", expected: io.ErrUnexpectedEOF},           // Cons missing the rest
+		{encodedHex: "8401020304"[:8], expected: io.ErrUnexpectedEOF}, // Atom cut short
+		{encodedHex: "c0", expected: io.ErrUnexpectedEOF},             // Size prefix cut short
+	} {
+		program := types.SerializedProgram{}
+		err := streamable.Unmarshal(mustHex(t, test.encodedHex), &program)
+		assert.ErrorIs(t, err, test.expected, test.encodedHex)
+	}
+
+	// 0xfe is a back reference, which isn't part of the standard serialization
+	program := types.SerializedProgram{}
+	assert.Error(t, streamable.Unmarshal([]byte{0xfe, 0x01}, &program))
+
+	// The decode limits apply to atom sizes
+	err := streamable.UnmarshalWithOptions(mustHex(t, "c064"+strings.Repeat("ee", 100)), &program, streamable.DecodeOptions{MaxBytesLength: 99})
+	assert.Error(t, err)
+
+	_, err = streamable.Marshal(&types.CoinSpend{})
+	assert.Error(t, err)
+
+	// A coin spend with a puzzle reveal atom of 0x100000001 bytes, which is too large to even check against the limits
+	err = streamable.Unmarshal(mustHex(t, strings.Repeat("00", 72)+"f900000001"), &types.CoinSpend{})
+	assert.EqualError(t, err, "streamable: decoding PuzzleReveal at offset 72: atom of 4294967297 bytes is too large")
+
+	// With the limits disabled, an atom that claims more data than there is only allocates what is actually read
+	err = streamable.UnmarshalWithOptions(mustHex(t, "f8ffffffff"+"0102"), &program, streamable.DecodeOptions{MaxBytesLength: -1, MaxAllocation: -1})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestCoinState_Streamable(t *testing.T) {
+	state := &types.CoinState{Coin: testCoin(t, 1), CreatedHeight: util.PtrUint32(100)}
+
+	encodedBytes, err := streamable.Marshal(state)
+	assert.NoError(t, err)
+	assert.Equal(t, "00"+"0100000064", hex.EncodeToString(encodedBytes[72:]))
+
+	decoded := &types.CoinState{}
+	assert.NoError(t, streamable.Unmarshal(encodedBytes, decoded))
+	assert.Equal(t, state, decoded)
+}
+
+func TestCoinRecord(t *testing.T) {
+	record := types.CoinRecord{Coin: testCoin(t, 1), ConfirmedBlockIndex: 10}
+	assert.False(t, record.Spent())
+	assert.Equal(t, record.Coin.Name(), record.Name())
+
+	record.SpentBlockIndex = 12
+	assert.True(t, record.Spent())
+}
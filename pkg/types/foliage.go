@@ -0,0 +1,51 @@
+package types
+
+// PoolTarget corresponds to PoolTarget in chia
+type PoolTarget struct {
+	PuzzleHash Bytes32 `streamable:""`
+	MaxHeight  uint32  `streamable:""` // A max height of 0 means it is valid forever
+}
+
+// FoliageBlockData corresponds to FoliageBlockData in chia
+// This is the part of the foliage that is signed by the plot key
+type FoliageBlockData struct {
+	UnfinishedRewardBlockHash Bytes32    `streamable:""`
+	PoolTarget                PoolTarget `streamable:""`
+	PoolSignature             *G2Element `streamable:"optional"` // Only set for plots with a pool public key
+	FarmerRewardPuzzleHash    Bytes32    `streamable:""`
+	ExtensionData             Bytes32    `streamable:""`
+}
+
+// Foliage corresponds to Foliage in chia
+// The hash of the foliage is the header hash of the block
+// The transaction block fields are only set for transaction blocks
+type Foliage struct {
+	PrevBlockHash                    Bytes32          `streamable:""`
+	RewardBlockHash                  Bytes32          `streamable:""`
+	FoliageBlockData                 FoliageBlockData `streamable:""`
+	FoliageBlockDataSignature        G2Element        `streamable:""`
+	FoliageTransactionBlockHash      *Bytes32         `streamable:"optional"`
+	FoliageTransactionBlockSignature *G2Element       `streamable:"optional"`
+}
+
+// FoliageTransactionBlock corresponds to FoliageTransactionBlock in chia
+// This is only included in transaction blocks
+type FoliageTransactionBlock struct {
+	PrevTransactionBlockHash Bytes32 `streamable:""`
+	Timestamp                uint64  `streamable:""`
+	FilterHash               Bytes32 `streamable:""`
+	AdditionsRoot            Bytes32 `streamable:""`
+	RemovalsRoot             Bytes32 `streamable:""`
+	TransactionsInfoHash     Bytes32 `streamable:""`
+}
+
+// TransactionsInfo corresponds to TransactionsInfo in chia
+// This is only included in transaction blocks
+type TransactionsInfo struct {
+	GeneratorRoot            Bytes32   `streamable:""` // sha256 of the block generator, or zeros if there isn't one
+	GeneratorRefsRoot        Bytes32   `streamable:""`
+	AggregatedSignature      G2Element `streamable:""`
+	Fees                     uint64    `streamable:""`
+	Cost                     uint64    `streamable:""`
+	RewardClaimsIncorporated []Coin    `streamable:""`
+}
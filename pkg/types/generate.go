@@ -0,0 +1,4 @@
+package types
+
+// Streamable methods for the structs in this package are generated, so encoding and decoding them skips reflection
+//go:generate go run github.com/cmmarslender/go-chia-lib/cmd/streamablegen
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// bytesToHexJSON returns the JSON representation of fixed size bytes, which is a 0x prefixed hex string
+// This matches the format chia uses when converting bytes to json
+func bytesToHexJSON(bytes []byte) ([]byte, error) {
+	return json.Marshal("0x" + hex.EncodeToString(bytes))
+}
+
+// hexJSONToBytes decodes a JSON hex string into dst
+// The 0x prefix is optional, and the decoded value must be exactly len(dst) bytes
+func hexJSONToBytes(data []byte, dst []byte) error {
+	var hexStr string
+	err := json.Unmarshal(data, &hexStr)
+	if err != nil {
+		return err
+	}
+
+	return hexStringToBytes(hexStr, dst)
+}
+
+// hexStringToBytes decodes a hex string with an optional 0x prefix into dst
+// The decoded value must be exactly len(dst) bytes
+func hexStringToBytes(hexStr string, dst []byte) error {
+	hexStr = strings.TrimPrefix(hexStr, "0x")
+
+	decoded, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return err
+	}
+
+	if len(decoded) != len(dst) {
+		return fmt.Errorf("expected %d bytes, got %d", len(dst), len(decoded))
+	}
+
+	copy(dst, decoded)
+
+	return nil
+}
+
+// bytesToFixed copies bytes into dst, ensuring the length is exactly len(dst)
+func bytesToFixed(bytes []byte, dst []byte) error {
+	if len(bytes) != len(dst) {
+		return fmt.Errorf("expected %d bytes, got %d", len(dst), len(bytes))
+	}
+
+	copy(dst, bytes)
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+const (
+	// clvmConsBox is the serialized marker for a cons pair, which is followed by the first and rest
+	clvmConsBox = 0xff
+
+	// clvmNil is the serialized empty atom
+	clvmNil = 0x80
+
+	// clvmMaxSingleByte is the largest byte that is an atom by itself, with no size prefix
+	clvmMaxSingleByte = 0x7f
+
+	// clvmMaxAtomSize is the largest atom size allowed by the CLVM serialization format
+	clvmMaxAtomSize = 0x400000000
+
+	// atomChunkSize is how much of an atom is allocated at a time while reading it
+	// Reading in chunks means a size prefix that claims more data than there is can't allocate more than was read
+	atomChunkSize = 1 << 16
+)
+
+// SerializedProgram corresponds to SerializedProgram in chia, such as the puzzle reveal and solution of a CoinSpend
+// It holds the serialized CLVM as is. Streamable encodes it with no length prefix, since the length is implied by
+// the serialization itself, so reading one means walking the CLVM structure to find where it ends
+type SerializedProgram []byte
+
+// MarshalStreamable returns the serialized program, which is written with no length prefix
+func (p SerializedProgram) MarshalStreamable() ([]byte, error) {
+	if len(p) == 0 {
+		return nil, fmt.Errorf("empty SerializedProgram, use 0x80 for nil")
+	}
+
+	return p, nil
+}
+
+// StreamableSize is the length of the serialized program
+func (p SerializedProgram) StreamableSize() int {
+	return len(p)
+}
+
+// UnmarshalStreamable reads exactly one serialized CLVM object from r
+// Cons pairs are handled with a count of objects still to read rather than recursion, so deeply nested
+// programs can't exhaust the stack
+func (p *SerializedProgram) UnmarshalStreamable(r io.Reader) error {
+	// Readers from streamable apply the decode limits to anything we allocate based on the data
+	limiter, _ := r.(interface {
+		LimitBytesLength(numBytes uint32) error
+	})
+
+	var program []byte
+	b := make([]byte, 1)
+	for remaining := 1; remaining > 0; remaining-- {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return unexpectedEOF(err)
+		}
+		program = append(program, b[0])
+
+		if b[0] == clvmConsBox {
+			remaining += 2
+			continue
+		}
+		if b[0] <= clvmMaxSingleByte || b[0] == clvmNil {
+			continue
+		}
+
+		sizePrefix, size, err := readAtomSize(r, b[0])
+		if err != nil {
+			return err
+		}
+		program = append(program, sizePrefix...)
+
+		if size > math.MaxUint32 {
+			return fmt.Errorf("atom of %d bytes is too large", size)
+		}
+		if limiter != nil {
+			if err = limiter.LimitBytesLength(uint32(size)); err != nil {
+				return err
+			}
+		}
+
+		program, err = readAtom(r, program, size)
+		if err != nil {
+			return err
+		}
+	}
+
+	*p = program
+
+	return nil
+}
+
+// readAtomSize reads the rest of the size prefix of an atom, given the first byte of the prefix
+// The number of leading 1 bits in the first byte is the number of bytes in the prefix
+// Returns the bytes of the prefix after the first byte, and the size of the atom
+func readAtomSize(r io.Reader, first byte) ([]byte, uint64, error) {
+	prefixLen := 0
+	for mask := byte(0x80); first&mask != 0; mask >>= 1 {
+		prefixLen++
+	}
+	if prefixLen > 6 {
+		return nil, 0, fmt.Errorf("invalid atom size prefix 0x%x", first)
+	}
+
+	rest := make([]byte, prefixLen-1)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+
+	size := uint64(first & (0xff >> (prefixLen + 1)))
+	for _, b := range rest {
+		size = size<<8 | uint64(b)
+	}
+	if size >= clvmMaxAtomSize {
+		return nil, 0, fmt.Errorf("atom of %d bytes is too large", size)
+	}
+
+	return rest, size, nil
+}
+
+// readAtom reads the size bytes of an atom from r, and appends them to program
+func readAtom(r io.Reader, program []byte, size uint64) ([]byte, error) {
+	for size > 0 {
+		chunk := size
+		if chunk > atomChunkSize {
+			chunk = atomChunkSize
+		}
+
+		start := len(program)
+		program = append(program, make([]byte, chunk)...)
+		if _, err := io.ReadFull(r, program[start:]); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		size -= chunk
+	}
+
+	return program, nil
+}
+
+// unexpectedEOF reports running out of data partway through a program as io.ErrUnexpectedEOF
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// String returns the hex representation of the serialized program
+func (p SerializedProgram) String() string {
+	return hex.EncodeToString(p)
+}
+
+// MarshalJSON marshals to a 0x prefixed hex string
+func (p SerializedProgram) MarshalJSON() ([]byte, error) {
+	return bytesToHexJSON(p)
+}
+
+// UnmarshalJSON unmarshals from a hex string, with or without the 0x prefix
+func (p *SerializedProgram) UnmarshalJSON(data []byte) error {
+	var hexStr string
+	err := json.Unmarshal(data, &hexStr)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := hex.DecodeString(strings.TrimPrefix(hexStr, "0x"))
+	if err != nil {
+		return err
+	}
+
+	*p = decoded
+
+	return nil
+}
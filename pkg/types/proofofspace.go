@@ -0,0 +1,13 @@
+package types
+
+// ProofOfSpace corresponds to ProofOfSpace in chia
+// Plots created for pools using the original protocol have a PoolPublicKey, while plots created for a pool contract
+// have a PoolContractPuzzleHash instead. Exactly one of the two is set
+type ProofOfSpace struct {
+	Challenge              Bytes32    `streamable:""`
+	PoolPublicKey          *G1Element `streamable:"optional"`
+	PoolContractPuzzleHash *Bytes32   `streamable:"optional"`
+	PlotPublicKey          G1Element  `streamable:""`
+	Size                   uint8      `streamable:""`
+	Proof                  []byte     `streamable:""`
+}
@@ -0,0 +1,33 @@
+package types
+
+// RewardChainBlock corresponds to RewardChainBlock in chia
+// The SP (signage point) VDFs are nil when the block is at the first signage point of the sub slot
+type RewardChainBlock struct {
+	Weight                     Uint128      `streamable:""`
+	Height                     uint32       `streamable:""`
+	TotalIters                 Uint128      `streamable:""`
+	SignagePointIndex          uint8        `streamable:""`
+	PosSSCCChallengeHash       Bytes32      `streamable:"name=pos_ss_cc_challenge_hash"`
+	ProofOfSpace               ProofOfSpace `streamable:""`
+	ChallengeChainSPVDF        *VDFInfo     `streamable:"optional,name=challenge_chain_sp_vdf"`
+	ChallengeChainSPSignature  G2Element    `streamable:""`
+	ChallengeChainIPVDF        VDFInfo      `streamable:"name=challenge_chain_ip_vdf"`
+	RewardChainSPVDF           *VDFInfo     `streamable:"optional,name=reward_chain_sp_vdf"`
+	RewardChainSPSignature     G2Element    `streamable:""`
+	RewardChainIPVDF           VDFInfo      `streamable:"name=reward_chain_ip_vdf"`
+	InfusedChallengeChainIPVDF *VDFInfo     `streamable:"optional,name=infused_challenge_chain_ip_vdf"`
+	IsTransactionBlock         bool         `streamable:""`
+}
+
+// RewardChainBlockUnfinished corresponds to RewardChainBlockUnfinished in chia
+// This is the part of the reward chain block that is known before the block is infused
+type RewardChainBlockUnfinished struct {
+	TotalIters                Uint128      `streamable:""`
+	SignagePointIndex         uint8        `streamable:""`
+	PosSSCCChallengeHash      Bytes32      `streamable:"name=pos_ss_cc_challenge_hash"`
+	ProofOfSpace              ProofOfSpace `streamable:""`
+	ChallengeChainSPVDF       *VDFInfo     `streamable:"optional,name=challenge_chain_sp_vdf"`
+	ChallengeChainSPSignature G2Element    `streamable:""`
+	RewardChainSPVDF          *VDFInfo     `streamable:"optional,name=reward_chain_sp_vdf"`
+	RewardChainSPSignature    G2Element    `streamable:""`
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/hex"
+)
+
+// Bytes32 corresponds to bytes32 in chia
+// Streamable encodes fixed size byte arrays as the raw bytes, with no length prefix
+type Bytes32 [32]byte
+
+// BytesToBytes32 returns a Bytes32 from a []byte that is exactly 32 bytes long
+func BytesToBytes32(bytes []byte) (Bytes32, error) {
+	b := Bytes32{}
+	err := bytesToFixed(bytes, b[:])
+	return b, err
+}
+
+// HexStringToBytes32 returns a Bytes32 from a hex string, with or without the 0x prefix
+func HexStringToBytes32(hexStr string) (Bytes32, error) {
+	b := Bytes32{}
+	err := hexStringToBytes(hexStr, b[:])
+	return b, err
+}
+
+// String returns the hex representation of the bytes
+func (b Bytes32) String() string {
+	return hex.EncodeToString(b[:])
+}
+
+// MarshalJSON marshals to a 0x prefixed hex string
+func (b Bytes32) MarshalJSON() ([]byte, error) {
+	return bytesToHexJSON(b[:])
+}
+
+// UnmarshalJSON unmarshals from a hex string, with or without the 0x prefix
+func (b *Bytes32) UnmarshalJSON(data []byte) error {
+	return hexJSONToBytes(data, b[:])
+}
+
+// Bytes100 corresponds to bytes100 in chia, which is the size of a serialized classgroup element
+type Bytes100 [100]byte
+
+// BytesToBytes100 returns a Bytes100 from a []byte that is exactly 100 bytes long
+func BytesToBytes100(bytes []byte) (Bytes100, error) {
+	b := Bytes100{}
+	err := bytesToFixed(bytes, b[:])
+	return b, err
+}
+
+// HexStringToBytes100 returns a Bytes100 from a hex string, with or without the 0x prefix
+func HexStringToBytes100(hexStr string) (Bytes100, error) {
+	b := Bytes100{}
+	err := hexStringToBytes(hexStr, b[:])
+	return b, err
+}
+
+// String returns the hex representation of the bytes
+func (b Bytes100) String() string {
+	return hex.EncodeToString(b[:])
+}
+
+// MarshalJSON marshals to a 0x prefixed hex string
+func (b Bytes100) MarshalJSON() ([]byte, error) {
+	return bytesToHexJSON(b[:])
+}
+
+// UnmarshalJSON unmarshals from a hex string, with or without the 0x prefix
+func (b *Bytes100) UnmarshalJSON(data []byte) error {
+	return hexJSONToBytes(data, b[:])
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+func TestBytes32_String(t *testing.T) {
+	b, err := types.HexStringToBytes32("0x" + strings.Repeat("ab", 32))
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("ab", 32), b.String())
+
+	// No prefix is fine too
+	b2, err := types.HexStringToBytes32(strings.Repeat("ab", 32))
+	assert.NoError(t, err)
+	assert.Equal(t, b, b2)
+
+	// Wrong lengths are rejected
+	_, err = types.HexStringToBytes32(strings.Repeat("ab", 31))
+	assert.Error(t, err)
+	_, err = types.BytesToBytes32(make([]byte, 33))
+	assert.Error(t, err)
+}
+
+func TestBytes32_JSON(t *testing.T) {
+	b, err := types.BytesToBytes32([]byte(strings.Repeat("\x01", 32)))
+	assert.NoError(t, err)
+
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0x`+strings.Repeat("01", 32)+`"`, string(jsonBytes))
+
+	decoded := types.Bytes32{}
+	err = json.Unmarshal(jsonBytes, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, b, decoded)
+
+	err = json.Unmarshal([]byte(`"0x1234"`), &decoded)
+	assert.Error(t, err)
+	err = json.Unmarshal([]byte(`"0xnothex"`), &decoded)
+	assert.Error(t, err)
+}
+
+func TestG1Element_JSON(t *testing.T) {
+	g, err := types.HexStringToG1Element(strings.Repeat("a0", 48))
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a0", 48), g.String())
+
+	jsonBytes, err := json.Marshal(g)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0x`+strings.Repeat("a0", 48)+`"`, string(jsonBytes))
+
+	decoded := types.G1Element{}
+	err = json.Unmarshal(jsonBytes, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, g, decoded)
+}
+
+func TestG2Element_JSON(t *testing.T) {
+	g, err := types.HexStringToG2Element(strings.Repeat("c0", 96))
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("c0", 96), g.String())
+
+	jsonBytes, err := json.Marshal(g)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0x`+strings.Repeat("c0", 96)+`"`, string(jsonBytes))
+
+	decoded := types.G2Element{}
+	err = json.Unmarshal(jsonBytes, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, g, decoded)
+
+	_, err = types.HexStringToG2Element(strings.Repeat("c0", 48))
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package types
+
+// ChallengeChainSubSlot corresponds to ChallengeChainSubSlot in chia
+// The new sub slot iters and difficulty are only set at the start of a new epoch
+type ChallengeChainSubSlot struct {
+	ChallengeChainEndOfSlotVDF       VDFInfo  `streamable:""`
+	InfusedChallengeChainSubSlotHash *Bytes32 `streamable:"optional"`
+	SubepochSummaryHash              *Bytes32 `streamable:"optional"`
+	NewSubSlotIters                  *uint64  `streamable:"optional"`
+	NewDifficulty                    *uint64  `streamable:"optional"`
+}
+
+// InfusedChallengeChainSubSlot corresponds to InfusedChallengeChainSubSlot in chia
+type InfusedChallengeChainSubSlot struct {
+	InfusedChallengeChainEndOfSlotVDF VDFInfo `streamable:""`
+}
+
+// RewardChainSubSlot corresponds to RewardChainSubSlot in chia
+type RewardChainSubSlot struct {
+	EndOfSlotVDF                     VDFInfo  `streamable:""`
+	ChallengeChainSubSlotHash        Bytes32  `streamable:""`
+	InfusedChallengeChainSubSlotHash *Bytes32 `streamable:"optional"`
+	Deficit                          uint8    `streamable:""`
+}
+
+// SubSlotProofs corresponds to SubSlotProofs in chia
+type SubSlotProofs struct {
+	ChallengeChainSlotProof        VDFProof  `streamable:""`
+	InfusedChallengeChainSlotProof *VDFProof `streamable:"optional"`
+	RewardChainSlotProof           VDFProof  `streamable:""`
+}
+
+// EndOfSubSlotBundle corresponds to EndOfSubSlotBundle in chia
+type EndOfSubSlotBundle struct {
+	ChallengeChain        ChallengeChainSubSlot         `streamable:""`
+	InfusedChallengeChain *InfusedChallengeChainSubSlot `streamable:"optional"`
+	RewardChain           RewardChainSubSlot            `streamable:""`
+	Proofs                SubSlotProofs                 `streamable:""`
+}
+
+// SubEpochSummary corresponds to SubEpochSummary in chia
+type SubEpochSummary struct {
+	PrevSubepochSummaryHash Bytes32 `streamable:""`
+	RewardChainHash         Bytes32 `streamable:""`
+	NumBlocksOverflow       uint8   `streamable:""`
+	NewDifficulty           *uint64 `streamable:"optional"` // Only once per epoch (diff adjustment)
+	NewSubSlotIters         *uint64 `streamable:"optional"` // Only once per epoch (diff adjustment)
+}
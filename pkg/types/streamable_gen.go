@@ -0,0 +1,4072 @@
+// Code generated by streamablegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"unsafe"
+)
+
+// MarshalStreamable implements streamable.Marshaler for ChallengeChainSubSlot
+func (s *ChallengeChainSubSlot) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of ChallengeChainSubSlot to b
+func (s *ChallengeChainSubSlot) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// ChallengeChainEndOfSlotVDF
+	if b, err = s.ChallengeChainEndOfSlotVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// InfusedChallengeChainSubSlotHash
+	if s.InfusedChallengeChainSubSlotHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.InfusedChallengeChainSubSlotHash)[:]...)
+	}
+	// SubepochSummaryHash
+	if s.SubepochSummaryHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.SubepochSummaryHash)[:]...)
+	}
+	// NewSubSlotIters
+	if s.NewSubSlotIters == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.NewSubSlotIters)>>56), byte((*s.NewSubSlotIters)>>48), byte((*s.NewSubSlotIters)>>40), byte((*s.NewSubSlotIters)>>32), byte((*s.NewSubSlotIters)>>24), byte((*s.NewSubSlotIters)>>16), byte((*s.NewSubSlotIters)>>8), byte((*s.NewSubSlotIters)))
+	}
+	// NewDifficulty
+	if s.NewDifficulty == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.NewDifficulty)>>56), byte((*s.NewDifficulty)>>48), byte((*s.NewDifficulty)>>40), byte((*s.NewDifficulty)>>32), byte((*s.NewDifficulty)>>24), byte((*s.NewDifficulty)>>16), byte((*s.NewDifficulty)>>8), byte((*s.NewDifficulty)))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for ChallengeChainSubSlot
+func (s *ChallengeChainSubSlot) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeChainEndOfSlotVDF
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeChainEndOfSlotVDF", (*VDFInfo)(nil))
+	}
+	if err = s.ChallengeChainEndOfSlotVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "ChallengeChainEndOfSlotVDF", (*VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChainSubSlotHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainSubSlotHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainSubSlotHash)); err != nil {
+			return d.FieldError(err, start2, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+		}
+		s.InfusedChallengeChainSubSlotHash = new(Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start2, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+		}
+		copy((*s.InfusedChallengeChainSubSlotHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// SubepochSummaryHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "SubepochSummaryHash", (**Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "SubepochSummaryHash", (**Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.SubepochSummaryHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.SubepochSummaryHash)); err != nil {
+			return d.FieldError(err, start3, "SubepochSummaryHash", (**Bytes32)(nil))
+		}
+		s.SubepochSummaryHash = new(Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start3, "SubepochSummaryHash", (**Bytes32)(nil))
+		}
+		copy((*s.SubepochSummaryHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "SubepochSummaryHash", (**Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// NewSubSlotIters
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "NewSubSlotIters", (**uint64)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "NewSubSlotIters", (**uint64)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.NewSubSlotIters = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.NewSubSlotIters)); err != nil {
+			return d.FieldError(err, start4, "NewSubSlotIters", (**uint64)(nil))
+		}
+		s.NewSubSlotIters = new(uint64)
+		if b, err = d.Next(8); err != nil {
+			return d.FieldError(err, start4, "NewSubSlotIters", (**uint64)(nil))
+		}
+		(*s.NewSubSlotIters) = uint64(binary.BigEndian.Uint64(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "NewSubSlotIters", (**uint64)(nil))
+	}
+	d.LeaveNested()
+	// NewDifficulty
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "NewDifficulty", (**uint64)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "NewDifficulty", (**uint64)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.NewDifficulty = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.NewDifficulty)); err != nil {
+			return d.FieldError(err, start5, "NewDifficulty", (**uint64)(nil))
+		}
+		s.NewDifficulty = new(uint64)
+		if b, err = d.Next(8); err != nil {
+			return d.FieldError(err, start5, "NewDifficulty", (**uint64)(nil))
+		}
+		(*s.NewDifficulty) = uint64(binary.BigEndian.Uint64(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "NewDifficulty", (**uint64)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for ClassgroupElement
+func (s *ClassgroupElement) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of ClassgroupElement to b
+func (s *ClassgroupElement) AppendStreamable(b []byte) ([]byte, error) {
+	// Data
+	b = append(b, s.Data[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for ClassgroupElement
+func (s *ClassgroupElement) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Data
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Data", (*Bytes100)(nil))
+	}
+	if b, err = d.Next(100); err != nil {
+		return d.FieldError(err, start1, "Data", (*Bytes100)(nil))
+	}
+	copy(s.Data[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Coin
+func (s *Coin) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Coin to b
+func (s *Coin) AppendStreamable(b []byte) ([]byte, error) {
+	// ParentCoinInfo
+	b = append(b, s.ParentCoinInfo[:]...)
+	// PuzzleHash
+	b = append(b, s.PuzzleHash[:]...)
+	// Amount
+	b = append(b, byte(s.Amount>>56), byte(s.Amount>>48), byte(s.Amount>>40), byte(s.Amount>>32), byte(s.Amount>>24), byte(s.Amount>>16), byte(s.Amount>>8), byte(s.Amount))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Coin
+func (s *Coin) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ParentCoinInfo
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ParentCoinInfo", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "ParentCoinInfo", (*Bytes32)(nil))
+	}
+	copy(s.ParentCoinInfo[:], b)
+	d.LeaveNested()
+	// PuzzleHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "PuzzleHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "PuzzleHash", (*Bytes32)(nil))
+	}
+	copy(s.PuzzleHash[:], b)
+	d.LeaveNested()
+	// Amount
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Amount", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start3, "Amount", (*uint64)(nil))
+	}
+	s.Amount = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for CoinRecord
+func (s *CoinRecord) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of CoinRecord to b
+func (s *CoinRecord) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Coin
+	if b, err = s.Coin.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ConfirmedBlockIndex
+	b = append(b, byte(s.ConfirmedBlockIndex>>24), byte(s.ConfirmedBlockIndex>>16), byte(s.ConfirmedBlockIndex>>8), byte(s.ConfirmedBlockIndex))
+	// SpentBlockIndex
+	b = append(b, byte(s.SpentBlockIndex>>24), byte(s.SpentBlockIndex>>16), byte(s.SpentBlockIndex>>8), byte(s.SpentBlockIndex))
+	// Coinbase
+	if s.Coinbase {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	// Timestamp
+	b = append(b, byte(s.Timestamp>>56), byte(s.Timestamp>>48), byte(s.Timestamp>>40), byte(s.Timestamp>>32), byte(s.Timestamp>>24), byte(s.Timestamp>>16), byte(s.Timestamp>>8), byte(s.Timestamp))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for CoinRecord
+func (s *CoinRecord) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Coin
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Coin", (*Coin)(nil))
+	}
+	if err = s.Coin.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Coin", (*Coin)(nil))
+	}
+	d.LeaveNested()
+	// ConfirmedBlockIndex
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ConfirmedBlockIndex", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "ConfirmedBlockIndex", (*uint32)(nil))
+	}
+	s.ConfirmedBlockIndex = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// SpentBlockIndex
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "SpentBlockIndex", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start3, "SpentBlockIndex", (*uint32)(nil))
+	}
+	s.SpentBlockIndex = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// Coinbase
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Coinbase", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "Coinbase", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.Coinbase = false
+	case 1:
+		s.Coinbase = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start4, "Coinbase", (*bool)(nil))
+	}
+	d.LeaveNested()
+	// Timestamp
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "Timestamp", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start5, "Timestamp", (*uint64)(nil))
+	}
+	s.Timestamp = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for CoinSpend
+func (s *CoinSpend) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of CoinSpend to b
+func (s *CoinSpend) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Coin
+	if b, err = s.Coin.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// PuzzleReveal
+	var custom1 []byte
+	if custom1, err = s.PuzzleReveal.MarshalStreamable(); err != nil {
+		return nil, err
+	}
+	b = append(b, custom1...)
+	// Solution
+	var custom2 []byte
+	if custom2, err = s.Solution.MarshalStreamable(); err != nil {
+		return nil, err
+	}
+	b = append(b, custom2...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for CoinSpend
+func (s *CoinSpend) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// Coin
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Coin", (*Coin)(nil))
+	}
+	if err = s.Coin.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Coin", (*Coin)(nil))
+	}
+	d.LeaveNested()
+	// PuzzleReveal
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "PuzzleReveal", (*SerializedProgram)(nil))
+	}
+	if err = s.PuzzleReveal.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start2, "PuzzleReveal", (*SerializedProgram)(nil))
+	}
+	d.LeaveNested()
+	// Solution
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Solution", (*SerializedProgram)(nil))
+	}
+	if err = s.Solution.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "Solution", (*SerializedProgram)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for CoinState
+func (s *CoinState) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of CoinState to b
+func (s *CoinState) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Coin
+	if b, err = s.Coin.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// SpentHeight
+	if s.SpentHeight == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.SpentHeight)>>24), byte((*s.SpentHeight)>>16), byte((*s.SpentHeight)>>8), byte((*s.SpentHeight)))
+	}
+	// CreatedHeight
+	if s.CreatedHeight == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.CreatedHeight)>>24), byte((*s.CreatedHeight)>>16), byte((*s.CreatedHeight)>>8), byte((*s.CreatedHeight)))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for CoinState
+func (s *CoinState) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Coin
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Coin", (*Coin)(nil))
+	}
+	if err = s.Coin.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "Coin", (*Coin)(nil))
+	}
+	d.LeaveNested()
+	// SpentHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "SpentHeight", (**uint32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "SpentHeight", (**uint32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.SpentHeight = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.SpentHeight)); err != nil {
+			return d.FieldError(err, start2, "SpentHeight", (**uint32)(nil))
+		}
+		s.SpentHeight = new(uint32)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start2, "SpentHeight", (**uint32)(nil))
+		}
+		(*s.SpentHeight) = uint32(binary.BigEndian.Uint32(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "SpentHeight", (**uint32)(nil))
+	}
+	d.LeaveNested()
+	// CreatedHeight
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "CreatedHeight", (**uint32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "CreatedHeight", (**uint32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CreatedHeight = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CreatedHeight)); err != nil {
+			return d.FieldError(err, start3, "CreatedHeight", (**uint32)(nil))
+		}
+		s.CreatedHeight = new(uint32)
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(err, start3, "CreatedHeight", (**uint32)(nil))
+		}
+		(*s.CreatedHeight) = uint32(binary.BigEndian.Uint32(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "CreatedHeight", (**uint32)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for EndOfSubSlotBundle
+func (s *EndOfSubSlotBundle) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of EndOfSubSlotBundle to b
+func (s *EndOfSubSlotBundle) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// ChallengeChain
+	if b, err = s.ChallengeChain.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// InfusedChallengeChain
+	if s.InfusedChallengeChain == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.InfusedChallengeChain).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChain
+	if b, err = s.RewardChain.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// Proofs
+	if b, err = s.Proofs.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for EndOfSubSlotBundle
+func (s *EndOfSubSlotBundle) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeChain
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeChain", (*ChallengeChainSubSlot)(nil))
+	}
+	if err = s.ChallengeChain.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "ChallengeChain", (*ChallengeChainSubSlot)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChain
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "InfusedChallengeChain", (**InfusedChallengeChainSubSlot)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "InfusedChallengeChain", (**InfusedChallengeChainSubSlot)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChain = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChain)); err != nil {
+			return d.FieldError(err, start2, "InfusedChallengeChain", (**InfusedChallengeChainSubSlot)(nil))
+		}
+		s.InfusedChallengeChain = new(InfusedChallengeChainSubSlot)
+		if err = (*s.InfusedChallengeChain).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start2, "InfusedChallengeChain", (**InfusedChallengeChainSubSlot)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "InfusedChallengeChain", (**InfusedChallengeChainSubSlot)(nil))
+	}
+	d.LeaveNested()
+	// RewardChain
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "RewardChain", (*RewardChainSubSlot)(nil))
+	}
+	if err = s.RewardChain.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "RewardChain", (*RewardChainSubSlot)(nil))
+	}
+	d.LeaveNested()
+	// Proofs
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Proofs", (*SubSlotProofs)(nil))
+	}
+	if err = s.Proofs.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "Proofs", (*SubSlotProofs)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for Foliage
+func (s *Foliage) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of Foliage to b
+func (s *Foliage) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// PrevBlockHash
+	b = append(b, s.PrevBlockHash[:]...)
+	// RewardBlockHash
+	b = append(b, s.RewardBlockHash[:]...)
+	// FoliageBlockData
+	if b, err = s.FoliageBlockData.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// FoliageBlockDataSignature
+	b = append(b, s.FoliageBlockDataSignature[:]...)
+	// FoliageTransactionBlockHash
+	if s.FoliageTransactionBlockHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.FoliageTransactionBlockHash)[:]...)
+	}
+	// FoliageTransactionBlockSignature
+	if s.FoliageTransactionBlockSignature == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.FoliageTransactionBlockSignature)[:]...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for Foliage
+func (s *Foliage) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PrevBlockHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PrevBlockHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "PrevBlockHash", (*Bytes32)(nil))
+	}
+	copy(s.PrevBlockHash[:], b)
+	d.LeaveNested()
+	// RewardBlockHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "RewardBlockHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "RewardBlockHash", (*Bytes32)(nil))
+	}
+	copy(s.RewardBlockHash[:], b)
+	d.LeaveNested()
+	// FoliageBlockData
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "FoliageBlockData", (*FoliageBlockData)(nil))
+	}
+	if err = s.FoliageBlockData.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "FoliageBlockData", (*FoliageBlockData)(nil))
+	}
+	d.LeaveNested()
+	// FoliageBlockDataSignature
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "FoliageBlockDataSignature", (*G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start4, "FoliageBlockDataSignature", (*G2Element)(nil))
+	}
+	copy(s.FoliageBlockDataSignature[:], b)
+	d.LeaveNested()
+	// FoliageTransactionBlockHash
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "FoliageTransactionBlockHash", (**Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "FoliageTransactionBlockHash", (**Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageTransactionBlockHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageTransactionBlockHash)); err != nil {
+			return d.FieldError(err, start5, "FoliageTransactionBlockHash", (**Bytes32)(nil))
+		}
+		s.FoliageTransactionBlockHash = new(Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start5, "FoliageTransactionBlockHash", (**Bytes32)(nil))
+		}
+		copy((*s.FoliageTransactionBlockHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "FoliageTransactionBlockHash", (**Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// FoliageTransactionBlockSignature
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "FoliageTransactionBlockSignature", (**G2Element)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "FoliageTransactionBlockSignature", (**G2Element)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageTransactionBlockSignature = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageTransactionBlockSignature)); err != nil {
+			return d.FieldError(err, start6, "FoliageTransactionBlockSignature", (**G2Element)(nil))
+		}
+		s.FoliageTransactionBlockSignature = new(G2Element)
+		if b, err = d.Next(96); err != nil {
+			return d.FieldError(err, start6, "FoliageTransactionBlockSignature", (**G2Element)(nil))
+		}
+		copy((*s.FoliageTransactionBlockSignature)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start6, "FoliageTransactionBlockSignature", (**G2Element)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for FoliageBlockData
+func (s *FoliageBlockData) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of FoliageBlockData to b
+func (s *FoliageBlockData) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// UnfinishedRewardBlockHash
+	b = append(b, s.UnfinishedRewardBlockHash[:]...)
+	// PoolTarget
+	if b, err = s.PoolTarget.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// PoolSignature
+	if s.PoolSignature == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.PoolSignature)[:]...)
+	}
+	// FarmerRewardPuzzleHash
+	b = append(b, s.FarmerRewardPuzzleHash[:]...)
+	// ExtensionData
+	b = append(b, s.ExtensionData[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for FoliageBlockData
+func (s *FoliageBlockData) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// UnfinishedRewardBlockHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardBlockHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "UnfinishedRewardBlockHash", (*Bytes32)(nil))
+	}
+	copy(s.UnfinishedRewardBlockHash[:], b)
+	d.LeaveNested()
+	// PoolTarget
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "PoolTarget", (*PoolTarget)(nil))
+	}
+	if err = s.PoolTarget.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start2, "PoolTarget", (*PoolTarget)(nil))
+	}
+	d.LeaveNested()
+	// PoolSignature
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "PoolSignature", (**G2Element)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "PoolSignature", (**G2Element)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PoolSignature = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PoolSignature)); err != nil {
+			return d.FieldError(err, start3, "PoolSignature", (**G2Element)(nil))
+		}
+		s.PoolSignature = new(G2Element)
+		if b, err = d.Next(96); err != nil {
+			return d.FieldError(err, start3, "PoolSignature", (**G2Element)(nil))
+		}
+		copy((*s.PoolSignature)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "PoolSignature", (**G2Element)(nil))
+	}
+	d.LeaveNested()
+	// FarmerRewardPuzzleHash
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "FarmerRewardPuzzleHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start4, "FarmerRewardPuzzleHash", (*Bytes32)(nil))
+	}
+	copy(s.FarmerRewardPuzzleHash[:], b)
+	d.LeaveNested()
+	// ExtensionData
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "ExtensionData", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start5, "ExtensionData", (*Bytes32)(nil))
+	}
+	copy(s.ExtensionData[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for FoliageTransactionBlock
+func (s *FoliageTransactionBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of FoliageTransactionBlock to b
+func (s *FoliageTransactionBlock) AppendStreamable(b []byte) ([]byte, error) {
+	// PrevTransactionBlockHash
+	b = append(b, s.PrevTransactionBlockHash[:]...)
+	// Timestamp
+	b = append(b, byte(s.Timestamp>>56), byte(s.Timestamp>>48), byte(s.Timestamp>>40), byte(s.Timestamp>>32), byte(s.Timestamp>>24), byte(s.Timestamp>>16), byte(s.Timestamp>>8), byte(s.Timestamp))
+	// FilterHash
+	b = append(b, s.FilterHash[:]...)
+	// AdditionsRoot
+	b = append(b, s.AdditionsRoot[:]...)
+	// RemovalsRoot
+	b = append(b, s.RemovalsRoot[:]...)
+	// TransactionsInfoHash
+	b = append(b, s.TransactionsInfoHash[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for FoliageTransactionBlock
+func (s *FoliageTransactionBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PrevTransactionBlockHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PrevTransactionBlockHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "PrevTransactionBlockHash", (*Bytes32)(nil))
+	}
+	copy(s.PrevTransactionBlockHash[:], b)
+	d.LeaveNested()
+	// Timestamp
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Timestamp", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "Timestamp", (*uint64)(nil))
+	}
+	s.Timestamp = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// FilterHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "FilterHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "FilterHash", (*Bytes32)(nil))
+	}
+	copy(s.FilterHash[:], b)
+	d.LeaveNested()
+	// AdditionsRoot
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "AdditionsRoot", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start4, "AdditionsRoot", (*Bytes32)(nil))
+	}
+	copy(s.AdditionsRoot[:], b)
+	d.LeaveNested()
+	// RemovalsRoot
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "RemovalsRoot", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start5, "RemovalsRoot", (*Bytes32)(nil))
+	}
+	copy(s.RemovalsRoot[:], b)
+	d.LeaveNested()
+	// TransactionsInfoHash
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "TransactionsInfoHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start6, "TransactionsInfoHash", (*Bytes32)(nil))
+	}
+	copy(s.TransactionsInfoHash[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for FullBlock
+func (s *FullBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of FullBlock to b
+func (s *FullBlock) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// FinishedSubSlots
+	n1 := uint32(len(s.FinishedSubSlots))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.FinishedSubSlots {
+		if b, err = s.FinishedSubSlots[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainBlock
+	if b, err = s.RewardChainBlock.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSPProof
+	if s.ChallengeChainSPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ChallengeChainSPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ChallengeChainIPProof
+	if b, err = s.ChallengeChainIPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainSPProof
+	if s.RewardChainSPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RewardChainSPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainIPProof
+	if b, err = s.RewardChainIPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// InfusedChallengeChainIPProof
+	if s.InfusedChallengeChainIPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.InfusedChallengeChainIPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Foliage
+	if b, err = s.Foliage.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// FoliageTransactionBlock
+	if s.FoliageTransactionBlock == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.FoliageTransactionBlock).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// TransactionsInfo
+	if s.TransactionsInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.TransactionsInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// TransactionsGenerator
+	if s.TransactionsGenerator == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		var custom3 []byte
+		if custom3, err = (*s.TransactionsGenerator).MarshalStreamable(); err != nil {
+			return nil, err
+		}
+		b = append(b, custom3...)
+	}
+	// TransactionsGeneratorRefList
+	n4 := uint32(len(s.TransactionsGeneratorRefList))
+	b = append(b, byte(n4>>24), byte(n4>>16), byte(n4>>8), byte(n4))
+	for i5 := range s.TransactionsGeneratorRefList {
+		b = append(b, byte(s.TransactionsGeneratorRefList[i5]>>24), byte(s.TransactionsGeneratorRefList[i5]>>16), byte(s.TransactionsGeneratorRefList[i5]>>8), byte(s.TransactionsGeneratorRefList[i5]))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for FullBlock
+func (s *FullBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// FinishedSubSlots
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.FinishedSubSlots[0])); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.FinishedSubSlots = make([]EndOfSubSlotBundle, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 EndOfSubSlotBundle
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*EndOfSubSlotBundle)(nil)), start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*EndOfSubSlotBundle)(nil)), start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+		}
+		d.LeaveNested()
+		s.FinishedSubSlots = append(s.FinishedSubSlots, item5)
+	}
+	d.LeaveNested()
+	// RewardChainBlock
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "RewardChainBlock", (*RewardChainBlock)(nil))
+	}
+	if err = s.RewardChainBlock.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start7, "RewardChainBlock", (*RewardChainBlock)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPProof
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ChallengeChainSPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ChallengeChainSPProof)); err != nil {
+			return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+		}
+		s.ChallengeChainSPProof = new(VDFProof)
+		if err = (*s.ChallengeChainSPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainIPProof
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "ChallengeChainIPProof", (*VDFProof)(nil))
+	}
+	if err = s.ChallengeChainIPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start9, "ChallengeChainIPProof", (*VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPProof
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RewardChainSPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RewardChainSPProof)); err != nil {
+			return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+		}
+		s.RewardChainSPProof = new(VDFProof)
+		if err = (*s.RewardChainSPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainIPProof
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "RewardChainIPProof", (*VDFProof)(nil))
+	}
+	if err = s.RewardChainIPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start11, "RewardChainIPProof", (*VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChainIPProof
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainIPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainIPProof)); err != nil {
+			return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+		}
+		s.InfusedChallengeChainIPProof = new(VDFProof)
+		if err = (*s.InfusedChallengeChainIPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// Foliage
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "Foliage", (*Foliage)(nil))
+	}
+	if err = s.Foliage.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start13, "Foliage", (*Foliage)(nil))
+	}
+	d.LeaveNested()
+	// FoliageTransactionBlock
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageTransactionBlock = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageTransactionBlock)); err != nil {
+			return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+		}
+		s.FoliageTransactionBlock = new(FoliageTransactionBlock)
+		if err = (*s.FoliageTransactionBlock).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	d.LeaveNested()
+	// TransactionsInfo
+	start15 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start15, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start15, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.TransactionsInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.TransactionsInfo)); err != nil {
+			return d.FieldError(err, start15, "TransactionsInfo", (**TransactionsInfo)(nil))
+		}
+		s.TransactionsInfo = new(TransactionsInfo)
+		if err = (*s.TransactionsInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start15, "TransactionsInfo", (**TransactionsInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start15, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	d.LeaveNested()
+	// TransactionsGenerator
+	start16 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start16, "TransactionsGenerator", (**SerializedProgram)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start16, "TransactionsGenerator", (**SerializedProgram)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.TransactionsGenerator = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.TransactionsGenerator)); err != nil {
+			return d.FieldError(err, start16, "TransactionsGenerator", (**SerializedProgram)(nil))
+		}
+		s.TransactionsGenerator = new(SerializedProgram)
+		if err = (*s.TransactionsGenerator).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start16, "TransactionsGenerator", (**SerializedProgram)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start16, "TransactionsGenerator", (**SerializedProgram)(nil))
+	}
+	d.LeaveNested()
+	// TransactionsGeneratorRefList
+	start17 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start17, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start17, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+	}
+	n18 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n18, unsafe.Sizeof(s.TransactionsGeneratorRefList[0])); err != nil {
+		return d.FieldError(err, start17, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+	}
+	prealloc19 := n18
+	if prealloc19 > 1024 {
+		prealloc19 = 1024
+	}
+	s.TransactionsGeneratorRefList = make([]uint32, 0, prealloc19)
+	for i20 := uint32(0); i20 < n18; i20++ {
+		var item21 uint32
+		start22 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start22, fmt.Sprintf("[%d]", i20), (*uint32)(nil)), start17, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start22, fmt.Sprintf("[%d]", i20), (*uint32)(nil)), start17, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+		}
+		item21 = uint32(binary.BigEndian.Uint32(b))
+		d.LeaveNested()
+		s.TransactionsGeneratorRefList = append(s.TransactionsGeneratorRefList, item21)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for HeaderBlock
+func (s *HeaderBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of HeaderBlock to b
+func (s *HeaderBlock) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// FinishedSubSlots
+	n1 := uint32(len(s.FinishedSubSlots))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.FinishedSubSlots {
+		if b, err = s.FinishedSubSlots[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainBlock
+	if b, err = s.RewardChainBlock.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSPProof
+	if s.ChallengeChainSPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ChallengeChainSPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ChallengeChainIPProof
+	if b, err = s.ChallengeChainIPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainSPProof
+	if s.RewardChainSPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RewardChainSPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainIPProof
+	if b, err = s.RewardChainIPProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// InfusedChallengeChainIPProof
+	if s.InfusedChallengeChainIPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.InfusedChallengeChainIPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Foliage
+	if b, err = s.Foliage.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// FoliageTransactionBlock
+	if s.FoliageTransactionBlock == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.FoliageTransactionBlock).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// TransactionsFilter
+	n3 := uint32(len(s.TransactionsFilter))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	b = append(b, s.TransactionsFilter...)
+	// TransactionsInfo
+	if s.TransactionsInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.TransactionsInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for HeaderBlock
+func (s *HeaderBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// FinishedSubSlots
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.FinishedSubSlots[0])); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.FinishedSubSlots = make([]EndOfSubSlotBundle, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 EndOfSubSlotBundle
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*EndOfSubSlotBundle)(nil)), start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*EndOfSubSlotBundle)(nil)), start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+		}
+		d.LeaveNested()
+		s.FinishedSubSlots = append(s.FinishedSubSlots, item5)
+	}
+	d.LeaveNested()
+	// RewardChainBlock
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "RewardChainBlock", (*RewardChainBlock)(nil))
+	}
+	if err = s.RewardChainBlock.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start7, "RewardChainBlock", (*RewardChainBlock)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPProof
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ChallengeChainSPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ChallengeChainSPProof)); err != nil {
+			return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+		}
+		s.ChallengeChainSPProof = new(VDFProof)
+		if err = (*s.ChallengeChainSPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainIPProof
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "ChallengeChainIPProof", (*VDFProof)(nil))
+	}
+	if err = s.ChallengeChainIPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start9, "ChallengeChainIPProof", (*VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPProof
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RewardChainSPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RewardChainSPProof)); err != nil {
+			return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+		}
+		s.RewardChainSPProof = new(VDFProof)
+		if err = (*s.RewardChainSPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start10, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainIPProof
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "RewardChainIPProof", (*VDFProof)(nil))
+	}
+	if err = s.RewardChainIPProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start11, "RewardChainIPProof", (*VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChainIPProof
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainIPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainIPProof)); err != nil {
+			return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+		}
+		s.InfusedChallengeChainIPProof = new(VDFProof)
+		if err = (*s.InfusedChallengeChainIPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start12, "InfusedChallengeChainIPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// Foliage
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "Foliage", (*Foliage)(nil))
+	}
+	if err = s.Foliage.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start13, "Foliage", (*Foliage)(nil))
+	}
+	d.LeaveNested()
+	// FoliageTransactionBlock
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageTransactionBlock = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageTransactionBlock)); err != nil {
+			return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+		}
+		s.FoliageTransactionBlock = new(FoliageTransactionBlock)
+		if err = (*s.FoliageTransactionBlock).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start14, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	d.LeaveNested()
+	// TransactionsFilter
+	start15 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start15, "TransactionsFilter", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start15, "TransactionsFilter", (*[]byte)(nil))
+	}
+	n16 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n16); err != nil {
+		return d.FieldError(err, start15, "TransactionsFilter", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n16); err != nil {
+		return d.FieldError(err, start15, "TransactionsFilter", (*[]byte)(nil))
+	}
+	s.TransactionsFilter = b
+	d.LeaveNested()
+	// TransactionsInfo
+	start17 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start17, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start17, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.TransactionsInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.TransactionsInfo)); err != nil {
+			return d.FieldError(err, start17, "TransactionsInfo", (**TransactionsInfo)(nil))
+		}
+		s.TransactionsInfo = new(TransactionsInfo)
+		if err = (*s.TransactionsInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start17, "TransactionsInfo", (**TransactionsInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start17, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for InfusedChallengeChainSubSlot
+func (s *InfusedChallengeChainSubSlot) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of InfusedChallengeChainSubSlot to b
+func (s *InfusedChallengeChainSubSlot) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// InfusedChallengeChainEndOfSlotVDF
+	if b, err = s.InfusedChallengeChainEndOfSlotVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for InfusedChallengeChainSubSlot
+func (s *InfusedChallengeChainSubSlot) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+
+	// InfusedChallengeChainEndOfSlotVDF
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "InfusedChallengeChainEndOfSlotVDF", (*VDFInfo)(nil))
+	}
+	if err = s.InfusedChallengeChainEndOfSlotVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "InfusedChallengeChainEndOfSlotVDF", (*VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for PoolTarget
+func (s *PoolTarget) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of PoolTarget to b
+func (s *PoolTarget) AppendStreamable(b []byte) ([]byte, error) {
+	// PuzzleHash
+	b = append(b, s.PuzzleHash[:]...)
+	// MaxHeight
+	b = append(b, byte(s.MaxHeight>>24), byte(s.MaxHeight>>16), byte(s.MaxHeight>>8), byte(s.MaxHeight))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for PoolTarget
+func (s *PoolTarget) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PuzzleHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PuzzleHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "PuzzleHash", (*Bytes32)(nil))
+	}
+	copy(s.PuzzleHash[:], b)
+	d.LeaveNested()
+	// MaxHeight
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "MaxHeight", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "MaxHeight", (*uint32)(nil))
+	}
+	s.MaxHeight = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for ProofOfSpace
+func (s *ProofOfSpace) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of ProofOfSpace to b
+func (s *ProofOfSpace) AppendStreamable(b []byte) ([]byte, error) {
+	// Challenge
+	b = append(b, s.Challenge[:]...)
+	// PoolPublicKey
+	if s.PoolPublicKey == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.PoolPublicKey)[:]...)
+	}
+	// PoolContractPuzzleHash
+	if s.PoolContractPuzzleHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.PoolContractPuzzleHash)[:]...)
+	}
+	// PlotPublicKey
+	b = append(b, s.PlotPublicKey[:]...)
+	// Size
+	b = append(b, byte(s.Size))
+	// Proof
+	n1 := uint32(len(s.Proof))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Proof...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for ProofOfSpace
+func (s *ProofOfSpace) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Challenge
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Challenge", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "Challenge", (*Bytes32)(nil))
+	}
+	copy(s.Challenge[:], b)
+	d.LeaveNested()
+	// PoolPublicKey
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "PoolPublicKey", (**G1Element)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "PoolPublicKey", (**G1Element)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PoolPublicKey = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PoolPublicKey)); err != nil {
+			return d.FieldError(err, start2, "PoolPublicKey", (**G1Element)(nil))
+		}
+		s.PoolPublicKey = new(G1Element)
+		if b, err = d.Next(48); err != nil {
+			return d.FieldError(err, start2, "PoolPublicKey", (**G1Element)(nil))
+		}
+		copy((*s.PoolPublicKey)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "PoolPublicKey", (**G1Element)(nil))
+	}
+	d.LeaveNested()
+	// PoolContractPuzzleHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "PoolContractPuzzleHash", (**Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "PoolContractPuzzleHash", (**Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.PoolContractPuzzleHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.PoolContractPuzzleHash)); err != nil {
+			return d.FieldError(err, start3, "PoolContractPuzzleHash", (**Bytes32)(nil))
+		}
+		s.PoolContractPuzzleHash = new(Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start3, "PoolContractPuzzleHash", (**Bytes32)(nil))
+		}
+		copy((*s.PoolContractPuzzleHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "PoolContractPuzzleHash", (**Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// PlotPublicKey
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "PlotPublicKey", (*G1Element)(nil))
+	}
+	if b, err = d.Next(48); err != nil {
+		return d.FieldError(err, start4, "PlotPublicKey", (*G1Element)(nil))
+	}
+	copy(s.PlotPublicKey[:], b)
+	d.LeaveNested()
+	// Size
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "Size", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "Size", (*uint8)(nil))
+	}
+	s.Size = uint8(b[0])
+	d.LeaveNested()
+	// Proof
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "Proof", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start6, "Proof", (*[]byte)(nil))
+	}
+	n7 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n7); err != nil {
+		return d.FieldError(err, start6, "Proof", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n7); err != nil {
+		return d.FieldError(err, start6, "Proof", (*[]byte)(nil))
+	}
+	s.Proof = b
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RewardChainBlock
+func (s *RewardChainBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RewardChainBlock to b
+func (s *RewardChainBlock) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Weight
+	b = append(b, s.Weight.Bytes()...)
+	// Height
+	b = append(b, byte(s.Height>>24), byte(s.Height>>16), byte(s.Height>>8), byte(s.Height))
+	// TotalIters
+	b = append(b, s.TotalIters.Bytes()...)
+	// SignagePointIndex
+	b = append(b, byte(s.SignagePointIndex))
+	// PosSSCCChallengeHash
+	b = append(b, s.PosSSCCChallengeHash[:]...)
+	// ProofOfSpace
+	if b, err = s.ProofOfSpace.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSPVDF
+	if s.ChallengeChainSPVDF == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ChallengeChainSPVDF).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ChallengeChainSPSignature
+	b = append(b, s.ChallengeChainSPSignature[:]...)
+	// ChallengeChainIPVDF
+	if b, err = s.ChallengeChainIPVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// RewardChainSPVDF
+	if s.RewardChainSPVDF == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RewardChainSPVDF).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainSPSignature
+	b = append(b, s.RewardChainSPSignature[:]...)
+	// RewardChainIPVDF
+	if b, err = s.RewardChainIPVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// InfusedChallengeChainIPVDF
+	if s.InfusedChallengeChainIPVDF == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.InfusedChallengeChainIPVDF).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// IsTransactionBlock
+	if s.IsTransactionBlock {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RewardChainBlock
+func (s *RewardChainBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Weight
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Weight", (*Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start1, "Weight", (*Uint128)(nil))
+	}
+	if s.Weight, err = BytesToUint128(b); err != nil {
+		return d.FieldError(err, start1, "Weight", (*Uint128)(nil))
+	}
+	d.LeaveNested()
+	// Height
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Height", (*uint32)(nil))
+	}
+	s.Height = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// TotalIters
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "TotalIters", (*Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start3, "TotalIters", (*Uint128)(nil))
+	}
+	if s.TotalIters, err = BytesToUint128(b); err != nil {
+		return d.FieldError(err, start3, "TotalIters", (*Uint128)(nil))
+	}
+	d.LeaveNested()
+	// SignagePointIndex
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "SignagePointIndex", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "SignagePointIndex", (*uint8)(nil))
+	}
+	s.SignagePointIndex = uint8(b[0])
+	d.LeaveNested()
+	// PosSSCCChallengeHash
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "PosSSCCChallengeHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start5, "PosSSCCChallengeHash", (*Bytes32)(nil))
+	}
+	copy(s.PosSSCCChallengeHash[:], b)
+	d.LeaveNested()
+	// ProofOfSpace
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "ProofOfSpace", (*ProofOfSpace)(nil))
+	}
+	if err = s.ProofOfSpace.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start6, "ProofOfSpace", (*ProofOfSpace)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPVDF
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start7, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ChallengeChainSPVDF = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ChallengeChainSPVDF)); err != nil {
+			return d.FieldError(err, start7, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+		}
+		s.ChallengeChainSPVDF = new(VDFInfo)
+		if err = (*s.ChallengeChainSPVDF).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start7, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start7, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPSignature
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPSignature", (*G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPSignature", (*G2Element)(nil))
+	}
+	copy(s.ChallengeChainSPSignature[:], b)
+	d.LeaveNested()
+	// ChallengeChainIPVDF
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "ChallengeChainIPVDF", (*VDFInfo)(nil))
+	}
+	if err = s.ChallengeChainIPVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start9, "ChallengeChainIPVDF", (*VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPVDF
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "RewardChainSPVDF", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "RewardChainSPVDF", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RewardChainSPVDF = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RewardChainSPVDF)); err != nil {
+			return d.FieldError(err, start10, "RewardChainSPVDF", (**VDFInfo)(nil))
+		}
+		s.RewardChainSPVDF = new(VDFInfo)
+		if err = (*s.RewardChainSPVDF).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start10, "RewardChainSPVDF", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start10, "RewardChainSPVDF", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPSignature
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "RewardChainSPSignature", (*G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start11, "RewardChainSPSignature", (*G2Element)(nil))
+	}
+	copy(s.RewardChainSPSignature[:], b)
+	d.LeaveNested()
+	// RewardChainIPVDF
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "RewardChainIPVDF", (*VDFInfo)(nil))
+	}
+	if err = s.RewardChainIPVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start12, "RewardChainIPVDF", (*VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChainIPVDF
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "InfusedChallengeChainIPVDF", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start13, "InfusedChallengeChainIPVDF", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainIPVDF = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainIPVDF)); err != nil {
+			return d.FieldError(err, start13, "InfusedChallengeChainIPVDF", (**VDFInfo)(nil))
+		}
+		s.InfusedChallengeChainIPVDF = new(VDFInfo)
+		if err = (*s.InfusedChallengeChainIPVDF).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start13, "InfusedChallengeChainIPVDF", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start13, "InfusedChallengeChainIPVDF", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// IsTransactionBlock
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "IsTransactionBlock", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start14, "IsTransactionBlock", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.IsTransactionBlock = false
+	case 1:
+		s.IsTransactionBlock = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start14, "IsTransactionBlock", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RewardChainBlockUnfinished
+func (s *RewardChainBlockUnfinished) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RewardChainBlockUnfinished to b
+func (s *RewardChainBlockUnfinished) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// TotalIters
+	b = append(b, s.TotalIters.Bytes()...)
+	// SignagePointIndex
+	b = append(b, byte(s.SignagePointIndex))
+	// PosSSCCChallengeHash
+	b = append(b, s.PosSSCCChallengeHash[:]...)
+	// ProofOfSpace
+	if b, err = s.ProofOfSpace.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSPVDF
+	if s.ChallengeChainSPVDF == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ChallengeChainSPVDF).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ChallengeChainSPSignature
+	b = append(b, s.ChallengeChainSPSignature[:]...)
+	// RewardChainSPVDF
+	if s.RewardChainSPVDF == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RewardChainSPVDF).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainSPSignature
+	b = append(b, s.RewardChainSPSignature[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RewardChainBlockUnfinished
+func (s *RewardChainBlockUnfinished) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// TotalIters
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "TotalIters", (*Uint128)(nil))
+	}
+	if b, err = d.Next(16); err != nil {
+		return d.FieldError(err, start1, "TotalIters", (*Uint128)(nil))
+	}
+	if s.TotalIters, err = BytesToUint128(b); err != nil {
+		return d.FieldError(err, start1, "TotalIters", (*Uint128)(nil))
+	}
+	d.LeaveNested()
+	// SignagePointIndex
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "SignagePointIndex", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "SignagePointIndex", (*uint8)(nil))
+	}
+	s.SignagePointIndex = uint8(b[0])
+	d.LeaveNested()
+	// PosSSCCChallengeHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "PosSSCCChallengeHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start3, "PosSSCCChallengeHash", (*Bytes32)(nil))
+	}
+	copy(s.PosSSCCChallengeHash[:], b)
+	d.LeaveNested()
+	// ProofOfSpace
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "ProofOfSpace", (*ProofOfSpace)(nil))
+	}
+	if err = s.ProofOfSpace.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start4, "ProofOfSpace", (*ProofOfSpace)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPVDF
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ChallengeChainSPVDF = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ChallengeChainSPVDF)); err != nil {
+			return d.FieldError(err, start5, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+		}
+		s.ChallengeChainSPVDF = new(VDFInfo)
+		if err = (*s.ChallengeChainSPVDF).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start5, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "ChallengeChainSPVDF", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPSignature
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "ChallengeChainSPSignature", (*G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start6, "ChallengeChainSPSignature", (*G2Element)(nil))
+	}
+	copy(s.ChallengeChainSPSignature[:], b)
+	d.LeaveNested()
+	// RewardChainSPVDF
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "RewardChainSPVDF", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start7, "RewardChainSPVDF", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RewardChainSPVDF = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RewardChainSPVDF)); err != nil {
+			return d.FieldError(err, start7, "RewardChainSPVDF", (**VDFInfo)(nil))
+		}
+		s.RewardChainSPVDF = new(VDFInfo)
+		if err = (*s.RewardChainSPVDF).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start7, "RewardChainSPVDF", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start7, "RewardChainSPVDF", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPSignature
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "RewardChainSPSignature", (*G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start8, "RewardChainSPSignature", (*G2Element)(nil))
+	}
+	copy(s.RewardChainSPSignature[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for RewardChainSubSlot
+func (s *RewardChainSubSlot) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of RewardChainSubSlot to b
+func (s *RewardChainSubSlot) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// EndOfSlotVDF
+	if b, err = s.EndOfSlotVDF.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSubSlotHash
+	b = append(b, s.ChallengeChainSubSlotHash[:]...)
+	// InfusedChallengeChainSubSlotHash
+	if s.InfusedChallengeChainSubSlotHash == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.InfusedChallengeChainSubSlotHash)[:]...)
+	}
+	// Deficit
+	b = append(b, byte(s.Deficit))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for RewardChainSubSlot
+func (s *RewardChainSubSlot) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// EndOfSlotVDF
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "EndOfSlotVDF", (*VDFInfo)(nil))
+	}
+	if err = s.EndOfSlotVDF.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "EndOfSlotVDF", (*VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSubSlotHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSubSlotHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "ChallengeChainSubSlotHash", (*Bytes32)(nil))
+	}
+	copy(s.ChallengeChainSubSlotHash[:], b)
+	d.LeaveNested()
+	// InfusedChallengeChainSubSlotHash
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainSubSlotHash = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainSubSlotHash)); err != nil {
+			return d.FieldError(err, start3, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+		}
+		s.InfusedChallengeChainSubSlotHash = new(Bytes32)
+		if b, err = d.Next(32); err != nil {
+			return d.FieldError(err, start3, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+		}
+		copy((*s.InfusedChallengeChainSubSlotHash)[:], b)
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "InfusedChallengeChainSubSlotHash", (**Bytes32)(nil))
+	}
+	d.LeaveNested()
+	// Deficit
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Deficit", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "Deficit", (*uint8)(nil))
+	}
+	s.Deficit = uint8(b[0])
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SpendBundle
+func (s *SpendBundle) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SpendBundle to b
+func (s *SpendBundle) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// CoinSpends
+	n1 := uint32(len(s.CoinSpends))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.CoinSpends {
+		if b, err = s.CoinSpends[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// AggregatedSignature
+	b = append(b, s.AggregatedSignature[:]...)
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SpendBundle
+func (s *SpendBundle) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// CoinSpends
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "CoinSpends", (*[]CoinSpend)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "CoinSpends", (*[]CoinSpend)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.CoinSpends[0])); err != nil {
+		return d.FieldError(err, start1, "CoinSpends", (*[]CoinSpend)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.CoinSpends = make([]CoinSpend, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 CoinSpend
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*CoinSpend)(nil)), start1, "CoinSpends", (*[]CoinSpend)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*CoinSpend)(nil)), start1, "CoinSpends", (*[]CoinSpend)(nil))
+		}
+		d.LeaveNested()
+		s.CoinSpends = append(s.CoinSpends, item5)
+	}
+	d.LeaveNested()
+	// AggregatedSignature
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "AggregatedSignature", (*G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start7, "AggregatedSignature", (*G2Element)(nil))
+	}
+	copy(s.AggregatedSignature[:], b)
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SubEpochChallengeSegment
+func (s *SubEpochChallengeSegment) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SubEpochChallengeSegment to b
+func (s *SubEpochChallengeSegment) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// SubEpochN
+	b = append(b, byte(s.SubEpochN>>24), byte(s.SubEpochN>>16), byte(s.SubEpochN>>8), byte(s.SubEpochN))
+	// SubSlots
+	n1 := uint32(len(s.SubSlots))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.SubSlots {
+		if b, err = s.SubSlots[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RCSlotEndInfo
+	if s.RCSlotEndInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RCSlotEndInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SubEpochChallengeSegment
+func (s *SubEpochChallengeSegment) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// SubEpochN
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "SubEpochN", (*uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "SubEpochN", (*uint32)(nil))
+	}
+	s.SubEpochN = uint32(binary.BigEndian.Uint32(b))
+	d.LeaveNested()
+	// SubSlots
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "SubSlots", (*[]SubSlotData)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "SubSlots", (*[]SubSlotData)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n3, unsafe.Sizeof(s.SubSlots[0])); err != nil {
+		return d.FieldError(err, start2, "SubSlots", (*[]SubSlotData)(nil))
+	}
+	prealloc4 := n3
+	if prealloc4 > 1024 {
+		prealloc4 = 1024
+	}
+	s.SubSlots = make([]SubSlotData, 0, prealloc4)
+	for i5 := uint32(0); i5 < n3; i5++ {
+		var item6 SubSlotData
+		start7 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*SubSlotData)(nil)), start2, "SubSlots", (*[]SubSlotData)(nil))
+		}
+		if err = item6.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start7, fmt.Sprintf("[%d]", i5), (*SubSlotData)(nil)), start2, "SubSlots", (*[]SubSlotData)(nil))
+		}
+		d.LeaveNested()
+		s.SubSlots = append(s.SubSlots, item6)
+	}
+	d.LeaveNested()
+	// RCSlotEndInfo
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "RCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "RCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RCSlotEndInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RCSlotEndInfo)); err != nil {
+			return d.FieldError(err, start8, "RCSlotEndInfo", (**VDFInfo)(nil))
+		}
+		s.RCSlotEndInfo = new(VDFInfo)
+		if err = (*s.RCSlotEndInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start8, "RCSlotEndInfo", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start8, "RCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SubEpochData
+func (s *SubEpochData) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SubEpochData to b
+func (s *SubEpochData) AppendStreamable(b []byte) ([]byte, error) {
+	// RewardChainHash
+	b = append(b, s.RewardChainHash[:]...)
+	// NumBlocksOverflow
+	b = append(b, byte(s.NumBlocksOverflow))
+	// NewSubSlotIters
+	if s.NewSubSlotIters == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.NewSubSlotIters)>>56), byte((*s.NewSubSlotIters)>>48), byte((*s.NewSubSlotIters)>>40), byte((*s.NewSubSlotIters)>>32), byte((*s.NewSubSlotIters)>>24), byte((*s.NewSubSlotIters)>>16), byte((*s.NewSubSlotIters)>>8), byte((*s.NewSubSlotIters)))
+	}
+	// NewDifficulty
+	if s.NewDifficulty == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.NewDifficulty)>>56), byte((*s.NewDifficulty)>>48), byte((*s.NewDifficulty)>>40), byte((*s.NewDifficulty)>>32), byte((*s.NewDifficulty)>>24), byte((*s.NewDifficulty)>>16), byte((*s.NewDifficulty)>>8), byte((*s.NewDifficulty)))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SubEpochData
+func (s *SubEpochData) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// RewardChainHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "RewardChainHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "RewardChainHash", (*Bytes32)(nil))
+	}
+	copy(s.RewardChainHash[:], b)
+	d.LeaveNested()
+	// NumBlocksOverflow
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "NumBlocksOverflow", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "NumBlocksOverflow", (*uint8)(nil))
+	}
+	s.NumBlocksOverflow = uint8(b[0])
+	d.LeaveNested()
+	// NewSubSlotIters
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "NewSubSlotIters", (**uint64)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "NewSubSlotIters", (**uint64)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.NewSubSlotIters = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.NewSubSlotIters)); err != nil {
+			return d.FieldError(err, start3, "NewSubSlotIters", (**uint64)(nil))
+		}
+		s.NewSubSlotIters = new(uint64)
+		if b, err = d.Next(8); err != nil {
+			return d.FieldError(err, start3, "NewSubSlotIters", (**uint64)(nil))
+		}
+		(*s.NewSubSlotIters) = uint64(binary.BigEndian.Uint64(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "NewSubSlotIters", (**uint64)(nil))
+	}
+	d.LeaveNested()
+	// NewDifficulty
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.NewDifficulty = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.NewDifficulty)); err != nil {
+			return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+		}
+		s.NewDifficulty = new(uint64)
+		if b, err = d.Next(8); err != nil {
+			return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+		}
+		(*s.NewDifficulty) = uint64(binary.BigEndian.Uint64(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SubEpochSummary
+func (s *SubEpochSummary) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SubEpochSummary to b
+func (s *SubEpochSummary) AppendStreamable(b []byte) ([]byte, error) {
+	// PrevSubepochSummaryHash
+	b = append(b, s.PrevSubepochSummaryHash[:]...)
+	// RewardChainHash
+	b = append(b, s.RewardChainHash[:]...)
+	// NumBlocksOverflow
+	b = append(b, byte(s.NumBlocksOverflow))
+	// NewDifficulty
+	if s.NewDifficulty == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.NewDifficulty)>>56), byte((*s.NewDifficulty)>>48), byte((*s.NewDifficulty)>>40), byte((*s.NewDifficulty)>>32), byte((*s.NewDifficulty)>>24), byte((*s.NewDifficulty)>>16), byte((*s.NewDifficulty)>>8), byte((*s.NewDifficulty)))
+	}
+	// NewSubSlotIters
+	if s.NewSubSlotIters == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.NewSubSlotIters)>>56), byte((*s.NewSubSlotIters)>>48), byte((*s.NewSubSlotIters)>>40), byte((*s.NewSubSlotIters)>>32), byte((*s.NewSubSlotIters)>>24), byte((*s.NewSubSlotIters)>>16), byte((*s.NewSubSlotIters)>>8), byte((*s.NewSubSlotIters)))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SubEpochSummary
+func (s *SubEpochSummary) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// PrevSubepochSummaryHash
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "PrevSubepochSummaryHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "PrevSubepochSummaryHash", (*Bytes32)(nil))
+	}
+	copy(s.PrevSubepochSummaryHash[:], b)
+	d.LeaveNested()
+	// RewardChainHash
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "RewardChainHash", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "RewardChainHash", (*Bytes32)(nil))
+	}
+	copy(s.RewardChainHash[:], b)
+	d.LeaveNested()
+	// NumBlocksOverflow
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "NumBlocksOverflow", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "NumBlocksOverflow", (*uint8)(nil))
+	}
+	s.NumBlocksOverflow = uint8(b[0])
+	d.LeaveNested()
+	// NewDifficulty
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.NewDifficulty = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.NewDifficulty)); err != nil {
+			return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+		}
+		s.NewDifficulty = new(uint64)
+		if b, err = d.Next(8); err != nil {
+			return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+		}
+		(*s.NewDifficulty) = uint64(binary.BigEndian.Uint64(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "NewDifficulty", (**uint64)(nil))
+	}
+	d.LeaveNested()
+	// NewSubSlotIters
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "NewSubSlotIters", (**uint64)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "NewSubSlotIters", (**uint64)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.NewSubSlotIters = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.NewSubSlotIters)); err != nil {
+			return d.FieldError(err, start5, "NewSubSlotIters", (**uint64)(nil))
+		}
+		s.NewSubSlotIters = new(uint64)
+		if b, err = d.Next(8); err != nil {
+			return d.FieldError(err, start5, "NewSubSlotIters", (**uint64)(nil))
+		}
+		(*s.NewSubSlotIters) = uint64(binary.BigEndian.Uint64(b))
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "NewSubSlotIters", (**uint64)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SubSlotData
+func (s *SubSlotData) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SubSlotData to b
+func (s *SubSlotData) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// ProofOfSpace
+	if s.ProofOfSpace == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ProofOfSpace).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// CCSignagePoint
+	if s.CCSignagePoint == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.CCSignagePoint).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// CCInfusionPoint
+	if s.CCInfusionPoint == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.CCInfusionPoint).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ICCInfusionPoint
+	if s.ICCInfusionPoint == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ICCInfusionPoint).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// CCSPVDFInfo
+	if s.CCSPVDFInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.CCSPVDFInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// SignagePointIndex
+	if s.SignagePointIndex == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, byte((*s.SignagePointIndex)))
+	}
+	// CCSlotEnd
+	if s.CCSlotEnd == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.CCSlotEnd).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ICCSlotEnd
+	if s.ICCSlotEnd == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ICCSlotEnd).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// CCSlotEndInfo
+	if s.CCSlotEndInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.CCSlotEndInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ICCSlotEndInfo
+	if s.ICCSlotEndInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ICCSlotEndInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// CCIPVDFInfo
+	if s.CCIPVDFInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.CCIPVDFInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// ICCIPVDFInfo
+	if s.ICCIPVDFInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ICCIPVDFInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// TotalIters
+	if s.TotalIters == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		b = append(b, (*s.TotalIters).Bytes()...)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SubSlotData
+func (s *SubSlotData) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ProofOfSpace
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ProofOfSpace", (**ProofOfSpace)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "ProofOfSpace", (**ProofOfSpace)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ProofOfSpace = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ProofOfSpace)); err != nil {
+			return d.FieldError(err, start1, "ProofOfSpace", (**ProofOfSpace)(nil))
+		}
+		s.ProofOfSpace = new(ProofOfSpace)
+		if err = (*s.ProofOfSpace).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start1, "ProofOfSpace", (**ProofOfSpace)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start1, "ProofOfSpace", (**ProofOfSpace)(nil))
+	}
+	d.LeaveNested()
+	// CCSignagePoint
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "CCSignagePoint", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "CCSignagePoint", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CCSignagePoint = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CCSignagePoint)); err != nil {
+			return d.FieldError(err, start2, "CCSignagePoint", (**VDFProof)(nil))
+		}
+		s.CCSignagePoint = new(VDFProof)
+		if err = (*s.CCSignagePoint).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start2, "CCSignagePoint", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "CCSignagePoint", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// CCInfusionPoint
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "CCInfusionPoint", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start3, "CCInfusionPoint", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CCInfusionPoint = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CCInfusionPoint)); err != nil {
+			return d.FieldError(err, start3, "CCInfusionPoint", (**VDFProof)(nil))
+		}
+		s.CCInfusionPoint = new(VDFProof)
+		if err = (*s.CCInfusionPoint).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start3, "CCInfusionPoint", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start3, "CCInfusionPoint", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// ICCInfusionPoint
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "ICCInfusionPoint", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "ICCInfusionPoint", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ICCInfusionPoint = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ICCInfusionPoint)); err != nil {
+			return d.FieldError(err, start4, "ICCInfusionPoint", (**VDFProof)(nil))
+		}
+		s.ICCInfusionPoint = new(VDFProof)
+		if err = (*s.ICCInfusionPoint).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start4, "ICCInfusionPoint", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start4, "ICCInfusionPoint", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// CCSPVDFInfo
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "CCSPVDFInfo", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start5, "CCSPVDFInfo", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CCSPVDFInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CCSPVDFInfo)); err != nil {
+			return d.FieldError(err, start5, "CCSPVDFInfo", (**VDFInfo)(nil))
+		}
+		s.CCSPVDFInfo = new(VDFInfo)
+		if err = (*s.CCSPVDFInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start5, "CCSPVDFInfo", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start5, "CCSPVDFInfo", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// SignagePointIndex
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "SignagePointIndex", (**uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start6, "SignagePointIndex", (**uint8)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.SignagePointIndex = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.SignagePointIndex)); err != nil {
+			return d.FieldError(err, start6, "SignagePointIndex", (**uint8)(nil))
+		}
+		s.SignagePointIndex = new(uint8)
+		if b, err = d.Next(1); err != nil {
+			return d.FieldError(err, start6, "SignagePointIndex", (**uint8)(nil))
+		}
+		(*s.SignagePointIndex) = uint8(b[0])
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start6, "SignagePointIndex", (**uint8)(nil))
+	}
+	d.LeaveNested()
+	// CCSlotEnd
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "CCSlotEnd", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start7, "CCSlotEnd", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CCSlotEnd = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CCSlotEnd)); err != nil {
+			return d.FieldError(err, start7, "CCSlotEnd", (**VDFProof)(nil))
+		}
+		s.CCSlotEnd = new(VDFProof)
+		if err = (*s.CCSlotEnd).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start7, "CCSlotEnd", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start7, "CCSlotEnd", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// ICCSlotEnd
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "ICCSlotEnd", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "ICCSlotEnd", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ICCSlotEnd = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ICCSlotEnd)); err != nil {
+			return d.FieldError(err, start8, "ICCSlotEnd", (**VDFProof)(nil))
+		}
+		s.ICCSlotEnd = new(VDFProof)
+		if err = (*s.ICCSlotEnd).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start8, "ICCSlotEnd", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start8, "ICCSlotEnd", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// CCSlotEndInfo
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "CCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "CCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CCSlotEndInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CCSlotEndInfo)); err != nil {
+			return d.FieldError(err, start9, "CCSlotEndInfo", (**VDFInfo)(nil))
+		}
+		s.CCSlotEndInfo = new(VDFInfo)
+		if err = (*s.CCSlotEndInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start9, "CCSlotEndInfo", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start9, "CCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ICCSlotEndInfo
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "ICCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start10, "ICCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ICCSlotEndInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ICCSlotEndInfo)); err != nil {
+			return d.FieldError(err, start10, "ICCSlotEndInfo", (**VDFInfo)(nil))
+		}
+		s.ICCSlotEndInfo = new(VDFInfo)
+		if err = (*s.ICCSlotEndInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start10, "ICCSlotEndInfo", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start10, "ICCSlotEndInfo", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// CCIPVDFInfo
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "CCIPVDFInfo", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start11, "CCIPVDFInfo", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.CCIPVDFInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.CCIPVDFInfo)); err != nil {
+			return d.FieldError(err, start11, "CCIPVDFInfo", (**VDFInfo)(nil))
+		}
+		s.CCIPVDFInfo = new(VDFInfo)
+		if err = (*s.CCIPVDFInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start11, "CCIPVDFInfo", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start11, "CCIPVDFInfo", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// ICCIPVDFInfo
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "ICCIPVDFInfo", (**VDFInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start12, "ICCIPVDFInfo", (**VDFInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ICCIPVDFInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ICCIPVDFInfo)); err != nil {
+			return d.FieldError(err, start12, "ICCIPVDFInfo", (**VDFInfo)(nil))
+		}
+		s.ICCIPVDFInfo = new(VDFInfo)
+		if err = (*s.ICCIPVDFInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start12, "ICCIPVDFInfo", (**VDFInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start12, "ICCIPVDFInfo", (**VDFInfo)(nil))
+	}
+	d.LeaveNested()
+	// TotalIters
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "TotalIters", (**Uint128)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start13, "TotalIters", (**Uint128)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.TotalIters = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.TotalIters)); err != nil {
+			return d.FieldError(err, start13, "TotalIters", (**Uint128)(nil))
+		}
+		s.TotalIters = new(Uint128)
+		if b, err = d.Next(16); err != nil {
+			return d.FieldError(err, start13, "TotalIters", (**Uint128)(nil))
+		}
+		if (*s.TotalIters), err = BytesToUint128(b); err != nil {
+			return d.FieldError(err, start13, "TotalIters", (**Uint128)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start13, "TotalIters", (**Uint128)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for SubSlotProofs
+func (s *SubSlotProofs) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of SubSlotProofs to b
+func (s *SubSlotProofs) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// ChallengeChainSlotProof
+	if b, err = s.ChallengeChainSlotProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// InfusedChallengeChainSlotProof
+	if s.InfusedChallengeChainSlotProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.InfusedChallengeChainSlotProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainSlotProof
+	if b, err = s.RewardChainSlotProof.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for SubSlotProofs
+func (s *SubSlotProofs) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// ChallengeChainSlotProof
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "ChallengeChainSlotProof", (*VDFProof)(nil))
+	}
+	if err = s.ChallengeChainSlotProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start1, "ChallengeChainSlotProof", (*VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// InfusedChallengeChainSlotProof
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "InfusedChallengeChainSlotProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start2, "InfusedChallengeChainSlotProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.InfusedChallengeChainSlotProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.InfusedChallengeChainSlotProof)); err != nil {
+			return d.FieldError(err, start2, "InfusedChallengeChainSlotProof", (**VDFProof)(nil))
+		}
+		s.InfusedChallengeChainSlotProof = new(VDFProof)
+		if err = (*s.InfusedChallengeChainSlotProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start2, "InfusedChallengeChainSlotProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start2, "InfusedChallengeChainSlotProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSlotProof
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "RewardChainSlotProof", (*VDFProof)(nil))
+	}
+	if err = s.RewardChainSlotProof.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "RewardChainSlotProof", (*VDFProof)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for TimestampedPeerInfo
+func (s *TimestampedPeerInfo) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of TimestampedPeerInfo to b
+func (s *TimestampedPeerInfo) AppendStreamable(b []byte) ([]byte, error) {
+	// Host
+	n1 := uint32(len(s.Host))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, string(s.Host)...)
+	// Port
+	b = append(b, byte(s.Port>>8), byte(s.Port))
+	// Timestamp
+	b = append(b, byte(s.Timestamp>>56), byte(s.Timestamp>>48), byte(s.Timestamp>>40), byte(s.Timestamp>>32), byte(s.Timestamp>>24), byte(s.Timestamp>>16), byte(s.Timestamp>>8), byte(s.Timestamp))
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for TimestampedPeerInfo
+func (s *TimestampedPeerInfo) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Host
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Host", (*string)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "Host", (*string)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n2); err != nil {
+		return d.FieldError(err, start1, "Host", (*string)(nil))
+	}
+	if b, err = d.Next(uint(n2)); err != nil {
+		return d.FieldError(err, start1, "Host", (*string)(nil))
+	}
+	s.Host = string(b)
+	d.LeaveNested()
+	// Port
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Port", (*uint16)(nil))
+	}
+	if b, err = d.Next(2); err != nil {
+		return d.FieldError(err, start3, "Port", (*uint16)(nil))
+	}
+	s.Port = uint16(binary.BigEndian.Uint16(b))
+	d.LeaveNested()
+	// Timestamp
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Timestamp", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start4, "Timestamp", (*uint64)(nil))
+	}
+	s.Timestamp = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for TransactionsInfo
+func (s *TransactionsInfo) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of TransactionsInfo to b
+func (s *TransactionsInfo) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// GeneratorRoot
+	b = append(b, s.GeneratorRoot[:]...)
+	// GeneratorRefsRoot
+	b = append(b, s.GeneratorRefsRoot[:]...)
+	// AggregatedSignature
+	b = append(b, s.AggregatedSignature[:]...)
+	// Fees
+	b = append(b, byte(s.Fees>>56), byte(s.Fees>>48), byte(s.Fees>>40), byte(s.Fees>>32), byte(s.Fees>>24), byte(s.Fees>>16), byte(s.Fees>>8), byte(s.Fees))
+	// Cost
+	b = append(b, byte(s.Cost>>56), byte(s.Cost>>48), byte(s.Cost>>40), byte(s.Cost>>32), byte(s.Cost>>24), byte(s.Cost>>16), byte(s.Cost>>8), byte(s.Cost))
+	// RewardClaimsIncorporated
+	n1 := uint32(len(s.RewardClaimsIncorporated))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.RewardClaimsIncorporated {
+		if b, err = s.RewardClaimsIncorporated[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for TransactionsInfo
+func (s *TransactionsInfo) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// GeneratorRoot
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "GeneratorRoot", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "GeneratorRoot", (*Bytes32)(nil))
+	}
+	copy(s.GeneratorRoot[:], b)
+	d.LeaveNested()
+	// GeneratorRefsRoot
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "GeneratorRefsRoot", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start2, "GeneratorRefsRoot", (*Bytes32)(nil))
+	}
+	copy(s.GeneratorRefsRoot[:], b)
+	d.LeaveNested()
+	// AggregatedSignature
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "AggregatedSignature", (*G2Element)(nil))
+	}
+	if b, err = d.Next(96); err != nil {
+		return d.FieldError(err, start3, "AggregatedSignature", (*G2Element)(nil))
+	}
+	copy(s.AggregatedSignature[:], b)
+	d.LeaveNested()
+	// Fees
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "Fees", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start4, "Fees", (*uint64)(nil))
+	}
+	s.Fees = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// Cost
+	start5 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start5, "Cost", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start5, "Cost", (*uint64)(nil))
+	}
+	s.Cost = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// RewardClaimsIncorporated
+	start6 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start6, "RewardClaimsIncorporated", (*[]Coin)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start6, "RewardClaimsIncorporated", (*[]Coin)(nil))
+	}
+	n7 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n7, unsafe.Sizeof(s.RewardClaimsIncorporated[0])); err != nil {
+		return d.FieldError(err, start6, "RewardClaimsIncorporated", (*[]Coin)(nil))
+	}
+	prealloc8 := n7
+	if prealloc8 > 1024 {
+		prealloc8 = 1024
+	}
+	s.RewardClaimsIncorporated = make([]Coin, 0, prealloc8)
+	for i9 := uint32(0); i9 < n7; i9++ {
+		var item10 Coin
+		start11 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start11, fmt.Sprintf("[%d]", i9), (*Coin)(nil)), start6, "RewardClaimsIncorporated", (*[]Coin)(nil))
+		}
+		if err = item10.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start11, fmt.Sprintf("[%d]", i9), (*Coin)(nil)), start6, "RewardClaimsIncorporated", (*[]Coin)(nil))
+		}
+		d.LeaveNested()
+		s.RewardClaimsIncorporated = append(s.RewardClaimsIncorporated, item10)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for UnfinishedBlock
+func (s *UnfinishedBlock) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of UnfinishedBlock to b
+func (s *UnfinishedBlock) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// FinishedSubSlots
+	n1 := uint32(len(s.FinishedSubSlots))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.FinishedSubSlots {
+		if b, err = s.FinishedSubSlots[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainBlock
+	if b, err = s.RewardChainBlock.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// ChallengeChainSPProof
+	if s.ChallengeChainSPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.ChallengeChainSPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RewardChainSPProof
+	if s.RewardChainSPProof == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.RewardChainSPProof).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// Foliage
+	if b, err = s.Foliage.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	// FoliageTransactionBlock
+	if s.FoliageTransactionBlock == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.FoliageTransactionBlock).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// TransactionsInfo
+	if s.TransactionsInfo == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = (*s.TransactionsInfo).AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// TransactionsGenerator
+	if s.TransactionsGenerator == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		var custom3 []byte
+		if custom3, err = (*s.TransactionsGenerator).MarshalStreamable(); err != nil {
+			return nil, err
+		}
+		b = append(b, custom3...)
+	}
+	// TransactionsGeneratorRefList
+	n4 := uint32(len(s.TransactionsGeneratorRefList))
+	b = append(b, byte(n4>>24), byte(n4>>16), byte(n4>>8), byte(n4))
+	for i5 := range s.TransactionsGeneratorRefList {
+		b = append(b, byte(s.TransactionsGeneratorRefList[i5]>>24), byte(s.TransactionsGeneratorRefList[i5]>>16), byte(s.TransactionsGeneratorRefList[i5]>>8), byte(s.TransactionsGeneratorRefList[i5]))
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for UnfinishedBlock
+func (s *UnfinishedBlock) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// FinishedSubSlots
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.FinishedSubSlots[0])); err != nil {
+		return d.FieldError(err, start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.FinishedSubSlots = make([]EndOfSubSlotBundle, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 EndOfSubSlotBundle
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*EndOfSubSlotBundle)(nil)), start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*EndOfSubSlotBundle)(nil)), start1, "FinishedSubSlots", (*[]EndOfSubSlotBundle)(nil))
+		}
+		d.LeaveNested()
+		s.FinishedSubSlots = append(s.FinishedSubSlots, item5)
+	}
+	d.LeaveNested()
+	// RewardChainBlock
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "RewardChainBlock", (*RewardChainBlockUnfinished)(nil))
+	}
+	if err = s.RewardChainBlock.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start7, "RewardChainBlock", (*RewardChainBlockUnfinished)(nil))
+	}
+	d.LeaveNested()
+	// ChallengeChainSPProof
+	start8 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.ChallengeChainSPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.ChallengeChainSPProof)); err != nil {
+			return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+		}
+		s.ChallengeChainSPProof = new(VDFProof)
+		if err = (*s.ChallengeChainSPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start8, "ChallengeChainSPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// RewardChainSPProof
+	start9 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start9, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start9, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.RewardChainSPProof = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.RewardChainSPProof)); err != nil {
+			return d.FieldError(err, start9, "RewardChainSPProof", (**VDFProof)(nil))
+		}
+		s.RewardChainSPProof = new(VDFProof)
+		if err = (*s.RewardChainSPProof).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start9, "RewardChainSPProof", (**VDFProof)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start9, "RewardChainSPProof", (**VDFProof)(nil))
+	}
+	d.LeaveNested()
+	// Foliage
+	start10 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start10, "Foliage", (*Foliage)(nil))
+	}
+	if err = s.Foliage.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start10, "Foliage", (*Foliage)(nil))
+	}
+	d.LeaveNested()
+	// FoliageTransactionBlock
+	start11 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start11, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start11, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.FoliageTransactionBlock = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.FoliageTransactionBlock)); err != nil {
+			return d.FieldError(err, start11, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+		}
+		s.FoliageTransactionBlock = new(FoliageTransactionBlock)
+		if err = (*s.FoliageTransactionBlock).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start11, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start11, "FoliageTransactionBlock", (**FoliageTransactionBlock)(nil))
+	}
+	d.LeaveNested()
+	// TransactionsInfo
+	start12 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start12, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start12, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.TransactionsInfo = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.TransactionsInfo)); err != nil {
+			return d.FieldError(err, start12, "TransactionsInfo", (**TransactionsInfo)(nil))
+		}
+		s.TransactionsInfo = new(TransactionsInfo)
+		if err = (*s.TransactionsInfo).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start12, "TransactionsInfo", (**TransactionsInfo)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start12, "TransactionsInfo", (**TransactionsInfo)(nil))
+	}
+	d.LeaveNested()
+	// TransactionsGenerator
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "TransactionsGenerator", (**SerializedProgram)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start13, "TransactionsGenerator", (**SerializedProgram)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.TransactionsGenerator = nil
+	case 1:
+		if err = d.LimitAllocation(unsafe.Sizeof(*s.TransactionsGenerator)); err != nil {
+			return d.FieldError(err, start13, "TransactionsGenerator", (**SerializedProgram)(nil))
+		}
+		s.TransactionsGenerator = new(SerializedProgram)
+		if err = (*s.TransactionsGenerator).UnmarshalStreamable(d); err != nil {
+			return d.FieldError(err, start13, "TransactionsGenerator", (**SerializedProgram)(nil))
+		}
+	default:
+		err = fmt.Errorf("invalid value for optional presence byte %d", b[0])
+		return d.FieldError(err, start13, "TransactionsGenerator", (**SerializedProgram)(nil))
+	}
+	d.LeaveNested()
+	// TransactionsGeneratorRefList
+	start14 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start14, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start14, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+	}
+	n15 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n15, unsafe.Sizeof(s.TransactionsGeneratorRefList[0])); err != nil {
+		return d.FieldError(err, start14, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+	}
+	prealloc16 := n15
+	if prealloc16 > 1024 {
+		prealloc16 = 1024
+	}
+	s.TransactionsGeneratorRefList = make([]uint32, 0, prealloc16)
+	for i17 := uint32(0); i17 < n15; i17++ {
+		var item18 uint32
+		start19 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start19, fmt.Sprintf("[%d]", i17), (*uint32)(nil)), start14, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+		}
+		if b, err = d.Next(4); err != nil {
+			return d.FieldError(d.FieldError(err, start19, fmt.Sprintf("[%d]", i17), (*uint32)(nil)), start14, "TransactionsGeneratorRefList", (*[]uint32)(nil))
+		}
+		item18 = uint32(binary.BigEndian.Uint32(b))
+		d.LeaveNested()
+		s.TransactionsGeneratorRefList = append(s.TransactionsGeneratorRefList, item18)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for VDFInfo
+func (s *VDFInfo) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of VDFInfo to b
+func (s *VDFInfo) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// Challenge
+	b = append(b, s.Challenge[:]...)
+	// NumberOfIterations
+	b = append(b, byte(s.NumberOfIterations>>56), byte(s.NumberOfIterations>>48), byte(s.NumberOfIterations>>40), byte(s.NumberOfIterations>>32), byte(s.NumberOfIterations>>24), byte(s.NumberOfIterations>>16), byte(s.NumberOfIterations>>8), byte(s.NumberOfIterations))
+	// Output
+	if b, err = s.Output.AppendStreamable(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for VDFInfo
+func (s *VDFInfo) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// Challenge
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "Challenge", (*Bytes32)(nil))
+	}
+	if b, err = d.Next(32); err != nil {
+		return d.FieldError(err, start1, "Challenge", (*Bytes32)(nil))
+	}
+	copy(s.Challenge[:], b)
+	d.LeaveNested()
+	// NumberOfIterations
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "NumberOfIterations", (*uint64)(nil))
+	}
+	if b, err = d.Next(8); err != nil {
+		return d.FieldError(err, start2, "NumberOfIterations", (*uint64)(nil))
+	}
+	s.NumberOfIterations = uint64(binary.BigEndian.Uint64(b))
+	d.LeaveNested()
+	// Output
+	start3 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start3, "Output", (*ClassgroupElement)(nil))
+	}
+	if err = s.Output.UnmarshalStreamable(d); err != nil {
+		return d.FieldError(err, start3, "Output", (*ClassgroupElement)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for VDFProof
+func (s *VDFProof) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of VDFProof to b
+func (s *VDFProof) AppendStreamable(b []byte) ([]byte, error) {
+	// WitnessType
+	b = append(b, byte(s.WitnessType))
+	// Witness
+	n1 := uint32(len(s.Witness))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	b = append(b, s.Witness...)
+	// NormalizedToIdentity
+	if s.NormalizedToIdentity {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for VDFProof
+func (s *VDFProof) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// WitnessType
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "WitnessType", (*uint8)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start1, "WitnessType", (*uint8)(nil))
+	}
+	s.WitnessType = uint8(b[0])
+	d.LeaveNested()
+	// Witness
+	start2 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start2, "Witness", (*[]byte)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start2, "Witness", (*[]byte)(nil))
+	}
+	n3 := binary.BigEndian.Uint32(b)
+	if err = d.LimitBytesLength(n3); err != nil {
+		return d.FieldError(err, start2, "Witness", (*[]byte)(nil))
+	}
+	if b, err = d.NextBytes(n3); err != nil {
+		return d.FieldError(err, start2, "Witness", (*[]byte)(nil))
+	}
+	s.Witness = b
+	d.LeaveNested()
+	// NormalizedToIdentity
+	start4 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start4, "NormalizedToIdentity", (*bool)(nil))
+	}
+	if b, err = d.Next(1); err != nil {
+		return d.FieldError(err, start4, "NormalizedToIdentity", (*bool)(nil))
+	}
+	switch b[0] {
+	case 0:
+		s.NormalizedToIdentity = false
+	case 1:
+		s.NormalizedToIdentity = true
+	default:
+		err = fmt.Errorf("invalid value for bool %d", b[0])
+		return d.FieldError(err, start4, "NormalizedToIdentity", (*bool)(nil))
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// MarshalStreamable implements streamable.Marshaler for WeightProof
+func (s *WeightProof) MarshalStreamable() ([]byte, error) {
+	return s.AppendStreamable(nil)
+}
+
+// AppendStreamable appends the streamable encoding of WeightProof to b
+func (s *WeightProof) AppendStreamable(b []byte) ([]byte, error) {
+	var err error
+
+	// SubEpochs
+	n1 := uint32(len(s.SubEpochs))
+	b = append(b, byte(n1>>24), byte(n1>>16), byte(n1>>8), byte(n1))
+	for i2 := range s.SubEpochs {
+		if b, err = s.SubEpochs[i2].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// SubEpochSegments
+	n3 := uint32(len(s.SubEpochSegments))
+	b = append(b, byte(n3>>24), byte(n3>>16), byte(n3>>8), byte(n3))
+	for i4 := range s.SubEpochSegments {
+		if b, err = s.SubEpochSegments[i4].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	// RecentChainData
+	n5 := uint32(len(s.RecentChainData))
+	b = append(b, byte(n5>>24), byte(n5>>16), byte(n5>>8), byte(n5))
+	for i6 := range s.RecentChainData {
+		if b, err = s.RecentChainData[i6].AppendStreamable(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalStreamable implements streamable.Unmarshaler for WeightProof
+func (s *WeightProof) UnmarshalStreamable(r io.Reader) error {
+	d := newStreamableDecoder(r)
+	var err error
+	var b []byte
+
+	// SubEpochs
+	start1 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start1, "SubEpochs", (*[]SubEpochData)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start1, "SubEpochs", (*[]SubEpochData)(nil))
+	}
+	n2 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n2, unsafe.Sizeof(s.SubEpochs[0])); err != nil {
+		return d.FieldError(err, start1, "SubEpochs", (*[]SubEpochData)(nil))
+	}
+	prealloc3 := n2
+	if prealloc3 > 1024 {
+		prealloc3 = 1024
+	}
+	s.SubEpochs = make([]SubEpochData, 0, prealloc3)
+	for i4 := uint32(0); i4 < n2; i4++ {
+		var item5 SubEpochData
+		start6 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*SubEpochData)(nil)), start1, "SubEpochs", (*[]SubEpochData)(nil))
+		}
+		if err = item5.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start6, fmt.Sprintf("[%d]", i4), (*SubEpochData)(nil)), start1, "SubEpochs", (*[]SubEpochData)(nil))
+		}
+		d.LeaveNested()
+		s.SubEpochs = append(s.SubEpochs, item5)
+	}
+	d.LeaveNested()
+	// SubEpochSegments
+	start7 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start7, "SubEpochSegments", (*[]SubEpochChallengeSegment)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start7, "SubEpochSegments", (*[]SubEpochChallengeSegment)(nil))
+	}
+	n8 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n8, unsafe.Sizeof(s.SubEpochSegments[0])); err != nil {
+		return d.FieldError(err, start7, "SubEpochSegments", (*[]SubEpochChallengeSegment)(nil))
+	}
+	prealloc9 := n8
+	if prealloc9 > 1024 {
+		prealloc9 = 1024
+	}
+	s.SubEpochSegments = make([]SubEpochChallengeSegment, 0, prealloc9)
+	for i10 := uint32(0); i10 < n8; i10++ {
+		var item11 SubEpochChallengeSegment
+		start12 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*SubEpochChallengeSegment)(nil)), start7, "SubEpochSegments", (*[]SubEpochChallengeSegment)(nil))
+		}
+		if err = item11.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start12, fmt.Sprintf("[%d]", i10), (*SubEpochChallengeSegment)(nil)), start7, "SubEpochSegments", (*[]SubEpochChallengeSegment)(nil))
+		}
+		d.LeaveNested()
+		s.SubEpochSegments = append(s.SubEpochSegments, item11)
+	}
+	d.LeaveNested()
+	// RecentChainData
+	start13 := d.Offset()
+	if err = d.EnterNested(); err != nil {
+		return d.FieldError(err, start13, "RecentChainData", (*[]HeaderBlock)(nil))
+	}
+	if b, err = d.Next(4); err != nil {
+		return d.FieldError(err, start13, "RecentChainData", (*[]HeaderBlock)(nil))
+	}
+	n14 := binary.BigEndian.Uint32(b)
+	if err = d.LimitListLength(n14, unsafe.Sizeof(s.RecentChainData[0])); err != nil {
+		return d.FieldError(err, start13, "RecentChainData", (*[]HeaderBlock)(nil))
+	}
+	prealloc15 := n14
+	if prealloc15 > 1024 {
+		prealloc15 = 1024
+	}
+	s.RecentChainData = make([]HeaderBlock, 0, prealloc15)
+	for i16 := uint32(0); i16 < n14; i16++ {
+		var item17 HeaderBlock
+		start18 := d.Offset()
+		if err = d.EnterNested(); err != nil {
+			return d.FieldError(d.FieldError(err, start18, fmt.Sprintf("[%d]", i16), (*HeaderBlock)(nil)), start13, "RecentChainData", (*[]HeaderBlock)(nil))
+		}
+		if err = item17.UnmarshalStreamable(d); err != nil {
+			return d.FieldError(d.FieldError(err, start18, fmt.Sprintf("[%d]", i16), (*HeaderBlock)(nil)), start13, "RecentChainData", (*[]HeaderBlock)(nil))
+		}
+		d.LeaveNested()
+		s.RecentChainData = append(s.RecentChainData, item17)
+	}
+	d.LeaveNested()
+	return nil
+}
+
+// streamableDecoder matches streamable.GeneratedDecoder, which generated code can't import
+type streamableDecoder interface {
+	io.Reader
+	LimitListLength(numItems uint32, itemSize uintptr) error
+	LimitBytesLength(numBytes uint32) error
+	LimitAllocation(numBytes uintptr) error
+	EnterNested() error
+	LeaveNested()
+	Next(numBytes uint) ([]byte, error)
+	NextBytes(numBytes uint32) ([]byte, error)
+	Offset() int64
+	FieldError(err error, start int64, path string, v interface{}) error
+}
+
+// newStreamableDecoder returns r if it is a streamableDecoder, and wraps it otherwise
+func newStreamableDecoder(r io.Reader) streamableDecoder {
+	if d, ok := r.(streamableDecoder); ok {
+		return d
+	}
+
+	return &streamableReader{r: r}
+}
+
+// streamableReadChunk limits how far ahead of the data actually arriving streamableReader allocates
+const streamableReadChunk = 64 * 1024
+
+// streamableReader is the streamableDecoder for a plain io.Reader, which has no limits other than the data itself
+type streamableReader struct {
+	r      io.Reader
+	offset int64
+	buf    []byte
+}
+
+func (s *streamableReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	s.offset += int64(n)
+	return n, err
+}
+
+// Next reads numBytes in chunks, so the buffer only grows as data arrives
+func (s *streamableReader) Next(numBytes uint) ([]byte, error) {
+	s.buf = s.buf[:0]
+	for uint(len(s.buf)) < numBytes {
+		chunk := numBytes - uint(len(s.buf))
+		if chunk > streamableReadChunk {
+			chunk = streamableReadChunk
+		}
+
+		filled := len(s.buf)
+		s.buf = append(s.buf, make([]byte, chunk)...)
+		n, err := io.ReadFull(s.r, s.buf[filled:])
+		s.offset += int64(n)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+
+	return s.buf, nil
+}
+
+func (s *streamableReader) NextBytes(numBytes uint32) ([]byte, error) {
+	b, err := s.Next(uint(numBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(make([]byte, 0, len(b)), b...), nil
+}
+
+func (s *streamableReader) LimitListLength(numItems uint32, itemSize uintptr) error { return nil }
+func (s *streamableReader) LimitBytesLength(numBytes uint32) error                  { return nil }
+func (s *streamableReader) LimitAllocation(numBytes uintptr) error                  { return nil }
+func (s *streamableReader) EnterNested() error                                      { return nil }
+func (s *streamableReader) LeaveNested()                                            {}
+func (s *streamableReader) Offset() int64                                           { return s.offset }
+
+func (s *streamableReader) FieldError(err error, start int64, path string, v interface{}) error {
+	return err
+}
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strings"
+)
+
+// Uint128 corresponds to uint128 in chia, used for things like weight and total_iters
+// Streamable encodes this as 16 big-endian bytes
+type Uint128 struct {
+	Lo uint64
+	Hi uint64
+}
+
+// NewUint128 returns a Uint128 from the high and low 64 bits
+func NewUint128(hi, lo uint64) Uint128 {
+	return Uint128{Lo: lo, Hi: hi}
+}
+
+// Uint128From64 returns a Uint128 from a uint64
+func Uint128From64(v uint64) Uint128 {
+	return Uint128{Lo: v}
+}
+
+// Uint128FromBig converts a big.Int to Uint128
+// Returns an error if the value is negative or does not fit in 128 bits
+func Uint128FromBig(i *big.Int) (Uint128, error) {
+	if i.Sign() < 0 {
+		return Uint128{}, fmt.Errorf("value %s is negative", i.String())
+	}
+	if i.BitLen() > 128 {
+		return Uint128{}, fmt.Errorf("value %s overflows uint128", i.String())
+	}
+
+	b := make([]byte, 16)
+	i.FillBytes(b)
+
+	return BytesToUint128(b)
+}
+
+// Uint128FromString parses a base 10 string into a Uint128
+func Uint128FromString(s string) (Uint128, error) {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Uint128{}, fmt.Errorf("invalid uint128 string %q", s)
+	}
+
+	return Uint128FromBig(i)
+}
+
+// BytesToUint128 returns a Uint128 from exactly 16 big-endian bytes
+func BytesToUint128(bytes []byte) (Uint128, error) {
+	if len(bytes) != 16 {
+		return Uint128{}, fmt.Errorf("expected 16 bytes, got %d", len(bytes))
+	}
+
+	return Uint128{
+		Hi: binary.BigEndian.Uint64(bytes[:8]),
+		Lo: binary.BigEndian.Uint64(bytes[8:]),
+	}, nil
+}
+
+// Bytes returns the 16 byte big-endian representation
+func (u Uint128) Bytes() []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], u.Hi)
+	binary.BigEndian.PutUint64(b[8:], u.Lo)
+
+	return b
+}
+
+// Big returns the value as a big.Int
+func (u Uint128) Big() *big.Int {
+	return new(big.Int).SetBytes(u.Bytes())
+}
+
+// IsZero returns true if the value is 0
+func (u Uint128) IsZero() bool {
+	return u.Lo == 0 && u.Hi == 0
+}
+
+// Equals returns true if the two values are equal
+func (u Uint128) Equals(v Uint128) bool {
+	return u == v
+}
+
+// Cmp compares u and v and returns -1 if u < v, 0 if u == v, and 1 if u > v
+func (u Uint128) Cmp(v Uint128) int {
+	switch {
+	case u == v:
+		return 0
+	case u.Hi < v.Hi || (u.Hi == v.Hi && u.Lo < v.Lo):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Add returns u+v
+// Panics on overflow
+func (u Uint128) Add(v Uint128) Uint128 {
+	lo, carry := bits.Add64(u.Lo, v.Lo, 0)
+	hi, carry := bits.Add64(u.Hi, v.Hi, carry)
+	if carry != 0 {
+		panic("uint128 overflow")
+	}
+
+	return Uint128{Lo: lo, Hi: hi}
+}
+
+// Sub returns u-v
+// Panics on underflow
+func (u Uint128) Sub(v Uint128) Uint128 {
+	lo, borrow := bits.Sub64(u.Lo, v.Lo, 0)
+	hi, borrow := bits.Sub64(u.Hi, v.Hi, borrow)
+	if borrow != 0 {
+		panic("uint128 underflow")
+	}
+
+	return Uint128{Lo: lo, Hi: hi}
+}
+
+// Mul returns u*v
+// Panics on overflow
+func (u Uint128) Mul(v Uint128) Uint128 {
+	if u.Hi != 0 && v.Hi != 0 {
+		panic("uint128 overflow")
+	}
+
+	hi, lo := bits.Mul64(u.Lo, v.Lo)
+	p0, p1 := bits.Mul64(u.Hi, v.Lo)
+	p2, p3 := bits.Mul64(u.Lo, v.Hi)
+	hi, c0 := bits.Add64(hi, p1, 0)
+	hi, c1 := bits.Add64(hi, p3, 0)
+	if p0 != 0 || p2 != 0 || c0 != 0 || c1 != 0 {
+		panic("uint128 overflow")
+	}
+
+	return Uint128{Lo: lo, Hi: hi}
+}
+
+// Div returns u/v
+// Panics on division by zero
+func (u Uint128) Div(v Uint128) Uint128 {
+	q, _ := u.QuoRem(v)
+	return q
+}
+
+// Mod returns u%v
+// Panics on division by zero
+func (u Uint128) Mod(v Uint128) Uint128 {
+	_, r := u.QuoRem(v)
+	return r
+}
+
+// QuoRem returns u/v and u%v
+// Panics on division by zero
+func (u Uint128) QuoRem(v Uint128) (Uint128, Uint128) {
+	if v.IsZero() {
+		panic("uint128 division by zero")
+	}
+
+	q, r := new(big.Int).QuoRem(u.Big(), v.Big(), new(big.Int))
+
+	// Neither of these can be out of range, since both are <= u
+	quo, _ := Uint128FromBig(q)
+	rem, _ := Uint128FromBig(r)
+
+	return quo, rem
+}
+
+// String returns the base 10 representation of the value
+func (u Uint128) String() string {
+	if u.Hi == 0 {
+		return fmt.Sprintf("%d", u.Lo)
+	}
+
+	return u.Big().String()
+}
+
+// MarshalJSON marshals the value as a JSON number, which is how chia represents uint128 in json
+func (u Uint128) MarshalJSON() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalJSON unmarshals from a JSON number
+// Quoted numbers are accepted as well, since some JSON tooling can't handle numbers this large
+func (u *Uint128) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		s = s[1 : len(s)-1]
+	}
+
+	parsed, err := Uint128FromString(s)
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/types"
+)
+
+func TestUint128_Big(t *testing.T) {
+	// 2^128 - 1
+	maxBig := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+	u, err := types.Uint128FromBig(maxBig)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewUint128(^uint64(0), ^uint64(0)), u)
+	assert.Equal(t, maxBig, u.Big())
+	assert.Equal(t, "340282366920938463463374607431768211455", u.String())
+
+	_, err = types.Uint128FromBig(new(big.Int).Add(maxBig, big.NewInt(1)))
+	assert.Error(t, err)
+
+	_, err = types.Uint128FromBig(big.NewInt(-1))
+	assert.Error(t, err)
+}
+
+func TestUint128_Bytes(t *testing.T) {
+	u := types.NewUint128(1, 2)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2}, u.Bytes())
+
+	decoded, err := types.BytesToUint128(u.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, u, decoded)
+
+	_, err = types.BytesToUint128([]byte{1})
+	assert.Error(t, err)
+}
+
+func TestUint128_Arithmetic(t *testing.T) {
+	maxUint64 := types.Uint128From64(^uint64(0))
+	one := types.Uint128From64(1)
+
+	// Carry into the high bits
+	sum := maxUint64.Add(one)
+	assert.Equal(t, types.NewUint128(1, 0), sum)
+	assert.Equal(t, maxUint64, sum.Sub(one))
+
+	product := maxUint64.Mul(maxUint64)
+	expected := new(big.Int).Mul(maxUint64.Big(), maxUint64.Big())
+	assert.Equal(t, expected, product.Big())
+
+	quo, rem := product.QuoRem(types.Uint128From64(1000))
+	expectedQuo, expectedRem := new(big.Int).QuoRem(expected, big.NewInt(1000), new(big.Int))
+	assert.Equal(t, expectedQuo, quo.Big())
+	assert.Equal(t, expectedRem, rem.Big())
+	assert.Equal(t, quo, product.Div(types.Uint128From64(1000)))
+	assert.Equal(t, rem, product.Mod(types.Uint128From64(1000)))
+
+	maxUint128 := types.NewUint128(^uint64(0), ^uint64(0))
+	assert.Panics(t, func() { maxUint128.Add(one) })
+	assert.Panics(t, func() { types.Uint128{}.Sub(one) })
+	assert.Panics(t, func() { maxUint128.Mul(types.Uint128From64(2)) })
+	assert.Panics(t, func() { one.Div(types.Uint128{}) })
+}
+
+func TestUint128_Cmp(t *testing.T) {
+	small := types.NewUint128(0, ^uint64(0))
+	large := types.NewUint128(1, 0)
+
+	assert.Equal(t, -1, small.Cmp(large))
+	assert.Equal(t, 1, large.Cmp(small))
+	assert.Equal(t, 0, large.Cmp(types.NewUint128(1, 0)))
+	assert.True(t, large.Equals(types.NewUint128(1, 0)))
+	assert.False(t, large.IsZero())
+	assert.True(t, types.Uint128{}.IsZero())
+}
+
+func TestUint128_JSON(t *testing.T) {
+	u, err := types.Uint128FromString("340282366920938463463374607431768211455")
+	assert.NoError(t, err)
+
+	jsonBytes, err := json.Marshal(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "340282366920938463463374607431768211455", string(jsonBytes))
+
+	decoded := types.Uint128{}
+	err = json.Unmarshal(jsonBytes, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, u, decoded)
+
+	// Quoted is fine too
+	err = json.Unmarshal([]byte(`"12345"`), &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Uint128From64(12345), decoded)
+
+	err = json.Unmarshal([]byte(`-1`), &decoded)
+	assert.Error(t, err)
+	err = json.Unmarshal([]byte(`1.5`), &decoded)
+	assert.Error(t, err)
+
+	// Quotes have to be at both ends, or not there at all
+	for _, invalid := range []string{`"5`, `5"`, `""5"`, `"5""`, `"`, `""`} {
+		assert.Error(t, decoded.UnmarshalJSON([]byte(invalid)), invalid)
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+// ClassgroupElement corresponds to ClassgroupElement in chia, which is the output of a VDF
+type ClassgroupElement struct {
+	Data Bytes100 `streamable:""`
+}
+
+// VDFInfo corresponds to VDFInfo in chia
+type VDFInfo struct {
+	Challenge          Bytes32           `streamable:""`
+	NumberOfIterations uint64            `streamable:""`
+	Output             ClassgroupElement `streamable:""`
+}
+
+// VDFProof corresponds to VDFProof in chia
+type VDFProof struct {
+	WitnessType          uint8  `streamable:""`
+	Witness              []byte `streamable:""`
+	NormalizedToIdentity bool   `streamable:""`
+}
@@ -0,0 +1,41 @@
+package types
+
+// SubEpochData corresponds to SubEpochData in chia
+type SubEpochData struct {
+	RewardChainHash   Bytes32 `streamable:""`
+	NumBlocksOverflow uint8   `streamable:""`
+	NewSubSlotIters   *uint64 `streamable:"optional"`
+	NewDifficulty     *uint64 `streamable:"optional"`
+}
+
+// SubSlotData corresponds to SubSlotData in chia
+// A sub slot either ends the slot (the slot end fields are set) or contains a challenge block (the rest are set)
+type SubSlotData struct {
+	ProofOfSpace      *ProofOfSpace `streamable:"optional"`
+	CCSignagePoint    *VDFProof     `streamable:"optional"`
+	CCInfusionPoint   *VDFProof     `streamable:"optional"`
+	ICCInfusionPoint  *VDFProof     `streamable:"optional"`
+	CCSPVDFInfo       *VDFInfo      `streamable:"optional,name=cc_sp_vdf_info"`
+	SignagePointIndex *uint8        `streamable:"optional"`
+	CCSlotEnd         *VDFProof     `streamable:"optional"`
+	ICCSlotEnd        *VDFProof     `streamable:"optional"`
+	CCSlotEndInfo     *VDFInfo      `streamable:"optional"`
+	ICCSlotEndInfo    *VDFInfo      `streamable:"optional"`
+	CCIPVDFInfo       *VDFInfo      `streamable:"optional,name=cc_ip_vdf_info"`
+	ICCIPVDFInfo      *VDFInfo      `streamable:"optional,name=icc_ip_vdf_info"`
+	TotalIters        *Uint128      `streamable:"optional"`
+}
+
+// SubEpochChallengeSegment corresponds to SubEpochChallengeSegment in chia
+type SubEpochChallengeSegment struct {
+	SubEpochN     uint32        `streamable:""`
+	SubSlots      []SubSlotData `streamable:""`
+	RCSlotEndInfo *VDFInfo      `streamable:"optional"`
+}
+
+// WeightProof corresponds to WeightProof in chia
+type WeightProof struct {
+	SubEpochs        []SubEpochData             `streamable:""`
+	SubEpochSegments []SubEpochChallengeSegment `streamable:""`
+	RecentChainData  []HeaderBlock              `streamable:""`
+}
@@ -14,3 +14,8 @@ func PtrUint16(num uint16) *uint16 {
 func PtrUint32(num uint32) *uint32 {
 	return &num
 }
+
+// PtrUint64 Returns a pointer of the uint64
+func PtrUint64(num uint64) *uint64 {
+	return &num
+}